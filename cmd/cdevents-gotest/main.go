@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Command cdevents-gotest reads the output of `go test -json` on stdin and
+// emits the corresponding CDEvents test events.
+//
+// Events are written to stdout as JSON, one per line, or sent as
+// CloudEvents over HTTP when a sink is set:
+//
+//	go test -json ./... | cdevents-gotest -environment ci -sink http://localhost:8080
+//
+// A filter expression selects the events to emit, see package filter:
+//
+//	go test -json ./... | cdevents-gotest -environment ci -filter 'context.type.glob("*.testsuiterun.finished.*")'
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/gotest"
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/cdevents/sdk-go/pkg/filter"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "cdevents-gotest: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("cdevents-gotest", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	source := flags.String("source", "go-test", "source of the events")
+	environment := flags.String("environment", "", "id of the environment where the tests run (required)")
+	environmentSource := flags.String("environment-source", "", "source of the environment")
+	chainId := flags.String("chain-id", "", "chainId of the events, a new one by default")
+	withOutput := flags.Bool("output", false, "attach the test output to the events as customData")
+	sink := flags.String("sink", "", "URL to send the events to as CloudEvents, instead of writing them to stdout")
+	tee := flags.Bool("tee", false, "copy the go test output to stderr")
+	expression := flags.String("filter", "", "CEL expression that selects the events to emit")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *environment == "" {
+		return fmt.Errorf("-environment is required")
+	}
+
+	var s sender.Sender = sender.NewWriterSender(stdout)
+	if *sink != "" {
+		client, err := cloudevents.NewClientHTTP()
+		if err != nil {
+			return fmt.Errorf("cannot create the CloudEvents client: %w", err)
+		}
+		ctx = cloudevents.ContextWithTarget(ctx, *sink)
+		s = sender.NewCloudEventsSender(client)
+	}
+	if *expression != "" {
+		f, err := filter.Compile(*expression)
+		if err != nil {
+			return err
+		}
+		s = filter.NewSender(f, s)
+	}
+
+	opts := []gotest.Option{
+		gotest.WithSource(*source),
+		gotest.WithEnvironment(&api.Reference{Id: *environment, Source: *environmentSource}),
+		gotest.WithChainId(*chainId),
+	}
+	if *withOutput {
+		opts = append(opts, gotest.WithOutput())
+	}
+	converter, err := gotest.NewConverter(s, opts...)
+	if err != nil {
+		return err
+	}
+	if *tee {
+		stdin = io.TeeReader(stdin, stderr)
+	}
+	return converter.Process(ctx, stdin)
+}
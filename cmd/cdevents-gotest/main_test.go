@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRun(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "pkg", "adapters", "gotest", "testdata", "build_failed.json"))
+	if err != nil {
+		t.Fatalf("cannot read input: %v", err)
+	}
+	var stdout, stderr bytes.Buffer
+	args := []string{"-environment", "ci", "-source", "/ci/greeter", "-tee"}
+	if err := run(context.Background(), args, bytes.NewReader(input), &stdout, &stderr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	gotTypes := []string{}
+	for _, line := range strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n") {
+		event, err := cdeventsv05.NewFromJsonBytes([]byte(line))
+		if err != nil {
+			t.Fatalf("cannot parse event %s: %v", line, err)
+		}
+		gotTypes = append(gotTypes, event.GetType().String())
+	}
+	wantTypes := []string{
+		cdeventsv05.TestSuiteRunStartedEventType.String(),
+		cdeventsv05.TestSuiteRunFinishedEventType.String(),
+	}
+	if d := cmp.Diff(wantTypes, gotTypes); d != "" {
+		t.Errorf("types diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(string(input), stderr.String()); d != "" {
+		t.Errorf("tee diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestRunFilter(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "pkg", "adapters", "gotest", "testdata", "build_failed.json"))
+	if err != nil {
+		t.Fatalf("cannot read input: %v", err)
+	}
+	var stdout, stderr bytes.Buffer
+	args := []string{"-environment", "ci", "-filter", `context.type.typePredicate() == "finished"`}
+	if err := run(context.Background(), args, bytes.NewReader(input), &stdout, &stderr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one event, got %d", len(lines))
+	}
+	event, err := cdeventsv05.NewFromJsonBytes([]byte(lines[0]))
+	if err != nil {
+		t.Fatalf("cannot parse event %s: %v", lines[0], err)
+	}
+	if d := cmp.Diff(cdeventsv05.TestSuiteRunFinishedEventType.String(), event.GetType().String()); d != "" {
+		t.Errorf("type diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestRunInvalidFilter(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	args := []string{"-environment", "ci", "-filter", "context.type =="}
+	if err := run(context.Background(), args, strings.NewReader(""), &stdout, &stderr); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func TestRunMissingEnvironment(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if err := run(context.Background(), nil, strings.NewReader(""), &stdout, &stderr); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 
 	examples "github.com/cdevents/sdk-go/docs/examples"
 	cdevents "github.com/cdevents/sdk-go/pkg/api"
@@ -15,17 +17,20 @@ import (
 const customSchemaUri = "https://myregistry.dev/schemas/cdevents/quota-exceeded/0_1_0"
 
 type Quota struct {
-	User      string `json:"user"`      // The use the quota applies ot
-	Limit     string `json:"limit"`     // The limit enforced by the quota e.g. 100Gb
-	Current   int    `json:"current"`   // The current % of the quota used e.g. 90%
-	Threshold int    `json:"threshold"` // The threshold for warning event e.g. 85%
-	Level     string `json:"level"`     // INFO: <threshold, WARNING: >threshold, <quota, CRITICAL: >quota
+	User      string `json:"user" jsonschema:"minLength=1"`  // The use the quota applies ot
+	Limit     string `json:"limit" jsonschema:"minLength=1"` // The limit enforced by the quota e.g. 100Gb
+	Current   int    `json:"current"`                        // The current % of the quota used e.g. 90%
+	Threshold int    `json:"threshold"`                      // The threshold for warning event e.g. 85%
+	Level     string `json:"level" jsonschema:"minLength=1"` // INFO: <threshold, WARNING: >threshold, <quota, CRITICAL: >quota
 }
 
 func main() {
 	var ce *cloudevents.Event
 	var c cloudevents.Client
 
+	schemaDir := flag.String("schema-dir", os.TempDir(), "directory where the custom schema is exported")
+	flag.Parse()
+
 	// Define the event type
 	eventType := cdevents.CDEventType{
 		Subject:   "quota",
@@ -61,12 +66,16 @@ func main() {
 	examples.PanicOnError(err, "failed to marshal the CDEvent")
 	fmt.Printf("%s", eventJson)
 
-	// To validate the event, we need to load its custom schema
-	customSchema, err := os.ReadFile("myregistry-quotaexceeded_schema.json")
-	examples.PanicOnError(err, "cannot load schema file")
+	// To validate the event, we need to load its custom schema. It is
+	// generated from the Quota struct, and exported for consumers, like
+	// myregistry-quotaexceeded_schema.json in this folder
+	customSchema, err := cdevents.NewCustomSchema(cdeventsv04.SpecVersion, eventType, customSchemaUri, Quota{})
+	examples.PanicOnError(err, "cannot generate the custom schema")
 
-	err = cdevents.LoadJsonSchema(customSchemaUri, customSchema)
-	examples.PanicOnError(err, "cannot load the custom schema file")
+	schemaFile := filepath.Join(*schemaDir, customSchema.FileName())
+	err = customSchema.WriteFile(schemaFile)
+	examples.PanicOnError(err, "cannot export the custom schema")
+	log.Printf("custom schema exported to %s", schemaFile)
 
 	ce, err = cdevents.AsCloudEvent(event)
 	examples.PanicOnError(err, "failed to create cloudevent")
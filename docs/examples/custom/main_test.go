@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cdevents "github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestCustomSchemaFile checks that the schema file in this folder is the
+// one generated from the Quota struct
+func TestCustomSchemaFile(t *testing.T) {
+	eventType := cdevents.CDEventType{
+		Subject:   "quota",
+		Predicate: "exceeded",
+		Version:   "0.1.0",
+		Custom:    "myregistry",
+	}
+	customSchema, err := cdevents.NewCustomSchema(cdeventsv04.SpecVersion, eventType, customSchemaUri, Quota{})
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	generated := filepath.Join(t.TempDir(), customSchema.FileName())
+	if err := customSchema.WriteFile(generated); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	want, err := os.ReadFile(customSchema.FileName())
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	got, err := os.ReadFile(generated) //nolint: gosec
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	if d := cmp.Diff(string(want), string(got)); d != "" {
+		t.Errorf("diff(-want,+got):\n%s", d)
+	}
+}
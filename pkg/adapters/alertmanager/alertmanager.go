@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package alertmanager converts Prometheus Alertmanager webhook
+// notifications into CDEvents v0.5.
+//
+// Each alert of a notification produces an event:
+//
+//   - firing alerts: IncidentDetected
+//   - resolved alerts: IncidentResolved
+//
+// The subject id of the events is the fingerprint of the alert, so that
+// repeated notifications of an alert, and its resolution, refer to the same
+// incident. The environment, service and artifactId of the incident come
+// from alert labels, and its description from the alert annotations.
+package alertmanager
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+)
+
+const (
+	// DefaultEnvironmentLabel is the default label of the environment
+	DefaultEnvironmentLabel = "environment"
+
+	// DefaultServiceLabel is the default label of the service
+	DefaultServiceLabel = "service"
+
+	// DefaultArtifactIdLabel is the default label of the artifactId
+	DefaultArtifactIdLabel = "artifact_id"
+
+	// maxPayloadSize is the maximum size of a webhook payload accepted
+	maxPayloadSize = 25 * 1024 * 1024
+
+	bearerPrefix = "Bearer "
+)
+
+var (
+	// ErrNoEnvironment is returned for alerts without an environment label,
+	// when no default environment is configured
+	ErrNoEnvironment = errors.New("alert has no environment")
+
+	// ErrUnauthorized is returned when the Authorization header of a
+	// request does not match the configured bearer token
+	ErrUnauthorized = errors.New("invalid Alertmanager webhook credentials")
+
+	// descriptionAnnotations are the annotations used as description, in
+	// order of preference
+	descriptionAnnotations = []string{"description", "summary"}
+)
+
+// incidentEvent holds the setters shared by the incident events
+type incidentEvent interface {
+	api.CDEventV04
+	SetSubjectEnvironment(environment *api.Reference)
+	SetSubjectService(service *api.Reference)
+	SetSubjectArtifactId(artifactId string)
+	SetSubjectDescription(description string)
+}
+
+// Adapter converts Alertmanager webhook notifications into CDEvents
+type Adapter struct {
+	source           string
+	token            string
+	environmentLabel string
+	serviceLabel     string
+	artifactIdLabel  string
+	environment      *api.Reference
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events. By default, the
+// external URL of the Alertmanager is used.
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithBearerToken sets the token expected in the Authorization header of
+// the requests in ParseRequest, as configured in the http_config of the
+// Alertmanager receiver. When no token is set, requests are not verified.
+func WithBearerToken(token string) Option {
+	return func(a *Adapter) {
+		a.token = token
+	}
+}
+
+// WithEnvironmentLabel sets the label that holds the id of the environment
+func WithEnvironmentLabel(label string) Option {
+	return func(a *Adapter) {
+		a.environmentLabel = label
+	}
+}
+
+// WithServiceLabel sets the label that holds the id of the service
+func WithServiceLabel(label string) Option {
+	return func(a *Adapter) {
+		a.serviceLabel = label
+	}
+}
+
+// WithArtifactIdLabel sets the label that holds the artifactId. Its value
+// is either a purl or an image reference, converted into a pkg:oci purl.
+func WithArtifactIdLabel(label string) Option {
+	return func(a *Adapter) {
+		a.artifactIdLabel = label
+	}
+}
+
+// WithEnvironment sets the environment of alerts without an environment
+// label
+func WithEnvironment(environment *api.Reference) Option {
+	return func(a *Adapter) {
+		a.environment = environment
+	}
+}
+
+// NewAdapter creates a new Alertmanager webhook Adapter
+func NewAdapter(opts ...Option) *Adapter {
+	a := &Adapter{
+		environmentLabel: DefaultEnvironmentLabel,
+		serviceLabel:     DefaultServiceLabel,
+		artifactIdLabel:  DefaultArtifactIdLabel,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// ParseRequest verifies the bearer token of a webhook request, if one is
+// configured, and converts its payload into CDEvents
+func (a *Adapter) ParseRequest(r *http.Request) ([]api.CDEventV04, error) {
+	if a.token != "" {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), bearerPrefix)
+		if !found || subtle.ConstantTimeCompare([]byte(a.token), []byte(token)) != 1 {
+			return nil, ErrUnauthorized
+		}
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the webhook payload: %w", err)
+	}
+	return a.Convert(payload)
+}
+
+// Convert converts the payload of a webhook notification into CDEvents, one
+// for each alert. Label values that are not valid artifactIds are ignored.
+// Alerts that cannot be converted, e.g. without environment, are skipped:
+// the events of the other alerts are returned along with their errors.
+func (a *Adapter) Convert(payload []byte) ([]api.CDEventV04, error) {
+	var hook webhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("cannot parse the webhook payload: %w", err)
+	}
+	source := a.source
+	if source == "" {
+		source = hook.ExternalURL
+	}
+	events := []api.CDEventV04{}
+	var errs []error
+	for _, alert := range hook.Alerts {
+		event, err := a.convertAlert(alert)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+		event.SetSource(source)
+		events = append(events, event)
+	}
+	return events, errors.Join(errs...)
+}
+
+func (a *Adapter) convertAlert(alert alert) (incidentEvent, error) {
+	var event incidentEvent
+	switch alert.Status {
+	case statusFiring:
+		e, err := cdeventsv05.NewIncidentDetectedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetTimestamp(alert.StartsAt)
+		event = e
+	case statusResolved:
+		e, err := cdeventsv05.NewIncidentResolvedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetTimestamp(alert.EndsAt)
+		event = e
+	default:
+		return nil, nil
+	}
+	event.SetSubjectId(alert.fingerprint())
+
+	environment := a.environment
+	if id := alert.Labels[a.environmentLabel]; id != "" {
+		environment = &api.Reference{Id: id}
+	}
+	if environment == nil {
+		return nil, fmt.Errorf("%w: alert %s has no %s label", ErrNoEnvironment, alert.fingerprint(), a.environmentLabel)
+	}
+	event.SetSubjectEnvironment(environment)
+	if id := alert.Labels[a.serviceLabel]; id != "" {
+		event.SetSubjectService(&api.Reference{Id: id})
+	}
+	if value := alert.Labels[a.artifactIdLabel]; value != "" {
+		if artifactId, err := api.NormalizeArtifactId(value); err == nil {
+			event.SetSubjectArtifactId(artifactId)
+		}
+	}
+	for _, annotation := range descriptionAnnotations {
+		if description := alert.Annotations[annotation]; description != "" {
+			event.SetSubjectDescription(description)
+			break
+		}
+	}
+	return event, nil
+}
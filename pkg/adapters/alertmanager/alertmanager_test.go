@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package alertmanager_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/alertmanager"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testExternalURL = "https://alertmanager.example.com"
+	testFingerprint = "3d7e2f1a9b4c6d80"
+	// testLegacyFingerprint is computed from the labels of the legacy alert
+	testLegacyFingerprint = "b7e3e813e8f39aa9"
+	testArtifactId        = "pkg:oci/checkout@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=ghcr.io%2Facme%2Fcheckout&tag=v1.4.2"
+	testDescription       = "5.2% of the requests to checkout failed in the last 5 minutes"
+	testBearerToken       = "It's a Secret to Everybody"
+	testLegacySource      = "/monitoring/alertmanager"
+)
+
+var (
+	testProduction = &api.Reference{Id: "production"}
+	testCheckout   = &api.Reference{Id: "checkout"}
+)
+
+type wantEvent struct {
+	eventType api.CDEventType
+	subjectId string
+	content   interface{}
+	timestamp time.Time
+}
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		opts       []alertmanager.Option
+		wantSource string
+		want       []wantEvent
+	}{{
+		fixture:    "firing",
+		wantSource: testExternalURL,
+		want: []wantEvent{{
+			eventType: cdeventsv05.IncidentDetectedEventType,
+			subjectId: testFingerprint,
+			content: api.IncidentDetectedSubjectContentV0_3_0{
+				ArtifactId:  testArtifactId,
+				Description: testDescription,
+				Environment: testProduction,
+				Service:     testCheckout,
+			},
+			timestamp: mustParseTime("2024-03-01T10:00:00Z"),
+		}, {
+			// The artifactId label is not valid, and there is no service label
+			eventType: cdeventsv05.IncidentDetectedEventType,
+			subjectId: "a1b2c3d4e5f60718",
+			content: api.IncidentDetectedSubjectContentV0_3_0{
+				Description: "High error rate on the frontend",
+				Environment: testProduction,
+			},
+			timestamp: mustParseTime("2024-03-01T10:02:30Z"),
+		}},
+	}, {
+		fixture:    "resolved",
+		wantSource: testExternalURL,
+		want: []wantEvent{{
+			eventType: cdeventsv05.IncidentResolvedEventType,
+			subjectId: testFingerprint,
+			content: api.IncidentResolvedSubjectContentV0_3_0{
+				ArtifactId:  testArtifactId,
+				Description: testDescription,
+				Environment: testProduction,
+				Service:     testCheckout,
+			},
+			timestamp: mustParseTime("2024-03-01T10:25:00Z"),
+		}},
+	}, {
+		fixture: "legacy",
+		opts: []alertmanager.Option{
+			alertmanager.WithSource(testLegacySource),
+			alertmanager.WithEnvironmentLabel("env"),
+			alertmanager.WithServiceLabel("app"),
+			alertmanager.WithArtifactIdLabel("image"),
+		},
+		wantSource: testLegacySource,
+		want: []wantEvent{{
+			// Alertmanager < 0.19 does not send fingerprints
+			eventType: cdeventsv05.IncidentDetectedEventType,
+			subjectId: testLegacyFingerprint,
+			content: api.IncidentDetectedSubjectContentV0_3_0{
+				ArtifactId:  "pkg:oci/postgres?repository_url=docker.io%2Flibrary%2Fpostgres&tag=16",
+				Description: "Disk almost full on postgres",
+				Environment: &api.Reference{Id: "staging"},
+				Service:     &api.Reference{Id: "postgres"},
+			},
+			timestamp: mustParseTime("2024-03-01T08:00:00Z"),
+		}},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := alertmanager.NewAdapter(tc.opts...).Convert(loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != len(tc.want) {
+				t.Fatalf("expected %d events, got %d", len(tc.want), len(events))
+			}
+			for i, event := range events {
+				want := tc.want[i]
+				if d := cmp.Diff(want.eventType, event.GetType()); d != "" {
+					t.Errorf("type diff(-want,+got):\n%s", d)
+				}
+				if d := cmp.Diff(want.subjectId, event.GetSubjectId()); d != "" {
+					t.Errorf("subject id diff(-want,+got):\n%s", d)
+				}
+				if d := cmp.Diff(want.content, event.GetSubjectContent()); d != "" {
+					t.Errorf("content diff(-want,+got):\n%s", d)
+				}
+				if d := cmp.Diff(want.timestamp, event.GetTimestamp()); d != "" {
+					t.Errorf("timestamp diff(-want,+got):\n%s", d)
+				}
+				if d := cmp.Diff(tc.wantSource, event.GetSource()); d != "" {
+					t.Errorf("source diff(-want,+got):\n%s", d)
+				}
+				if err := api.Validate(event); err != nil {
+					t.Errorf("produced event is not valid: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertRefire(t *testing.T) {
+	tests := []struct {
+		fixtures []string
+		opts     []alertmanager.Option
+		want     string
+	}{{
+		fixtures: []string{"firing", "firing", "resolved"},
+		want:     testFingerprint,
+	}, {
+		fixtures: []string{"legacy", "legacy"},
+		opts:     []alertmanager.Option{alertmanager.WithEnvironmentLabel("env")},
+		want:     testLegacyFingerprint,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.want, func(t *testing.T) {
+			adapter := alertmanager.NewAdapter(tc.opts...)
+			for _, fixture := range tc.fixtures {
+				events, err := adapter.Convert(loadFixture(t, fixture))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if d := cmp.Diff(tc.want, events[0].GetSubjectId()); d != "" {
+					t.Errorf("%s: subject id diff(-want,+got):\n%s", fixture, d)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertNoEnvironment(t *testing.T) {
+	_, err := alertmanager.NewAdapter().Convert(loadFixture(t, "legacy"))
+	if !errors.Is(err, alertmanager.ErrNoEnvironment) {
+		t.Fatalf("expected ErrNoEnvironment, got %v", err)
+	}
+	events, err := alertmanager.NewAdapter(alertmanager.WithEnvironment(testProduction)).Convert(loadFixture(t, "legacy"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, ok := events[0].GetSubjectContent().(api.IncidentDetectedSubjectContentV0_3_0)
+	if !ok {
+		t.Fatalf("unexpected content %T", events[0].GetSubjectContent())
+	}
+	if d := cmp.Diff(testProduction, content.Environment); d != "" {
+		t.Errorf("environment diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestConvertSkipsInvalidAlerts(t *testing.T) {
+	events, err := alertmanager.NewAdapter().Convert(loadFixture(t, "missing_environment"))
+	if !errors.Is(err, alertmanager.ErrNoEnvironment) {
+		t.Fatalf("expected ErrNoEnvironment, got %v", err)
+	}
+	if d := cmp.Diff(1, len(events)); d != "" {
+		t.Fatalf("events diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff("6f7a8b9c0d1e2f30", events[0].GetSubjectId()); d != "" {
+		t.Errorf("subject id diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestConvertInvalid(t *testing.T) {
+	if _, err := alertmanager.NewAdapter().Convert([]byte("not json")); err == nil {
+		t.Error("expected an error, got none")
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantError     error
+	}{{
+		name:          "valid token",
+		authorization: "Bearer " + testBearerToken,
+	}, {
+		name:          "invalid token",
+		authorization: "Bearer nope",
+		wantError:     alertmanager.ErrUnauthorized,
+	}, {
+		name:          "basic auth",
+		authorization: "Basic dXNlcjpwYXNz",
+		wantError:     alertmanager.ErrUnauthorized,
+	}, {
+		name:      "missing token",
+		wantError: alertmanager.ErrUnauthorized,
+	}}
+	adapter := alertmanager.NewAdapter(alertmanager.WithBearerToken(testBearerToken))
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(loadFixture(t, "firing")))
+			if tc.authorization != "" {
+				r.Header.Set("Authorization", tc.authorization)
+			}
+			events, err := adapter.ParseRequest(r)
+			if !errors.Is(err, tc.wantError) {
+				t.Fatalf("error %v, want %v", err, tc.wantError)
+			}
+			if tc.wantError == nil && len(events) != 2 {
+				t.Errorf("expected 2 events, got %d", len(events))
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package alertmanager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The types in this file hold the subset of the Alertmanager webhook
+// payload used by the adapter.
+// Docs: https://prometheus.io/docs/alerting/latest/configuration/#webhook_config
+
+const (
+	statusFiring   = "firing"
+	statusResolved = "resolved"
+)
+
+// webhook is the payload of an Alertmanager webhook notification
+type webhook struct {
+	Version     string  `json:"version"`
+	GroupKey    string  `json:"groupKey"`
+	Status      string  `json:"status"`
+	Receiver    string  `json:"receiver"`
+	ExternalURL string  `json:"externalURL"`
+	Alerts      []alert `json:"alerts"`
+}
+
+type alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// fingerprint returns the fingerprint of the alert. Alertmanager sends it
+// since v0.19; for older versions it is computed from the labels, which
+// identify an alert in Alertmanager as well.
+func (a alert) fingerprint() string {
+	if a.Fingerprint != "" {
+		return a.Fingerprint
+	}
+	names := make([]string, 0, len(a.Labels))
+	for name := range a.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte(0)
+		b.WriteString(a.Labels[name])
+		b.WriteByte(0)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package github converts GitHub webhook payloads into CDEvents.
+//
+// The following webhook events are supported:
+//
+//   - pull_request: ChangeCreated, ChangeUpdated, ChangeMerged, ChangeAbandoned
+//   - pull_request_review: ChangeReviewed
+//   - push, create, delete: BranchCreated, BranchDeleted
+//   - repository: RepositoryCreated, RepositoryModified, RepositoryDeleted
+//
+// GitHub delivers both a push and a create (or delete) webhook when a branch
+// is created (or deleted). Subscribe the webhook to only one of them to avoid
+// duplicate branch events.
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	// EventHeader is the HTTP header that holds the name of the webhook event
+	EventHeader = "X-GitHub-Event"
+
+	// DeliveryHeader is the HTTP header that holds the unique id of the delivery
+	DeliveryHeader = "X-GitHub-Delivery"
+
+	// maxPayloadSize is the maximum size of a webhook payload, as documented by GitHub
+	maxPayloadSize = 25 * 1024 * 1024
+
+	branchRefPrefix = "refs/heads/"
+)
+
+// ErrUnsupportedEvent is returned for webhook events that have no CDEvents mapping
+var ErrUnsupportedEvent = errors.New("unsupported GitHub webhook event")
+
+// eventFactory holds the event types and constructor for one spec version
+type eventFactory struct {
+	types    map[string]api.CDEventV04
+	newEvent func(eventType, specVersion string) (api.CDEvent, error)
+}
+
+var factories = map[string]eventFactory{
+	"v0.4": {types: cdeventsv04.CDEventsByUnversionedTypes, newEvent: cdeventsv04.NewCDEvent},
+	"v0.5": {types: cdeventsv05.CDEventsByUnversionedTypes, newEvent: cdeventsv05.NewCDEvent},
+}
+
+// Adapter converts GitHub webhook payloads into CDEvents
+type Adapter struct {
+	source      string
+	secret      []byte
+	specVersion string
+	factory     eventFactory
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events. By default, the
+// HTML URL of the repository is used.
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithSecret sets the webhook secret used to verify the X-Hub-Signature-256
+// header in ParseRequest. When no secret is set, signatures are not verified.
+func WithSecret(secret []byte) Option {
+	return func(a *Adapter) {
+		a.secret = secret
+	}
+}
+
+// WithSpecVersion sets the CDEvents spec version of the produced events.
+// Versions v0.4.x and v0.5.x are supported, v0.5 is the default.
+func WithSpecVersion(specVersion string) Option {
+	return func(a *Adapter) {
+		a.specVersion = specVersion
+	}
+}
+
+// NewAdapter creates a new GitHub webhook Adapter
+func NewAdapter(opts ...Option) (*Adapter, error) {
+	a := &Adapter{
+		specVersion: cdeventsv05.SpecVersion,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	factory, ok := factories[semver.MajorMinor("v"+a.specVersion)]
+	if !ok {
+		return nil, fmt.Errorf("spec version %s not supported by the GitHub adapter", a.specVersion)
+	}
+	a.factory = factory
+	return a, nil
+}
+
+// ParseRequest verifies the signature of a webhook request, if a secret
+// is configured, and converts its payload into CDEvents
+func (a *Adapter) ParseRequest(r *http.Request) ([]api.CDEventV04, error) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the webhook payload: %w", err)
+	}
+	if len(a.secret) > 0 {
+		err = VerifySignature(a.secret, payload, r.Header.Get(SignatureHeader))
+		if err != nil {
+			return nil, err
+		}
+	}
+	events, err := a.Convert(r.Header.Get(EventHeader), payload)
+	if err != nil {
+		return nil, err
+	}
+	// Redeliveries share the delivery id, so it's a suitable event id
+	if delivery := r.Header.Get(DeliveryHeader); delivery != "" && len(events) == 1 {
+		events[0].SetId(delivery)
+	}
+	return events, nil
+}
+
+// Convert converts the payload of a webhook event into CDEvents. eventName
+// is the value of the X-GitHub-Event header. Actions that have no CDEvents
+// equivalent, like a pull request being labeled, produce no events and
+// no error. Event names without a mapping return ErrUnsupportedEvent.
+func (a *Adapter) Convert(eventName string, payload []byte) ([]api.CDEventV04, error) {
+	var event api.CDEventV04
+	var err error
+	switch eventName {
+	case "pull_request":
+		event, err = a.convertPullRequest(payload)
+	case "pull_request_review":
+		event, err = a.convertPullRequestReview(payload)
+	case "push":
+		event, err = a.convertPush(payload)
+	case "create":
+		event, err = a.convertRef(payload, "branch.created")
+	case "delete":
+		event, err = a.convertRef(payload, "branch.deleted")
+	case "repository":
+		event, err = a.convertRepository(payload)
+	case "ping":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedEvent, eventName)
+	}
+	if err != nil || event == nil {
+		return nil, err
+	}
+	return []api.CDEventV04{event}, nil
+}
+
+func (a *Adapter) convertPullRequest(payload []byte) (api.CDEventV04, error) {
+	var prEvent pullRequestEvent
+	if err := json.Unmarshal(payload, &prEvent); err != nil {
+		return nil, fmt.Errorf("cannot parse pull_request payload: %w", err)
+	}
+	pr := prEvent.PullRequest
+	var subjectPredicate string
+	var timestamp *time.Time
+	switch prEvent.Action {
+	case "opened":
+		subjectPredicate, timestamp = "change.created", pr.CreatedAt
+	case "edited", "synchronize", "reopened", "ready_for_review", "converted_to_draft":
+		subjectPredicate, timestamp = "change.updated", pr.UpdatedAt
+	case "closed":
+		if pr.Merged {
+			subjectPredicate, timestamp = "change.merged", pr.MergedAt
+		} else {
+			subjectPredicate, timestamp = "change.abandoned", pr.ClosedAt
+		}
+	default:
+		return nil, nil
+	}
+	event, err := a.newChangeEvent(subjectPredicate, pr, prEvent.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if timestamp != nil {
+		event.SetTimestamp(*timestamp)
+	}
+	return event, nil
+}
+
+func (a *Adapter) convertPullRequestReview(payload []byte) (api.CDEventV04, error) {
+	var reviewEvent pullRequestReviewEvent
+	if err := json.Unmarshal(payload, &reviewEvent); err != nil {
+		return nil, fmt.Errorf("cannot parse pull_request_review payload: %w", err)
+	}
+	if reviewEvent.Action != "submitted" {
+		return nil, nil
+	}
+	event, err := a.newChangeEvent("change.reviewed", reviewEvent.PullRequest, reviewEvent.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if reviewEvent.Review.SubmittedAt != nil {
+		event.SetTimestamp(*reviewEvent.Review.SubmittedAt)
+	}
+	return event, nil
+}
+
+func (a *Adapter) convertPush(payload []byte) (api.CDEventV04, error) {
+	var push pushEvent
+	if err := json.Unmarshal(payload, &push); err != nil {
+		return nil, fmt.Errorf("cannot parse push payload: %w", err)
+	}
+	branch, isBranch := strings.CutPrefix(push.Ref, branchRefPrefix)
+	switch {
+	case !isBranch:
+		return nil, nil
+	case push.Created:
+		return a.newBranchEvent("branch.created", branch, push.Repository)
+	case push.Deleted:
+		return a.newBranchEvent("branch.deleted", branch, push.Repository)
+	default:
+		return nil, nil
+	}
+}
+
+func (a *Adapter) convertRef(payload []byte, subjectPredicate string) (api.CDEventV04, error) {
+	var ref refEvent
+	if err := json.Unmarshal(payload, &ref); err != nil {
+		return nil, fmt.Errorf("cannot parse %s payload: %w", subjectPredicate, err)
+	}
+	if ref.RefType != "branch" {
+		return nil, nil
+	}
+	return a.newBranchEvent(subjectPredicate, ref.Ref, ref.Repository)
+}
+
+func (a *Adapter) convertRepository(payload []byte) (api.CDEventV04, error) {
+	var repoEvent repositoryEvent
+	if err := json.Unmarshal(payload, &repoEvent); err != nil {
+		return nil, fmt.Errorf("cannot parse repository payload: %w", err)
+	}
+	var subjectPredicate string
+	switch repoEvent.Action {
+	case "created":
+		subjectPredicate = "repository.created"
+	case "edited", "renamed", "transferred", "archived", "unarchived", "publicized", "privatized":
+		subjectPredicate = "repository.modified"
+	case "deleted":
+		subjectPredicate = "repository.deleted"
+	default:
+		return nil, nil
+	}
+	repo := repoEvent.Repository
+	event, err := a.newEvent(subjectPredicate, repo)
+	if err != nil {
+		return nil, err
+	}
+	event.SetSubjectId(repo.FullName)
+	setSubjectSource(event, repo.serverURL())
+	if e, ok := event.(repositoryContentWriter); ok {
+		e.SetSubjectName(repo.Name)
+		e.SetSubjectOwner(repo.owner())
+		e.SetSubjectViewUrl(repo.HTMLURL)
+	}
+	// The repository URL field was renamed from url to uri in v0.5
+	switch e := event.(type) {
+	case uriWriter:
+		e.SetSubjectUri(repo.CloneURL)
+	case urlWriter:
+		e.SetSubjectUrl(repo.CloneURL)
+	}
+	return event, nil
+}
+
+func (a *Adapter) newChangeEvent(subjectPredicate string, pr pullRequest, repo repository) (api.CDEventV04, error) {
+	event, err := a.newEvent(subjectPredicate, repo)
+	if err != nil {
+		return nil, err
+	}
+	event.SetSubjectId(strconv.Itoa(pr.Number))
+	setSubjectSource(event, repo.HTMLURL)
+	if e, ok := event.(repositoryReferenceWriter); ok {
+		e.SetSubjectRepository(repositoryReference(repo))
+	}
+	if e, ok := event.(descriptionWriter); ok {
+		e.SetSubjectDescription(pr.Title)
+	}
+	return event, nil
+}
+
+func (a *Adapter) newBranchEvent(subjectPredicate, branch string, repo repository) (api.CDEventV04, error) {
+	event, err := a.newEvent(subjectPredicate, repo)
+	if err != nil {
+		return nil, err
+	}
+	event.SetSubjectId(branch)
+	setSubjectSource(event, repo.HTMLURL)
+	if e, ok := event.(repositoryReferenceWriter); ok {
+		e.SetSubjectRepository(repositoryReference(repo))
+	}
+	return event, nil
+}
+
+// newEvent creates an event for the configured spec version. subjectPredicate
+// is the unversioned type without the root, e.g. "change.created".
+func (a *Adapter) newEvent(subjectPredicate string, repo repository) (api.CDEventV04, error) {
+	eventType, ok := a.factory.types[api.EventTypeRoot+"."+subjectPredicate]
+	if !ok {
+		return nil, fmt.Errorf("event %s not available in spec version %s", subjectPredicate, a.specVersion)
+	}
+	event, err := a.factory.newEvent(eventType.GetType().String(), a.specVersion)
+	if err != nil {
+		return nil, err
+	}
+	v04event, ok := event.(api.CDEventV04)
+	if !ok {
+		return nil, fmt.Errorf("event %s does not support v0.4+ fields", eventType.GetType())
+	}
+	source := a.source
+	if source == "" {
+		source = repo.HTMLURL
+	}
+	v04event.SetSource(source)
+	return v04event, nil
+}
+
+// setSubjectSource overrides the subject source, which defaults to the
+// event source, only when the payload provides one
+func setSubjectSource(event api.CDEventV04, subjectSource string) {
+	if subjectSource != "" {
+		event.SetSubjectSource(subjectSource)
+	}
+}
+
+func repositoryReference(repo repository) *api.Reference {
+	return &api.Reference{
+		Id:     repo.FullName,
+		Source: repo.serverURL(),
+	}
+}
+
+// The interfaces below are satisfied by the events that have the
+// corresponding content fields, across spec versions
+
+type repositoryReferenceWriter interface {
+	SetSubjectRepository(repository *api.Reference)
+}
+
+type descriptionWriter interface {
+	SetSubjectDescription(description string)
+}
+
+type repositoryContentWriter interface {
+	SetSubjectName(name string)
+	SetSubjectOwner(owner string)
+	SetSubjectViewUrl(viewUrl string)
+}
+
+type uriWriter interface {
+	SetSubjectUri(uri string)
+}
+
+type urlWriter interface {
+	SetSubjectUrl(url string)
+}
@@ -0,0 +1,378 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package github_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/github"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testRepoURL = "https://github.com/octo-org/hello-world"
+	testTitle   = "Add a greeting for CDEvents users"
+)
+
+var (
+	testSecret  = []byte("It's a Secret to Everybody")
+	testRepoRef = &api.Reference{Id: "octo-org/hello-world", Source: "https://github.com"}
+)
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func TestConvertV05(t *testing.T) {
+	tests := []struct {
+		fixture       string
+		eventName     string
+		wantType      api.CDEventType
+		wantSubjectId string
+		wantSource    string
+		wantContent   interface{}
+		wantTimestamp time.Time
+	}{{
+		fixture:       "pull_request_opened",
+		eventName:     "pull_request",
+		wantType:      cdeventsv05.ChangeCreatedEventType,
+		wantSubjectId: "42",
+		wantSource:    testRepoURL,
+		wantContent:   api.ChangeCreatedSubjectContentV0_4_0{Description: testTitle, Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:00Z"),
+	}, {
+		fixture:       "pull_request_synchronize",
+		eventName:     "pull_request",
+		wantType:      cdeventsv05.ChangeUpdatedEventType,
+		wantSubjectId: "42",
+		wantSource:    testRepoURL,
+		wantContent:   api.ChangeUpdatedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-01T11:30:00Z"),
+	}, {
+		fixture:       "pull_request_closed_merged",
+		eventName:     "pull_request",
+		wantType:      cdeventsv05.ChangeMergedEventType,
+		wantSubjectId: "42",
+		wantSource:    testRepoURL,
+		wantContent:   api.ChangeMergedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-02T09:15:00Z"),
+	}, {
+		fixture:       "pull_request_closed",
+		eventName:     "pull_request",
+		wantType:      cdeventsv05.ChangeAbandonedEventType,
+		wantSubjectId: "42",
+		wantSource:    testRepoURL,
+		wantContent:   api.ChangeAbandonedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-02T12:00:00Z"),
+	}, {
+		fixture:       "pull_request_review_submitted",
+		eventName:     "pull_request_review",
+		wantType:      cdeventsv05.ChangeReviewedEventType,
+		wantSubjectId: "42",
+		wantSource:    testRepoURL,
+		wantContent:   api.ChangeReviewedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-01T15:45:00Z"),
+	}, {
+		fixture:       "push_branch_created",
+		eventName:     "push",
+		wantType:      cdeventsv05.BranchCreatedEventType,
+		wantSubjectId: "feature-x",
+		wantSource:    testRepoURL,
+		wantContent:   api.BranchCreatedSubjectContentV0_3_0{Repository: testRepoRef},
+	}, {
+		fixture:       "push_branch_deleted",
+		eventName:     "push",
+		wantType:      cdeventsv05.BranchDeletedEventType,
+		wantSubjectId: "feature-x",
+		wantSource:    testRepoURL,
+		wantContent:   api.BranchDeletedSubjectContentV0_3_0{Repository: testRepoRef},
+	}, {
+		fixture:       "create_branch",
+		eventName:     "create",
+		wantType:      cdeventsv05.BranchCreatedEventType,
+		wantSubjectId: "feature-x",
+		wantSource:    testRepoURL,
+		wantContent:   api.BranchCreatedSubjectContentV0_3_0{Repository: testRepoRef},
+	}, {
+		fixture:       "delete_branch",
+		eventName:     "delete",
+		wantType:      cdeventsv05.BranchDeletedEventType,
+		wantSubjectId: "feature-x",
+		wantSource:    testRepoURL,
+		wantContent:   api.BranchDeletedSubjectContentV0_3_0{Repository: testRepoRef},
+	}, {
+		fixture:       "repository_created",
+		eventName:     "repository",
+		wantType:      cdeventsv05.RepositoryCreatedEventType,
+		wantSubjectId: "octo-org/hello-world",
+		wantSource:    testRepoURL,
+		wantContent: api.RepositoryCreatedSubjectContentV0_3_0{
+			Name:    "hello-world",
+			Owner:   "octo-org",
+			Uri:     "https://github.com/octo-org/hello-world.git",
+			ViewUrl: testRepoURL,
+		},
+	}, {
+		fixture:       "repository_renamed",
+		eventName:     "repository",
+		wantType:      cdeventsv05.RepositoryModifiedEventType,
+		wantSubjectId: "octo-org/hello-cdevents",
+		wantSource:    "https://github.com/octo-org/hello-cdevents",
+		wantContent: api.RepositoryModifiedSubjectContentV0_3_0{
+			Name:    "hello-cdevents",
+			Owner:   "octo-org",
+			Uri:     "https://github.com/octo-org/hello-cdevents.git",
+			ViewUrl: "https://github.com/octo-org/hello-cdevents",
+		},
+	}, {
+		fixture:       "repository_deleted",
+		eventName:     "repository",
+		wantType:      cdeventsv05.RepositoryDeletedEventType,
+		wantSubjectId: "octo-org/hello-world",
+		wantSource:    testRepoURL,
+		wantContent: api.RepositoryDeletedSubjectContentV0_3_0{
+			Name:    "hello-world",
+			Owner:   "octo-org",
+			Uri:     "https://github.com/octo-org/hello-world.git",
+			ViewUrl: testRepoURL,
+		},
+	}}
+	adapter, err := github.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := adapter.Convert(tc.eventName, loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected one event, got %d", len(events))
+			}
+			event := events[0]
+			if d := cmp.Diff(tc.wantType, event.GetType()); d != "" {
+				t.Errorf("type diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSource, event.GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSubjectId, event.GetSubjectId()); d != "" {
+				t.Errorf("subject id diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantContent, event.GetSubjectContent()); d != "" {
+				t.Errorf("content diff(-want,+got):\n%s", d)
+			}
+			if !tc.wantTimestamp.IsZero() && !tc.wantTimestamp.Equal(event.GetTimestamp()) {
+				t.Errorf("timestamp %v, want %v", event.GetTimestamp(), tc.wantTimestamp)
+			}
+			if err := api.Validate(event); err != nil {
+				t.Errorf("produced event is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestConvertV04(t *testing.T) {
+	adapter, err := github.NewAdapter(
+		github.WithSpecVersion(cdeventsv04.SpecVersion),
+		github.WithSource("/github/webhook"))
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	tests := []struct {
+		fixture     string
+		eventName   string
+		wantType    api.CDEventType
+		wantContent interface{}
+	}{{
+		fixture:     "pull_request_opened",
+		eventName:   "pull_request",
+		wantType:    cdeventsv04.ChangeCreatedEventType,
+		wantContent: api.ChangeCreatedSubjectContentV0_3_0{Description: testTitle, Repository: testRepoRef},
+	}, {
+		fixture:     "delete_branch",
+		eventName:   "delete",
+		wantType:    cdeventsv04.BranchDeletedEventType,
+		wantContent: api.BranchDeletedSubjectContentV0_2_0{Repository: testRepoRef},
+	}, {
+		fixture:   "repository_created",
+		eventName: "repository",
+		wantType:  cdeventsv04.RepositoryCreatedEventType,
+		wantContent: api.RepositoryCreatedSubjectContentV0_2_0{
+			Name:    "hello-world",
+			Owner:   "octo-org",
+			Url:     "https://github.com/octo-org/hello-world.git",
+			ViewUrl: testRepoURL,
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := adapter.Convert(tc.eventName, loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected one event, got %d", len(events))
+			}
+			event := events[0]
+			if d := cmp.Diff(tc.wantType, event.GetType()); d != "" {
+				t.Errorf("type diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff("/github/webhook", event.GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(cdeventsv04.SpecVersion, event.GetVersion()); d != "" {
+				t.Errorf("spec version diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantContent, event.GetSubjectContent()); d != "" {
+				t.Errorf("content diff(-want,+got):\n%s", d)
+			}
+			if err := api.Validate(event); err != nil {
+				t.Errorf("produced event is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestConvertIgnored(t *testing.T) {
+	tests := []struct {
+		fixture   string
+		eventName string
+	}{{
+		fixture:   "pull_request_labeled",
+		eventName: "pull_request",
+	}, {
+		fixture:   "push_commits",
+		eventName: "push",
+	}, {
+		fixture:   "push_tag",
+		eventName: "push",
+	}, {
+		fixture:   "create_tag",
+		eventName: "create",
+	}}
+	adapter, err := github.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := adapter.Convert(tc.eventName, loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 0 {
+				t.Errorf("expected no events, got %d", len(events))
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	adapter, err := github.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	_, err = adapter.Convert("issues", []byte(`{}`))
+	if !errors.Is(err, github.ErrUnsupportedEvent) {
+		t.Errorf("expected ErrUnsupportedEvent, got %v", err)
+	}
+	_, err = adapter.Convert("pull_request", []byte(`{not json}`))
+	if err == nil {
+		t.Error("expected an error for a malformed payload, got none")
+	}
+}
+
+func TestNewAdapterUnsupportedSpecVersion(t *testing.T) {
+	_, err := github.NewAdapter(github.WithSpecVersion("0.3.0"))
+	if err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	payload := loadFixture(t, "pull_request_opened")
+	tests := []struct {
+		name      string
+		signature string
+		wantError error
+	}{{
+		name:      "valid signature",
+		signature: github.Sign(testSecret, payload),
+	}, {
+		name:      "wrong secret",
+		signature: github.Sign([]byte("wrong"), payload),
+		wantError: github.ErrInvalidSignature,
+	}, {
+		name:      "missing signature",
+		signature: "",
+		wantError: github.ErrInvalidSignature,
+	}}
+	adapter, err := github.NewAdapter(github.WithSecret(testSecret))
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+			req.Header.Set(github.EventHeader, "pull_request")
+			req.Header.Set(github.DeliveryHeader, "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+			if tc.signature != "" {
+				req.Header.Set(github.SignatureHeader, tc.signature)
+			}
+			events, err := adapter.ParseRequest(req)
+			if !errors.Is(err, tc.wantError) {
+				t.Fatalf("error %v, want %v", err, tc.wantError)
+			}
+			if tc.wantError != nil {
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected one event, got %d", len(events))
+			}
+			if d := cmp.Diff("72d3162e-cc78-11e3-81ab-4c9367dc0958", events[0].GetId()); d != "" {
+				t.Errorf("event id diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package github
+
+import (
+	"net/url"
+	"time"
+)
+
+// The types in this file hold the subset of the GitHub webhook payloads
+// used to build CDEvents.
+// Spec: https://docs.github.com/en/webhooks/webhook-events-and-payloads
+
+type user struct {
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type repository struct {
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	Owner       user   `json:"owner"`
+	HTMLURL     string `json:"html_url"`
+	CloneURL    string `json:"clone_url"`
+	Description string `json:"description"`
+}
+
+// owner returns the login of the repository owner. Push payloads only
+// set the name, all other payloads only set the login.
+func (r repository) owner() string {
+	if r.Owner.Login != "" {
+		return r.Owner.Login
+	}
+	return r.Owner.Name
+}
+
+// serverURL returns the base URL of the GitHub server hosting the
+// repository, e.g. https://github.com
+func (r repository) serverURL() string {
+	u, err := url.Parse(r.HTMLURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+type pullRequest struct {
+	Number    int        `json:"number"`
+	HTMLURL   string     `json:"html_url"`
+	Title     string     `json:"title"`
+	Merged    bool       `json:"merged"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+}
+
+type pullRequestEvent struct {
+	Action      string      `json:"action"`
+	PullRequest pullRequest `json:"pull_request"`
+	Repository  repository  `json:"repository"`
+}
+
+type review struct {
+	State       string     `json:"state"`
+	SubmittedAt *time.Time `json:"submitted_at"`
+}
+
+type pullRequestReviewEvent struct {
+	Action      string      `json:"action"`
+	Review      review      `json:"review"`
+	PullRequest pullRequest `json:"pull_request"`
+	Repository  repository  `json:"repository"`
+}
+
+type pushEvent struct {
+	Ref        string     `json:"ref"`
+	Created    bool       `json:"created"`
+	Deleted    bool       `json:"deleted"`
+	Repository repository `json:"repository"`
+}
+
+type refEvent struct {
+	Ref        string     `json:"ref"`
+	RefType    string     `json:"ref_type"`
+	Repository repository `json:"repository"`
+}
+
+type repositoryEvent struct {
+	Action     string     `json:"action"`
+	Repository repository `json:"repository"`
+}
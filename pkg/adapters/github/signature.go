@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const (
+	// SignatureHeader is the HTTP header that holds the HMAC-SHA256
+	// signature of the webhook payload
+	SignatureHeader = "X-Hub-Signature-256"
+
+	signaturePrefix = "sha256="
+)
+
+// ErrInvalidSignature is returned when the webhook signature is missing,
+// malformed or does not match the payload
+var ErrInvalidSignature = errors.New("invalid GitHub webhook signature")
+
+// Sign returns the value of the X-Hub-Signature-256 header for payload
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that signature, the value of the
+// X-Hub-Signature-256 header, is the signature of payload with secret.
+// Spec: https://docs.github.com/en/webhooks/using-webhooks/validating-webhook-deliveries
+func VerifySignature(secret, payload []byte, signature string) error {
+	hexSignature, found := strings.CutPrefix(signature, signaturePrefix)
+	if !found {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package github_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/github"
+)
+
+func TestVerifySignature(t *testing.T) {
+	// Test vector from the GitHub documentation
+	payload := []byte("Hello, World!")
+	validSignature := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		wantError bool
+	}{{
+		name:      "valid",
+		payload:   payload,
+		signature: validSignature,
+	}, {
+		name:      "tampered payload",
+		payload:   []byte("Hello, World?"),
+		signature: validSignature,
+		wantError: true,
+	}, {
+		name:      "sha1 signature",
+		payload:   payload,
+		signature: "sha1=01dc10d0c83e72ed246219cdd91669667fe2ca59",
+		wantError: true,
+	}, {
+		name:      "not hex",
+		payload:   payload,
+		signature: "sha256=not-hex",
+		wantError: true,
+	}, {
+		name:      "empty",
+		payload:   payload,
+		signature: "",
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := github.VerifySignature(testSecret, tc.payload, tc.signature)
+			if tc.wantError && !errors.Is(err, github.ErrInvalidSignature) {
+				t.Errorf("expected ErrInvalidSignature, got %v", err)
+			}
+			if !tc.wantError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	payload := []byte(`{"zen":"Keep it logically awesome."}`)
+	if err := github.VerifySignature(testSecret, payload, github.Sign(testSecret, payload)); err != nil {
+		t.Errorf("signature produced by Sign not valid: %v", err)
+	}
+}
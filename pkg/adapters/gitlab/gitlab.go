@@ -0,0 +1,425 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package gitlab converts GitLab webhook payloads into CDEvents v0.5.
+//
+// The following hooks are supported:
+//
+//   - merge request: ChangeCreated, ChangeUpdated, ChangeReviewed, ChangeMerged, ChangeAbandoned
+//   - push: BranchCreated, BranchDeleted
+//   - pipeline: PipelineRunQueued, PipelineRunStarted, PipelineRunFinished
+//   - job: TaskRunStarted, TaskRunFinished
+//
+// Tag push hooks are accepted but produce no events, since CDEvents
+// does not define tag events.
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+)
+
+const (
+	// TokenHeader is the HTTP header that holds the secret token of the webhook
+	TokenHeader = "X-Gitlab-Token"
+
+	// EventUUIDHeader is the HTTP header that holds the unique id of the hook
+	EventUUIDHeader = "X-Gitlab-Event-UUID"
+
+	// maxPayloadSize is the maximum size of a webhook payload accepted
+	maxPayloadSize = 25 * 1024 * 1024
+
+	branchRefPrefix = "refs/heads/"
+
+	// zeroSHA is used by GitLab as before (after) commit when a branch is
+	// created (deleted)
+	zeroSHA = "0000000000000000000000000000000000000000"
+)
+
+var (
+	// ErrUnsupportedEvent is returned for hooks that have no CDEvents mapping
+	ErrUnsupportedEvent = errors.New("unsupported GitLab webhook event")
+
+	// ErrInvalidToken is returned when the X-Gitlab-Token header is missing
+	// or does not match the configured token
+	ErrInvalidToken = errors.New("invalid GitLab webhook token")
+)
+
+// Adapter converts GitLab webhook payloads into CDEvents
+type Adapter struct {
+	source string
+	token  string
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events. By default, the
+// web URL of the project is used.
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithToken sets the secret token used to verify the X-Gitlab-Token header
+// in ParseRequest. When no token is set, requests are not verified.
+func WithToken(token string) Option {
+	return func(a *Adapter) {
+		a.token = token
+	}
+}
+
+// NewAdapter creates a new GitLab webhook Adapter
+func NewAdapter(opts ...Option) *Adapter {
+	a := &Adapter{}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// VerifyToken checks that token, the value of the X-Gitlab-Token header,
+// matches the expected one
+func VerifyToken(expected, token string) error {
+	if token == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(token)) != 1 {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// ParseRequest verifies the token of a webhook request, if one is
+// configured, and converts its payload into CDEvents
+func (a *Adapter) ParseRequest(r *http.Request) ([]api.CDEventV04, error) {
+	if a.token != "" {
+		if err := VerifyToken(a.token, r.Header.Get(TokenHeader)); err != nil {
+			return nil, err
+		}
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the webhook payload: %w", err)
+	}
+	events, err := a.Convert(payload)
+	if err != nil {
+		return nil, err
+	}
+	// Retries share the event UUID, so it's a suitable event id
+	if eventUUID := r.Header.Get(EventUUIDHeader); eventUUID != "" && len(events) == 1 {
+		events[0].SetId(eventUUID)
+	}
+	return events, nil
+}
+
+// Convert converts the payload of a webhook into CDEvents. The kind of hook
+// is read from the object_kind field of the payload. States that have no
+// CDEvents equivalent, like a pipeline waiting for a manual action, produce
+// no events and no error. Hooks without a mapping return ErrUnsupportedEvent.
+func (a *Adapter) Convert(payload []byte) ([]api.CDEventV04, error) {
+	var kind objectKind
+	if err := json.Unmarshal(payload, &kind); err != nil {
+		return nil, fmt.Errorf("cannot parse the webhook payload: %w", err)
+	}
+	var event api.CDEventV04
+	var err error
+	switch kind.ObjectKind {
+	case "merge_request":
+		event, err = a.convertMergeRequest(payload)
+	case "push":
+		event, err = a.convertPush(payload)
+	case "tag_push":
+		return nil, nil
+	case "pipeline":
+		event, err = a.convertPipeline(payload)
+	case "build":
+		event, err = a.convertJob(payload)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedEvent, kind.ObjectKind)
+	}
+	if err != nil || event == nil {
+		return nil, err
+	}
+	return []api.CDEventV04{event}, nil
+}
+
+func (a *Adapter) convertMergeRequest(payload []byte) (api.CDEventV04, error) {
+	var hook mergeRequestHook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("cannot parse merge request payload: %w", err)
+	}
+	mr := hook.ObjectAttributes
+	repository := repositoryReference(hook.Project)
+	var event api.CDEventV04
+	var err error
+	switch mr.Action {
+	case "open":
+		var e *cdeventsv05.ChangeCreatedEvent
+		if e, err = cdeventsv05.NewChangeCreatedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			e.SetSubjectDescription(mr.Title)
+			setTimestamp(e, mr.CreatedAt)
+			event = e
+		}
+	case "update", "reopen":
+		var e *cdeventsv05.ChangeUpdatedEvent
+		if e, err = cdeventsv05.NewChangeUpdatedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			setTimestamp(e, mr.UpdatedAt)
+			event = e
+		}
+	case "approved", "approval":
+		var e *cdeventsv05.ChangeReviewedEvent
+		if e, err = cdeventsv05.NewChangeReviewedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			setTimestamp(e, mr.UpdatedAt)
+			event = e
+		}
+	case "merge":
+		var e *cdeventsv05.ChangeMergedEvent
+		if e, err = cdeventsv05.NewChangeMergedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			setTimestamp(e, mr.UpdatedAt)
+			event = e
+		}
+	case "close":
+		var e *cdeventsv05.ChangeAbandonedEvent
+		if e, err = cdeventsv05.NewChangeAbandonedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			setTimestamp(e, mr.UpdatedAt)
+			event = e
+		}
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.setSource(event, hook.Project)
+	event.SetSubjectId(strconv.FormatInt(mr.Iid, 10))
+	setSubjectSource(event, hook.Project.WebURL)
+	return event, nil
+}
+
+func (a *Adapter) convertPush(payload []byte) (api.CDEventV04, error) {
+	var hook pushHook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("cannot parse push payload: %w", err)
+	}
+	branch, isBranch := strings.CutPrefix(hook.Ref, branchRefPrefix)
+	if !isBranch {
+		return nil, nil
+	}
+	repository := repositoryReference(hook.Project)
+	var event api.CDEventV04
+	var err error
+	switch {
+	case hook.Before == zeroSHA:
+		var e *cdeventsv05.BranchCreatedEvent
+		if e, err = cdeventsv05.NewBranchCreatedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			event = e
+		}
+	case hook.After == zeroSHA:
+		var e *cdeventsv05.BranchDeletedEvent
+		if e, err = cdeventsv05.NewBranchDeletedEvent(); err == nil {
+			e.SetSubjectRepository(repository)
+			event = e
+		}
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.setSource(event, hook.Project)
+	event.SetSubjectId(branch)
+	setSubjectSource(event, hook.Project.WebURL)
+	return event, nil
+}
+
+func (a *Adapter) convertPipeline(payload []byte) (api.CDEventV04, error) {
+	var hook pipelineHook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("cannot parse pipeline payload: %w", err)
+	}
+	pipeline := hook.ObjectAttributes
+	name := pipeline.Name
+	if name == "" {
+		name = hook.Project.PathWithNamespace
+	}
+	uri := pipeline.URL
+	if uri == "" {
+		uri = hook.Project.WebURL + "/-/pipelines/" + strconv.FormatInt(pipeline.Id, 10)
+	}
+	var event api.CDEventV04
+	var err error
+	switch pipeline.Status {
+	case "created", "waiting_for_resource", "preparing", "pending", "scheduled":
+		var e *cdeventsv05.PipelineRunQueuedEvent
+		if e, err = cdeventsv05.NewPipelineRunQueuedEvent(); err == nil {
+			e.SetSubjectPipelineName(name)
+			e.SetSubjectUri(uri)
+			setTimestamp(e, pipeline.CreatedAt)
+			event = e
+		}
+	case "running":
+		var e *cdeventsv05.PipelineRunStartedEvent
+		if e, err = cdeventsv05.NewPipelineRunStartedEvent(); err == nil {
+			e.SetSubjectPipelineName(name)
+			e.SetSubjectUri(uri)
+			event = e
+		}
+	case "success", "failed", "canceled":
+		var e *cdeventsv05.PipelineRunFinishedEvent
+		if e, err = cdeventsv05.NewPipelineRunFinishedEvent(); err == nil {
+			e.SetSubjectPipelineName(name)
+			e.SetSubjectUri(uri)
+			e.SetSubjectOutcome(outcome(pipeline.Status))
+			e.SetSubjectErrors(pipelineErrors(hook.Builds))
+			setTimestamp(e, pipeline.FinishedAt)
+			event = e
+		}
+	default:
+		// e.g. "manual" and "skipped" pipelines did not run
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.setSource(event, hook.Project)
+	event.SetSubjectId(strconv.FormatInt(pipeline.Id, 10))
+	setSubjectSource(event, hook.Project.WebURL)
+	return event, nil
+}
+
+func (a *Adapter) convertJob(payload []byte) (api.CDEventV04, error) {
+	var hook jobHook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("cannot parse job payload: %w", err)
+	}
+	uri := hook.Project.WebURL + "/-/jobs/" + strconv.FormatInt(hook.BuildId, 10)
+	pipelineRun := &api.Reference{
+		Id:     strconv.FormatInt(hook.PipelineId, 10),
+		Source: hook.Project.WebURL,
+	}
+	var event api.CDEventV04
+	var err error
+	switch hook.BuildStatus {
+	case "running":
+		var e *cdeventsv05.TaskRunStartedEvent
+		if e, err = cdeventsv05.NewTaskRunStartedEvent(); err == nil {
+			e.SetSubjectTaskName(hook.BuildName)
+			e.SetSubjectUri(uri)
+			e.SetSubjectPipelineRun(pipelineRun)
+			setTimestamp(e, hook.BuildStartedAt)
+			event = e
+		}
+	case "success", "failed", "canceled":
+		var e *cdeventsv05.TaskRunFinishedEvent
+		if e, err = cdeventsv05.NewTaskRunFinishedEvent(); err == nil {
+			e.SetSubjectTaskName(hook.BuildName)
+			e.SetSubjectUri(uri)
+			e.SetSubjectPipelineRun(pipelineRun)
+			// Task run outcomes are free text, with the pipeline run values
+			e.SetSubjectOutcome(string(outcome(hook.BuildStatus)))
+			if hook.BuildStatus == "failed" {
+				e.SetSubjectErrors(hook.BuildFailureReason)
+			}
+			setTimestamp(e, hook.BuildFinishedAt)
+			event = e
+		}
+	default:
+		// CDEvents has no queued event for tasks
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.setSource(event, hook.Project)
+	event.SetSubjectId(strconv.FormatInt(hook.BuildId, 10))
+	setSubjectSource(event, hook.Project.WebURL)
+	return event, nil
+}
+
+func (a *Adapter) setSource(event api.CDEventV04, p project) {
+	source := a.source
+	if source == "" {
+		source = p.WebURL
+	}
+	event.SetSource(source)
+}
+
+// setSubjectSource overrides the subject source, which defaults to the
+// event source, only when the payload provides one
+func setSubjectSource(event api.CDEventV04, subjectSource string) {
+	if subjectSource != "" {
+		event.SetSubjectSource(subjectSource)
+	}
+}
+
+// setTimestamp sets the time of the occurrence, when the payload provides one
+func setTimestamp(event api.CDEventV04, timestamp gitlabTime) {
+	if !timestamp.IsZero() {
+		event.SetTimestamp(timestamp.UTC().Truncate(time.Second))
+	}
+}
+
+func repositoryReference(p project) *api.Reference {
+	return &api.Reference{
+		Id:     p.PathWithNamespace,
+		Source: p.serverURL(),
+	}
+}
+
+// outcome maps a GitLab pipeline or job status to a CDEvents outcome
+func outcome(status string) cdeventsv05.PipelineRunFinishedSubjectContentOutcome {
+	switch status {
+	case "success":
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeSuccess
+	case "canceled":
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeCancel
+	default:
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeFailure
+	}
+}
+
+// pipelineErrors summarizes the failed jobs of a pipeline
+func pipelineErrors(builds []pipelineBuild) string {
+	failures := []string{}
+	for _, build := range builds {
+		if build.Status != "failed" {
+			continue
+		}
+		failure := build.Name
+		if build.FailureReason != "" {
+			failure += ": " + build.FailureReason
+		}
+		failures = append(failures, failure)
+	}
+	return strings.Join(failures, "; ")
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gitlab_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/gitlab"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testProjectURL  = "https://gitlab.example.com/acme/hello-world"
+	testPipelineURL = testProjectURL + "/-/pipelines/31"
+	testJobURL      = testProjectURL + "/-/jobs/381"
+	testTitle       = "Add a greeting for CDEvents users"
+	testToken       = "It's a Secret to Everybody"
+)
+
+var (
+	testRepoRef     = &api.Reference{Id: "acme/hello-world", Source: "https://gitlab.example.com"}
+	testPipelineRef = &api.Reference{Id: "31", Source: testProjectURL}
+)
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		fixture       string
+		wantType      api.CDEventType
+		wantSubjectId string
+		wantContent   interface{}
+		wantTimestamp time.Time
+	}{{
+		fixture:       "merge_request_open",
+		wantType:      cdeventsv05.ChangeCreatedEventType,
+		wantSubjectId: "7",
+		wantContent:   api.ChangeCreatedSubjectContentV0_4_0{Description: testTitle, Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:00Z"),
+	}, {
+		fixture:       "merge_request_update",
+		wantType:      cdeventsv05.ChangeUpdatedEventType,
+		wantSubjectId: "7",
+		wantContent:   api.ChangeUpdatedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-01T11:30:00Z"),
+	}, {
+		fixture:       "merge_request_approved",
+		wantType:      cdeventsv05.ChangeReviewedEventType,
+		wantSubjectId: "7",
+		wantContent:   api.ChangeReviewedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-01T15:45:00Z"),
+	}, {
+		fixture:       "merge_request_merge",
+		wantType:      cdeventsv05.ChangeMergedEventType,
+		wantSubjectId: "7",
+		wantContent:   api.ChangeMergedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-02T09:15:00Z"),
+	}, {
+		fixture:       "merge_request_close",
+		wantType:      cdeventsv05.ChangeAbandonedEventType,
+		wantSubjectId: "7",
+		wantContent:   api.ChangeAbandonedSubjectContentV0_3_0{Repository: testRepoRef},
+		wantTimestamp: mustParseTime("2024-03-02T12:00:00Z"),
+	}, {
+		fixture:       "push_branch_created",
+		wantType:      cdeventsv05.BranchCreatedEventType,
+		wantSubjectId: "feature-x",
+		wantContent:   api.BranchCreatedSubjectContentV0_3_0{Repository: testRepoRef},
+	}, {
+		fixture:       "push_branch_deleted",
+		wantType:      cdeventsv05.BranchDeletedEventType,
+		wantSubjectId: "feature-x",
+		wantContent:   api.BranchDeletedSubjectContentV0_3_0{Repository: testRepoRef},
+	}, {
+		fixture:       "pipeline_pending",
+		wantType:      cdeventsv05.PipelineRunQueuedEventType,
+		wantSubjectId: "31",
+		wantContent: api.PipelineRunQueuedSubjectContentV0_3_0{
+			PipelineName: "Build and test",
+			Uri:          testPipelineURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:05:00Z"),
+	}, {
+		fixture:       "pipeline_running",
+		wantType:      cdeventsv05.PipelineRunStartedEventType,
+		wantSubjectId: "31",
+		wantContent: api.PipelineRunStartedSubjectContentV0_3_0{
+			PipelineName: "Build and test",
+			Uri:          testPipelineURL,
+		},
+	}, {
+		fixture:       "pipeline_success",
+		wantType:      cdeventsv05.PipelineRunFinishedEventType,
+		wantSubjectId: "31",
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Outcome:      "success",
+			PipelineName: "Build and test",
+			Uri:          testPipelineURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:08:00Z"),
+	}, {
+		fixture:       "pipeline_failed",
+		wantType:      cdeventsv05.PipelineRunFinishedEventType,
+		wantSubjectId: "31",
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Errors:       "unit-test: script_failure; lint: job_execution_timeout",
+			Outcome:      "failure",
+			PipelineName: "Build and test",
+			Uri:          testPipelineURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:09:00Z"),
+	}, {
+		fixture:       "pipeline_canceled",
+		wantType:      cdeventsv05.PipelineRunFinishedEventType,
+		wantSubjectId: "31",
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Outcome:      "cancel",
+			PipelineName: "Build and test",
+			Uri:          testPipelineURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:05:30Z"),
+	}, {
+		fixture:       "job_running",
+		wantType:      cdeventsv05.TaskRunStartedEventType,
+		wantSubjectId: "381",
+		wantContent: api.TaskRunStartedSubjectContentV0_3_0{
+			PipelineRun: testPipelineRef,
+			TaskName:    "unit-test",
+			Uri:         testJobURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:06:05Z"),
+	}, {
+		fixture:       "job_success",
+		wantType:      cdeventsv05.TaskRunFinishedEventType,
+		wantSubjectId: "381",
+		wantContent: api.TaskRunFinishedSubjectContentV0_3_0{
+			Outcome:     "success",
+			PipelineRun: testPipelineRef,
+			TaskName:    "unit-test",
+			Uri:         testJobURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:08:00Z"),
+	}, {
+		fixture:       "job_failed",
+		wantType:      cdeventsv05.TaskRunFinishedEventType,
+		wantSubjectId: "381",
+		wantContent: api.TaskRunFinishedSubjectContentV0_3_0{
+			Errors:      "script_failure",
+			Outcome:     "failure",
+			PipelineRun: testPipelineRef,
+			TaskName:    "unit-test",
+			Uri:         testJobURL,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:08:00Z"),
+	}}
+	adapter := gitlab.NewAdapter()
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := adapter.Convert(loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected one event, got %d", len(events))
+			}
+			event := events[0]
+			if d := cmp.Diff(tc.wantType, event.GetType()); d != "" {
+				t.Errorf("type diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(testProjectURL, event.GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(testProjectURL, event.GetSubjectSource()); d != "" {
+				t.Errorf("subject source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSubjectId, event.GetSubjectId()); d != "" {
+				t.Errorf("subject id diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantContent, event.GetSubjectContent()); d != "" {
+				t.Errorf("content diff(-want,+got):\n%s", d)
+			}
+			if !tc.wantTimestamp.IsZero() && !tc.wantTimestamp.Equal(event.GetTimestamp()) {
+				t.Errorf("timestamp %v, want %v", event.GetTimestamp(), tc.wantTimestamp)
+			}
+			if err := api.Validate(event); err != nil {
+				t.Errorf("produced event is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestConvertWithSource(t *testing.T) {
+	adapter := gitlab.NewAdapter(gitlab.WithSource("/gitlab/webhook"))
+	events, err := adapter.Convert(loadFixture(t, "merge_request_open"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected one event, got %d", len(events))
+	}
+	if d := cmp.Diff("/gitlab/webhook", events[0].GetSource()); d != "" {
+		t.Errorf("source diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(testProjectURL, events[0].GetSubjectSource()); d != "" {
+		t.Errorf("subject source diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestConvertIgnored(t *testing.T) {
+	tests := []string{
+		"merge_request_unapproved",
+		"push_commits",
+		"tag_push",
+		"pipeline_manual",
+		"job_pending",
+	}
+	adapter := gitlab.NewAdapter()
+	for _, fixture := range tests {
+		t.Run(fixture, func(t *testing.T) {
+			events, err := adapter.Convert(loadFixture(t, fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 0 {
+				t.Errorf("expected no events, got %d", len(events))
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	adapter := gitlab.NewAdapter()
+	_, err := adapter.Convert([]byte(`{"object_kind": "issue"}`))
+	if !errors.Is(err, gitlab.ErrUnsupportedEvent) {
+		t.Errorf("expected ErrUnsupportedEvent, got %v", err)
+	}
+	_, err = adapter.Convert([]byte(`{not json}`))
+	if err == nil {
+		t.Error("expected an error for a malformed payload, got none")
+	}
+	_, err = adapter.Convert([]byte(`{"object_kind": "pipeline", "object_attributes": {"created_at": "yesterday"}}`))
+	if err == nil {
+		t.Error("expected an error for a malformed time, got none")
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	payload := loadFixture(t, "pipeline_running")
+	tests := []struct {
+		name      string
+		token     string
+		wantError error
+	}{{
+		name:  "valid token",
+		token: testToken,
+	}, {
+		name:      "wrong token",
+		token:     "wrong",
+		wantError: gitlab.ErrInvalidToken,
+	}, {
+		name:      "missing token",
+		token:     "",
+		wantError: gitlab.ErrInvalidToken,
+	}}
+	adapter := gitlab.NewAdapter(gitlab.WithToken(testToken))
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+			req.Header.Set(gitlab.EventUUIDHeader, "13792a34-cac6-4fda-95a8-c58e00a3954e")
+			if tc.token != "" {
+				req.Header.Set(gitlab.TokenHeader, tc.token)
+			}
+			events, err := adapter.ParseRequest(req)
+			if !errors.Is(err, tc.wantError) {
+				t.Fatalf("error %v, want %v", err, tc.wantError)
+			}
+			if tc.wantError != nil {
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected one event, got %d", len(events))
+			}
+			if d := cmp.Diff("13792a34-cac6-4fda-95a8-c58e00a3954e", events[0].GetId()); d != "" {
+				t.Errorf("event id diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
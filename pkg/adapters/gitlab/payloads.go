@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The types in this file hold the subset of the GitLab webhook payloads
+// used to build CDEvents.
+// Spec: https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html
+
+// gitlabTimeFormats lists the time formats found in GitLab payloads.
+// Depending on the hook and the GitLab version, times are rendered in
+// either RFC3339 or in a custom format with the UTC suffix.
+var gitlabTimeFormats = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+}
+
+type gitlabTime struct {
+	time.Time
+}
+
+func (t *gitlabTime) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "null" || value == "" {
+		return nil
+	}
+	for _, format := range gitlabTimeFormats {
+		parsed, err := time.Parse(format, value)
+		if err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse GitLab time %q", value)
+}
+
+type project struct {
+	Id                int64  `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+}
+
+// serverURL returns the base URL of the GitLab server hosting the
+// project, e.g. https://gitlab.com
+func (p project) serverURL() string {
+	u, err := url.Parse(p.WebURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+type objectKind struct {
+	ObjectKind string `json:"object_kind"`
+}
+
+type mergeRequestAttributes struct {
+	Id        int64      `json:"id"`
+	Iid       int64      `json:"iid"`
+	Title     string     `json:"title"`
+	URL       string     `json:"url"`
+	Action    string     `json:"action"`
+	CreatedAt gitlabTime `json:"created_at"`
+	UpdatedAt gitlabTime `json:"updated_at"`
+}
+
+type mergeRequestHook struct {
+	Project          project                `json:"project"`
+	ObjectAttributes mergeRequestAttributes `json:"object_attributes"`
+}
+
+type pushHook struct {
+	Ref     string  `json:"ref"`
+	Before  string  `json:"before"`
+	After   string  `json:"after"`
+	Project project `json:"project"`
+}
+
+type pipelineBuild struct {
+	Id            int64  `json:"id"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason"`
+}
+
+type pipelineAttributes struct {
+	Id         int64      `json:"id"`
+	Iid        int64      `json:"iid"`
+	Name       string     `json:"name"`
+	Ref        string     `json:"ref"`
+	Status     string     `json:"status"`
+	URL        string     `json:"url"`
+	CreatedAt  gitlabTime `json:"created_at"`
+	FinishedAt gitlabTime `json:"finished_at"`
+}
+
+type pipelineHook struct {
+	Project          project            `json:"project"`
+	ObjectAttributes pipelineAttributes `json:"object_attributes"`
+	Builds           []pipelineBuild    `json:"builds"`
+}
+
+type jobHook struct {
+	BuildId            int64      `json:"build_id"`
+	BuildName          string     `json:"build_name"`
+	BuildStatus        string     `json:"build_status"`
+	BuildCreatedAt     gitlabTime `json:"build_created_at"`
+	BuildStartedAt     gitlabTime `json:"build_started_at"`
+	BuildFinishedAt    gitlabTime `json:"build_finished_at"`
+	BuildFailureReason string     `json:"build_failure_reason"`
+	PipelineId         int64      `json:"pipeline_id"`
+	Project            project    `json:"project"`
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package gotest converts the output of `go test -json` into CDEvents v0.5.
+//
+// Each package produces a TestSuiteRunStarted and a TestSuiteRunFinished
+// event, and each test or subtest a TestCaseRunStarted event followed by
+// either a TestCaseRunFinished or a TestCaseRunSkipped event. Events are
+// streamed through a sender.Sender as soon as the corresponding test2json
+// action is read.
+//
+// Subtests are reported as test cases of their own, identified by their
+// full name, e.g. TestGreet/empty_name.
+package gotest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxOutputSize is the maximum size of the output attached to an event.
+	// The end of the output is kept, since that's where failures are.
+	maxOutputSize = 64 * 1024
+
+	// maxLineSize is the maximum size of a line of test2json output
+	maxLineSize = 1024 * 1024
+
+	// actionIncomplete is used internally to finish runs that were still
+	// running at the end of the output
+	actionIncomplete = "incomplete"
+)
+
+// TestEvent is an event produced by test2json, i.e. a line of the output
+// of `go test -json`. Spec: https://pkg.go.dev/cmd/test2json
+type TestEvent struct {
+	Time        time.Time `json:",omitempty"`
+	Action      string
+	Package     string  `json:",omitempty"`
+	Test        string  `json:",omitempty"`
+	Elapsed     float64 `json:",omitempty"`
+	Output      string  `json:",omitempty"`
+	FailedBuild string  `json:",omitempty"`
+}
+
+// Output is the custom data attached to finished and skipped events when
+// WithOutput is used
+type Output struct {
+	Output string `json:"output"`
+}
+
+type run struct {
+	output strings.Builder
+}
+
+// append adds a line of output, keeping at most maxOutputSize bytes
+func (r *run) append(output string) {
+	r.output.WriteString(output)
+	if r.output.Len() > maxOutputSize {
+		tail := r.output.String()[r.output.Len()-maxOutputSize:]
+		r.output.Reset()
+		r.output.WriteString(tail)
+	}
+}
+
+type packageRun struct {
+	run
+	tests map[string]*run
+	// order of the running tests, to close them deterministically
+	order  []string
+	failed int
+}
+
+// Converter converts test2json events into CDEvents and sends them.
+// A Converter is not safe for concurrent use.
+type Converter struct {
+	sender      sender.Sender
+	source      string
+	environment *api.Reference
+	chainId     string
+	withOutput  bool
+	packages    map[string]*packageRun
+}
+
+// Option configures a Converter
+type Option func(*Converter)
+
+// WithSource sets the source of the produced events
+func WithSource(source string) Option {
+	return func(c *Converter) {
+		c.source = source
+	}
+}
+
+// WithEnvironment sets the environment where the tests run. It is required,
+// since the test events must reference an environment.
+func WithEnvironment(environment *api.Reference) Option {
+	return func(c *Converter) {
+		c.environment = environment
+	}
+}
+
+// WithChainId sets the chainId of the produced events. By default, each
+// Converter starts a new chain.
+func WithChainId(chainId string) Option {
+	return func(c *Converter) {
+		c.chainId = chainId
+	}
+}
+
+// WithOutput attaches the output captured for a test or a package to its
+// finished or skipped event, as Output custom data
+func WithOutput() Option {
+	return func(c *Converter) {
+		c.withOutput = true
+	}
+}
+
+// NewConverter creates a new Converter that sends events with s
+func NewConverter(s sender.Sender, opts ...Option) (*Converter, error) {
+	c := &Converter{
+		sender:   s,
+		source:   "go-test",
+		packages: map[string]*packageRun{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.environment == nil || c.environment.Id == "" {
+		return nil, errors.New("an environment is required by the go test converter")
+	}
+	if c.chainId == "" {
+		chainUUID, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		c.chainId = chainUUID.String()
+	}
+	return c, nil
+}
+
+// Process reads the output of `go test -json` from r until EOF, sending the
+// corresponding events, and then closes the Converter. Lines that are not
+// test2json events, like build errors printed by go test, are ignored.
+func (c *Converter) Process(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+		var event TestEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			continue
+		}
+		if err := c.Handle(ctx, event); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("cannot read the go test output: %w", err)
+	}
+	return c.Close(ctx)
+}
+
+// Handle converts a single test2json event, sending the resulting CDEvents
+func (c *Converter) Handle(ctx context.Context, event TestEvent) error {
+	if event.Package == "" {
+		// e.g. build-output and build-fail actions, which are reported again
+		// through the FailedBuild field of the package
+		return nil
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	pkg, err := c.startPackage(ctx, event)
+	if err != nil {
+		return err
+	}
+	if event.Test == "" {
+		switch event.Action {
+		case "output":
+			pkg.append(event.Output)
+		case "pass", "fail", "skip":
+			return c.finishPackage(ctx, event, pkg)
+		}
+		return nil
+	}
+	switch event.Action {
+	case "run":
+		return c.startTest(ctx, event, pkg)
+	case "output":
+		if test, ok := pkg.tests[event.Test]; ok {
+			test.append(event.Output)
+		}
+	case "pass", "fail", "skip":
+		return c.finishTest(ctx, event, pkg)
+	}
+	return nil
+}
+
+// Close finishes the tests and packages that are still running, like when
+// go test is interrupted, with an error outcome
+func (c *Converter) Close(ctx context.Context) error {
+	names := make([]string, 0, len(c.packages))
+	for name := range c.packages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := c.finishPackage(ctx, TestEvent{
+			Time:    time.Now(),
+			Action:  actionIncomplete,
+			Package: name,
+		}, c.packages[name])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Converter) startPackage(ctx context.Context, event TestEvent) (*packageRun, error) {
+	if pkg, ok := c.packages[event.Package]; ok {
+		return pkg, nil
+	}
+	started, err := cdeventsv05.NewTestSuiteRunStartedEvent()
+	if err != nil {
+		return nil, err
+	}
+	started.SetSubjectId(event.Package)
+	started.SetSubjectEnvironment(c.environment)
+	started.SetSubjectTestSuite(&api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: event.Package, Name: event.Package})
+	if err := c.send(ctx, started, event.Time); err != nil {
+		return nil, err
+	}
+	pkg := &packageRun{tests: map[string]*run{}}
+	c.packages[event.Package] = pkg
+	return pkg, nil
+}
+
+func (c *Converter) finishPackage(ctx context.Context, event TestEvent, pkg *packageRun) error {
+	incomplete := 0
+	for _, name := range pkg.order {
+		if _, running := pkg.tests[name]; !running {
+			continue
+		}
+		err := c.finishTest(ctx, TestEvent{
+			Time:    event.Time,
+			Action:  actionIncomplete,
+			Package: event.Package,
+			Test:    name,
+		}, pkg)
+		if err != nil {
+			return err
+		}
+		incomplete++
+	}
+	delete(c.packages, event.Package)
+
+	finished, err := cdeventsv05.NewTestSuiteRunFinishedEvent()
+	if err != nil {
+		return err
+	}
+	finished.SetSubjectId(event.Package)
+	finished.SetSubjectEnvironment(c.environment)
+	finished.SetSubjectTestSuite(&api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: event.Package, Name: event.Package})
+	switch {
+	case event.FailedBuild != "":
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeError)
+		finished.SetSubjectReason("build failed: " + event.FailedBuild)
+	case event.Action == actionIncomplete || incomplete > 0:
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeError)
+		finished.SetSubjectReason("tests did not complete")
+	case event.Action == "fail" && pkg.failed > 0:
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeFailure)
+		finished.SetSubjectReason(plural(pkg.failed, "test") + " failed")
+	case event.Action == "fail":
+		// e.g. a panic in TestMain
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeFailure)
+		finished.SetSubjectReason(reason(pkg.output.String()))
+	case event.Action == "skip":
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeSuccess)
+		finished.SetSubjectReason("no test files")
+	default:
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeSuccess)
+	}
+	if err := c.setOutput(finished, &pkg.run); err != nil {
+		return err
+	}
+	return c.send(ctx, finished, event.Time)
+}
+
+func (c *Converter) startTest(ctx context.Context, event TestEvent, pkg *packageRun) error {
+	started, err := cdeventsv05.NewTestCaseRunStartedEvent()
+	if err != nil {
+		return err
+	}
+	started.SetSubjectId(testRunId(event))
+	started.SetSubjectEnvironment(c.environment)
+	started.SetSubjectTestCase(&api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{
+		Id:   testRunId(event),
+		Name: event.Test,
+		Type: testType(event.Test, cdeventsv05.TestCaseRunStartedSubjectContentTestCaseTypePerformance),
+	})
+	started.SetSubjectTestSuiteRun(c.packageReference(event))
+	if err := c.send(ctx, started, event.Time); err != nil {
+		return err
+	}
+	pkg.tests[event.Test] = &run{}
+	pkg.order = append(pkg.order, event.Test)
+	return nil
+}
+
+func (c *Converter) finishTest(ctx context.Context, event TestEvent, pkg *packageRun) error {
+	test, ok := pkg.tests[event.Test]
+	if !ok {
+		// The run action was not seen, e.g. the output is truncated
+		test = &run{}
+	}
+	delete(pkg.tests, event.Test)
+
+	if event.Action == "skip" {
+		skipped, err := cdeventsv05.NewTestCaseRunSkippedEvent()
+		if err != nil {
+			return err
+		}
+		skipped.SetSubjectId(testRunId(event))
+		skipped.SetSubjectEnvironment(c.environment)
+		skipped.SetSubjectTestCase(&api.TestCaseRunSkippedSubjectContentTestCaseV0_2_0{
+			Id:   testRunId(event),
+			Name: event.Test,
+			Type: testType(event.Test, cdeventsv05.TestCaseRunSkippedSubjectContentTestCaseTypePerformance),
+		})
+		skipped.SetSubjectTestSuiteRun(c.packageReference(event))
+		skipped.SetSubjectReason(reason(test.output.String()))
+		if err := c.setOutput(skipped, test); err != nil {
+			return err
+		}
+		return c.send(ctx, skipped, event.Time)
+	}
+
+	finished, err := cdeventsv05.NewTestCaseRunFinishedEvent()
+	if err != nil {
+		return err
+	}
+	finished.SetSubjectId(testRunId(event))
+	finished.SetSubjectEnvironment(c.environment)
+	finished.SetSubjectTestCase(&api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{
+		Id:   testRunId(event),
+		Name: event.Test,
+		Type: testType(event.Test, cdeventsv05.TestCaseRunFinishedSubjectContentTestCaseTypePerformance),
+	})
+	finished.SetSubjectTestSuiteRun(c.packageReference(event))
+	switch event.Action {
+	case "pass":
+		finished.SetSubjectOutcome(cdeventsv05.TestCaseRunFinishedSubjectContentOutcomeSuccess)
+	case "fail":
+		finished.SetSubjectOutcome(cdeventsv05.TestCaseRunFinishedSubjectContentOutcomeFailure)
+		finished.SetSubjectReason(reason(test.output.String()))
+		pkg.failed++
+	default:
+		finished.SetSubjectOutcome(cdeventsv05.TestCaseRunFinishedSubjectContentOutcomeError)
+		finished.SetSubjectReason("test did not complete")
+	}
+	if err := c.setOutput(finished, test); err != nil {
+		return err
+	}
+	return c.send(ctx, finished, event.Time)
+}
+
+func (c *Converter) send(ctx context.Context, event api.CDEventV04, timestamp time.Time) error {
+	event.SetSource(c.source)
+	event.SetChainId(c.chainId)
+	event.SetTimestamp(timestamp.UTC())
+	return c.sender.Send(ctx, event)
+}
+
+func (c *Converter) setOutput(event api.CDEventV04, r *run) error {
+	if !c.withOutput || r.output.Len() == 0 {
+		return nil
+	}
+	return event.SetCustomData("application/json", Output{Output: r.output.String()})
+}
+
+func (c *Converter) packageReference(event TestEvent) *api.Reference {
+	return &api.Reference{Id: event.Package, Source: c.source}
+}
+
+// testRunId returns the fully qualified name of a test, e.g.
+// example.com/greeter.TestGreet/empty_name
+func testRunId(event TestEvent) string {
+	return event.Package + "." + event.Test
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// testType returns the performance test type of an event content for
+// benchmarks, and no type for other tests
+func testType[T ~string](test string, performance T) T {
+	if strings.HasPrefix(test, "Benchmark") {
+		return performance
+	}
+	return ""
+}
+
+// reason returns the first message logged by a test, skipping the
+// headers printed by go test, e.g. "greet_test.go:12: got Hello, want Hi"
+func reason(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "",
+			strings.HasPrefix(line, "=== "),
+			strings.HasPrefix(line, "--- "),
+			line == "FAIL", line == "PASS":
+			continue
+		}
+		return line
+	}
+	return ""
+}
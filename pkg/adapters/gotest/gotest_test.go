@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gotest_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/gotest"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testSource  = "/ci/greeter/build/42"
+	testChainId = "4c8f2a1e-7b3d-4f6a-9e2c-1d5b8a7f3e90"
+	greetPkg    = "example.com/greeter/greet"
+	emptyPkg    = "example.com/greeter/empty"
+)
+
+var (
+	testEnvironment = &api.Reference{Id: "ci", Source: "/environments"}
+	greetRun        = &api.Reference{Id: greetPkg, Source: testSource}
+)
+
+// eventSummary holds the fields of an event checked by the tests
+type eventSummary struct {
+	Type      api.CDEventType
+	SubjectId string
+	Content   interface{}
+}
+
+// collector is a sender.Sender that keeps the events it receives
+type collector struct {
+	events []api.CDEventReader
+}
+
+func (c *collector) Send(_ context.Context, event api.CDEventReader) error {
+	c.events = append(c.events, event)
+	return nil
+}
+
+func (c *collector) summaries() []eventSummary {
+	summaries := []eventSummary{}
+	for _, event := range c.events {
+		summaries = append(summaries, eventSummary{
+			Type:      event.GetType(),
+			SubjectId: event.GetSubjectId(),
+			Content:   event.GetSubjectContent(),
+		})
+	}
+	return summaries
+}
+
+func openFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot open fixture %s: %v", name, err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func testCaseStarted(test string) eventSummary {
+	return eventSummary{
+		Type:      cdeventsv05.TestCaseRunStartedEventType,
+		SubjectId: greetPkg + "." + test,
+		Content: api.TestCaseRunStartedSubjectContentV0_3_0{
+			Environment:  testEnvironment,
+			TestCase:     &api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: greetPkg + "." + test, Name: test},
+			TestSuiteRun: greetRun,
+		},
+	}
+}
+
+func testCaseFinished(test string, outcome cdeventsv05.TestCaseRunFinishedSubjectContentOutcome, reason string) eventSummary {
+	return eventSummary{
+		Type:      cdeventsv05.TestCaseRunFinishedEventType,
+		SubjectId: greetPkg + "." + test,
+		Content: api.TestCaseRunFinishedSubjectContentV0_3_0{
+			Environment:  testEnvironment,
+			Outcome:      outcome,
+			Reason:       reason,
+			TestCase:     &api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: greetPkg + "." + test, Name: test},
+			TestSuiteRun: greetRun,
+		},
+	}
+}
+
+func testSuiteStarted(pkg string) eventSummary {
+	return eventSummary{
+		Type:      cdeventsv05.TestSuiteRunStartedEventType,
+		SubjectId: pkg,
+		Content: api.TestSuiteRunStartedSubjectContentV0_3_0{
+			Environment: testEnvironment,
+			TestSuite:   &api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: pkg, Name: pkg},
+		},
+	}
+}
+
+func testSuiteFinished(pkg string, outcome cdeventsv05.TestSuiteRunFinishedSubjectContentOutcome, reason string) eventSummary {
+	return eventSummary{
+		Type:      cdeventsv05.TestSuiteRunFinishedEventType,
+		SubjectId: pkg,
+		Content: api.TestSuiteRunFinishedSubjectContentV0_3_0{
+			Environment: testEnvironment,
+			Outcome:     outcome,
+			Reason:      reason,
+			TestSuite:   &api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: pkg, Name: pkg},
+		},
+	}
+}
+
+func TestProcess(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []eventSummary
+	}{{
+		fixture: "greeter",
+		want: []eventSummary{
+			testSuiteStarted(emptyPkg),
+			testSuiteFinished(emptyPkg, "success", "no test files"),
+			testSuiteStarted(greetPkg),
+			testCaseStarted("TestGreet"),
+			testCaseStarted("TestGreet/alice"),
+			testCaseFinished("TestGreet/alice", "success", ""),
+			testCaseStarted("TestGreet/empty_name"),
+			testCaseFinished("TestGreet/empty_name", "failure", `greet_test.go:16: Greet("") = "Hello, !", want "Hello, stranger!"`),
+			testCaseFinished("TestGreet", "failure", ""),
+			testCaseStarted("TestGreetFrench"),
+			{
+				Type:      cdeventsv05.TestCaseRunSkippedEventType,
+				SubjectId: greetPkg + ".TestGreetFrench",
+				Content: api.TestCaseRunSkippedSubjectContentV0_2_0{
+					Environment:  testEnvironment,
+					Reason:       "greet_test.go:23: French locale not available",
+					TestCase:     &api.TestCaseRunSkippedSubjectContentTestCaseV0_2_0{Id: greetPkg + ".TestGreetFrench", Name: "TestGreetFrench"},
+					TestSuiteRun: greetRun,
+				},
+			},
+			testCaseStarted("TestGreetLength"),
+			testCaseFinished("TestGreetLength", "success", ""),
+			testSuiteFinished(greetPkg, "failure", "2 tests failed"),
+		},
+	}, {
+		fixture: "build_failed",
+		want: []eventSummary{
+			testSuiteStarted(greetPkg),
+			testSuiteFinished(greetPkg, "error", "build failed: example.com/greeter/greet [example.com/greeter/greet.test]"),
+		},
+	}, {
+		fixture: "interrupted",
+		want: []eventSummary{
+			testSuiteStarted(greetPkg),
+			testCaseStarted("TestGreet"),
+			testCaseStarted("TestGreet/alice"),
+			testCaseFinished("TestGreet/alice", "success", ""),
+			testCaseStarted("TestGreet/empty_name"),
+			testCaseFinished("TestGreet", "error", "test did not complete"),
+			testCaseFinished("TestGreet/empty_name", "error", "test did not complete"),
+			testSuiteFinished(greetPkg, "error", "tests did not complete"),
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			c := &collector{}
+			converter, err := gotest.NewConverter(c,
+				gotest.WithSource(testSource),
+				gotest.WithEnvironment(testEnvironment),
+				gotest.WithChainId(testChainId))
+			if err != nil {
+				t.Fatalf("cannot create converter: %v", err)
+			}
+			if err := converter.Process(context.Background(), openFixture(t, tc.fixture)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, c.summaries()); d != "" {
+				t.Errorf("events diff(-want,+got):\n%s", d)
+			}
+			for _, event := range c.events {
+				if d := cmp.Diff(testChainId, event.(api.CDEventReaderV04).GetChainId()); d != "" {
+					t.Errorf("chain id diff(-want,+got):\n%s", d)
+				}
+				if err := api.Validate(event); err != nil {
+					t.Errorf("produced event is not valid: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessWithOutput(t *testing.T) {
+	c := &collector{}
+	converter, err := gotest.NewConverter(c,
+		gotest.WithEnvironment(testEnvironment),
+		gotest.WithOutput())
+	if err != nil {
+		t.Fatalf("cannot create converter: %v", err)
+	}
+	if err := converter.Process(context.Background(), openFixture(t, "greeter")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := map[string]string{}
+	for _, event := range c.events {
+		if event.GetType() != cdeventsv05.TestCaseRunStartedEventType && event.GetCustomDataContentType() != "" {
+			var output gotest.Output
+			if err := event.GetCustomDataAs(&output); err != nil {
+				t.Fatalf("cannot read output of %s: %v", event.GetSubjectId(), err)
+			}
+			got[event.GetSubjectId()] = output.Output
+		}
+	}
+	want := map[string]string{
+		emptyPkg:                      "?   \texample.com/greeter/empty\t[no test files]\n",
+		greetPkg + ".TestGreet":       "=== RUN   TestGreet\n--- FAIL: TestGreet (0.00s)\n",
+		greetPkg + ".TestGreet/alice": "=== RUN   TestGreet/alice\n--- PASS: TestGreet/alice (0.00s)\n",
+		greetPkg + ".TestGreetFrench": "=== RUN   TestGreetFrench\n    greet_test.go:23: French locale not available\n--- SKIP: TestGreetFrench (0.00s)\n",
+		greetPkg + ".TestGreetLength": "=== RUN   TestGreetLength\nchecking length\n--- PASS: TestGreetLength (0.00s)\n",
+		greetPkg + ".TestGreet/empty_name": "=== RUN   TestGreet/empty_name\n" +
+			"    greet_test.go:16: Greet(\"\") = \"Hello, !\", want \"Hello, stranger!\"\n" +
+			"--- FAIL: TestGreet/empty_name (0.00s)\n",
+		greetPkg: "FAIL\nFAIL\texample.com/greeter/greet\t0.002s\n",
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("output diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestProcessSenderError(t *testing.T) {
+	errSend := errors.New("broker unavailable")
+	failing := sender.SenderFunc(func(context.Context, api.CDEventReader) error {
+		return errSend
+	})
+	converter, err := gotest.NewConverter(failing, gotest.WithEnvironment(testEnvironment))
+	if err != nil {
+		t.Fatalf("cannot create converter: %v", err)
+	}
+	err = converter.Process(context.Background(), openFixture(t, "greeter"))
+	if !errors.Is(err, errSend) {
+		t.Errorf("error %v, want %v", err, errSend)
+	}
+}
+
+func TestNewConverterNoEnvironment(t *testing.T) {
+	_, err := gotest.NewConverter(&collector{})
+	if err == nil {
+		t.Error("expected an error but got none")
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package jira converts Jira issue webhook payloads into CDEvents v0.5
+// ticket events:
+//
+//   - jira:issue_created: TicketCreated
+//   - jira:issue_updated: TicketUpdated, or TicketClosed when the changelog
+//     sets a resolution
+//
+// Deleted issues produce no events, since CDEvents does not define a
+// ticket deleted event. The resolution of closed tickets comes from the
+// changelog, and is normalized by tickets.NewEvent. The user of an
+// update webhook, i.e. the author of its changelog, is the updatedBy of the
+// ticket.
+package jira
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/tickets"
+	"github.com/cdevents/sdk-go/pkg/api"
+)
+
+const (
+	// maxPayloadSize is the maximum size of a webhook payload accepted
+	maxPayloadSize = 25 * 1024 * 1024
+
+	eventIssueCreated = "jira:issue_created"
+	eventIssueUpdated = "jira:issue_updated"
+	eventIssueDeleted = "jira:issue_deleted"
+
+	fieldResolution = "resolution"
+	restPathPrefix  = "/rest/api/"
+)
+
+// ErrUnsupportedEvent is returned for webhooks that have no CDEvents mapping
+var ErrUnsupportedEvent = errors.New("unsupported Jira webhook event")
+
+// Adapter converts Jira webhook payloads into CDEvents
+type Adapter struct {
+	source string
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events. By default, the base
+// URL of the Jira instance is used.
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// NewAdapter creates a new Jira webhook Adapter
+func NewAdapter(opts ...Option) *Adapter {
+	a := &Adapter{}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// ParseRequest converts the payload of a webhook request into CDEvents
+func (a *Adapter) ParseRequest(r *http.Request) ([]api.CDEventV04, error) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the webhook payload: %w", err)
+	}
+	return a.Convert(payload)
+}
+
+// Convert converts the payload of an issue webhook into CDEvents. Webhooks
+// of other kinds, like comments or sprints, return ErrUnsupportedEvent.
+func (a *Adapter) Convert(payload []byte) ([]api.CDEventV04, error) {
+	var hook webhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("cannot parse the webhook payload: %w", err)
+	}
+	var kind tickets.Kind
+	switch hook.WebhookEvent {
+	case eventIssueCreated:
+		kind = tickets.KindCreated
+	case eventIssueUpdated:
+		kind = tickets.KindUpdated
+	case eventIssueDeleted:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedEvent, hook.WebhookEvent)
+	}
+	if hook.Issue == nil {
+		return nil, fmt.Errorf("%w: %s webhook without issue", ErrUnsupportedEvent, hook.WebhookEvent)
+	}
+	ticket := a.ticket(hook.Issue)
+	if hook.Timestamp > 0 {
+		ticket.Timestamp = time.UnixMilli(hook.Timestamp).UTC()
+	}
+	if kind == tickets.KindUpdated {
+		ticket.UpdatedBy = hook.User.id()
+		// Issues are resolved when the changelog sets a resolution, and
+		// reopened when it clears it
+		for _, item := range hook.Changelog.items() {
+			if item.Field == fieldResolution {
+				ticket.Resolution = item.ToString
+				if item.ToString != "" {
+					kind = tickets.KindClosed
+				}
+			}
+		}
+	}
+	event, err := tickets.NewEvent(kind, ticket)
+	if err != nil {
+		return nil, err
+	}
+	return []api.CDEventV04{event}, nil
+}
+
+func (a *Adapter) ticket(issue *issue) tickets.Ticket {
+	baseURL := issue.Self
+	if i := strings.Index(baseURL, restPathPrefix); i >= 0 {
+		baseURL = baseURL[:i]
+	}
+	source := a.source
+	if source == "" {
+		source = baseURL
+	}
+	fields := issue.Fields
+	creator := fields.Creator.id()
+	if creator == "" {
+		creator = fields.Reporter.id()
+	}
+	var assignees []string
+	if assignee := fields.Assignee.id(); assignee != "" {
+		assignees = []string{assignee}
+	}
+	var milestone string
+	if len(fields.FixVersions) > 0 {
+		milestone = fields.FixVersions[0].Name
+	}
+	return tickets.Ticket{
+		Id:         issue.Key,
+		Source:     source,
+		Uri:        baseURL + "/browse/" + issue.Key,
+		Summary:    fields.Summary,
+		Creator:    creator,
+		Assignees:  assignees,
+		Group:      fields.Project.Key,
+		Labels:     fields.Labels,
+		Milestone:  milestone,
+		Priority:   fields.Priority.name(),
+		TicketType: fields.IssueType.name(),
+		Resolution: fields.Resolution.name(),
+	}
+}
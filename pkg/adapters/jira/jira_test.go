@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jira_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/jira"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testJiraURL  = "https://acme.atlassian.net"
+	testIssueURI = testJiraURL + "/browse/SHOP-42"
+	testSummary  = "Checkout fails with expired cards"
+)
+
+var testLabels = []string{"checkout", "payments"}
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		fixture       string
+		wantType      api.CDEventType
+		wantContent   interface{}
+		wantTimestamp time.Time
+	}{{
+		fixture:  "issue_created",
+		wantType: cdeventsv05.TicketCreatedEventType,
+		wantContent: api.TicketCreatedSubjectContentV0_2_0{
+			Creator:    "Alice Doe",
+			Group:      "SHOP",
+			Labels:     testLabels,
+			Milestone:  "2024.03",
+			Priority:   "High",
+			Summary:    testSummary,
+			TicketType: "Bug",
+			Uri:        testIssueURI,
+		},
+		wantTimestamp: time.UnixMilli(1709287200000).UTC(),
+	}, {
+		fixture:  "issue_updated",
+		wantType: cdeventsv05.TicketUpdatedEventType,
+		wantContent: api.TicketUpdatedSubjectContentV0_2_0{
+			Assignees:  []string{"Bob Smith"},
+			Creator:    "Alice Doe",
+			Group:      "SHOP",
+			Labels:     testLabels,
+			Milestone:  "2024.03",
+			Priority:   "Highest",
+			Summary:    testSummary,
+			TicketType: "Bug",
+			UpdatedBy:  "Alice Doe",
+			Uri:        testIssueURI,
+		},
+		wantTimestamp: time.UnixMilli(1709290800000).UTC(),
+	}, {
+		fixture:  "issue_resolved",
+		wantType: cdeventsv05.TicketClosedEventType,
+		wantContent: api.TicketClosedSubjectContentV0_2_0{
+			Assignees:  []string{"Bob Smith"},
+			Creator:    "Alice Doe",
+			Group:      "SHOP",
+			Labels:     testLabels,
+			Milestone:  "2024.03",
+			Priority:   "Highest",
+			Resolution: "completed",
+			Summary:    testSummary,
+			TicketType: "Bug",
+			UpdatedBy:  "Bob Smith",
+			Uri:        testIssueURI,
+		},
+		wantTimestamp: time.UnixMilli(1709301600000).UTC(),
+	}, {
+		fixture:  "issue_duplicate",
+		wantType: cdeventsv05.TicketClosedEventType,
+		wantContent: api.TicketClosedSubjectContentV0_2_0{
+			Assignees:  []string{"Bob Smith"},
+			Creator:    "Alice Doe",
+			Group:      "SHOP",
+			Labels:     testLabels,
+			Milestone:  "2024.03",
+			Priority:   "Highest",
+			Resolution: "duplicate",
+			Summary:    testSummary,
+			TicketType: "Bug",
+			UpdatedBy:  "Bob Smith",
+			Uri:        testIssueURI,
+		},
+		wantTimestamp: time.UnixMilli(1709301600000).UTC(),
+	}, {
+		fixture:  "issue_reopened",
+		wantType: cdeventsv05.TicketUpdatedEventType,
+		wantContent: api.TicketUpdatedSubjectContentV0_2_0{
+			Assignees:  []string{"Bob Smith"},
+			Creator:    "Alice Doe",
+			Group:      "SHOP",
+			Labels:     testLabels,
+			Milestone:  "2024.03",
+			Priority:   "Highest",
+			Summary:    testSummary,
+			TicketType: "Bug",
+			UpdatedBy:  "Alice Doe",
+			Uri:        testIssueURI,
+		},
+		wantTimestamp: time.UnixMilli(1709388000000).UTC(),
+	}}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := jira.NewAdapter().Convert(loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(events))
+			}
+			event := events[0]
+			if d := cmp.Diff(tc.wantType, event.GetType()); d != "" {
+				t.Errorf("type diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff("SHOP-42", event.GetSubjectId()); d != "" {
+				t.Errorf("subject id diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(testJiraURL, event.GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantContent, event.GetSubjectContent()); d != "" {
+				t.Errorf("content diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantTimestamp, event.GetTimestamp()); d != "" {
+				t.Errorf("timestamp diff(-want,+got):\n%s", d)
+			}
+			if err := api.Validate(event); err != nil {
+				t.Errorf("produced event is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestConvertNoEvents(t *testing.T) {
+	events, err := jira.NewAdapter().Convert(loadFixture(t, "issue_deleted"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events, got %d", len(events))
+	}
+}
+
+func TestConvertUnsupported(t *testing.T) {
+	_, err := jira.NewAdapter().Convert(loadFixture(t, "comment_created"))
+	if !errors.Is(err, jira.ErrUnsupportedEvent) {
+		t.Errorf("expected ErrUnsupportedEvent, got %v", err)
+	}
+	if _, err := jira.NewAdapter().Convert([]byte("not json")); err == nil {
+		t.Error("expected an error, got none")
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	adapter := jira.NewAdapter(jira.WithSource("/trackers/jira"))
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(loadFixture(t, "issue_created")))
+	events, err := adapter.ParseRequest(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff("/trackers/jira", events[0].GetSource()); d != "" {
+		t.Errorf("source diff(-want,+got):\n%s", d)
+	}
+}
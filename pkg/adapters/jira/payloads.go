@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jira
+
+// The types in this file hold the subset of the Jira webhook payloads used
+// by the adapter.
+// Docs: https://developer.atlassian.com/server/jira/platform/webhooks/
+
+type user struct {
+	AccountId    string `json:"accountId"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// id returns the most readable identifier of the user: Jira Cloud only
+// sends the display name and account id
+func (u *user) id() string {
+	if u == nil {
+		return ""
+	}
+	for _, id := range []string{u.DisplayName, u.Name, u.AccountId} {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+type named struct {
+	Name string `json:"name"`
+}
+
+func (n *named) name() string {
+	if n == nil {
+		return ""
+	}
+	return n.Name
+}
+
+type issueFields struct {
+	Summary     string   `json:"summary"`
+	IssueType   *named   `json:"issuetype"`
+	Priority    *named   `json:"priority"`
+	Resolution  *named   `json:"resolution"`
+	Labels      []string `json:"labels"`
+	Assignee    *user    `json:"assignee"`
+	Creator     *user    `json:"creator"`
+	Reporter    *user    `json:"reporter"`
+	FixVersions []named  `json:"fixVersions"`
+	Project     struct {
+		Key string `json:"key"`
+	} `json:"project"`
+}
+
+type issue struct {
+	Id     string      `json:"id"`
+	Key    string      `json:"key"`
+	Self   string      `json:"self"`
+	Fields issueFields `json:"fields"`
+}
+
+type changelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+type changelog struct {
+	Id    string          `json:"id"`
+	Items []changelogItem `json:"items"`
+}
+
+func (c *changelog) items() []changelogItem {
+	if c == nil {
+		return nil
+	}
+	return c.Items
+}
+
+type webhook struct {
+	Timestamp          int64      `json:"timestamp"`
+	WebhookEvent       string     `json:"webhookEvent"`
+	IssueEventTypeName string     `json:"issue_event_type_name"`
+	User               *user      `json:"user"`
+	Issue              *issue     `json:"issue"`
+	Changelog          *changelog `json:"changelog"`
+}
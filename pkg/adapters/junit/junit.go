@@ -0,0 +1,362 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package junit converts JUnit XML test reports into CDEvents v0.5.
+//
+// Each test suite in the report produces a TestSuiteRunStarted event,
+// followed by the events of its test cases and a TestSuiteRunFinished
+// event. Test cases that ran produce a TestCaseRunStarted and a
+// TestCaseRunFinished event, while skipped test cases produce a single
+// TestCaseRunSkipped event. A final TestOutputPublished event references
+// the report itself.
+//
+// The subject ids of the test suite runs combine a run id, by default the
+// timestamp of the first suite of the report, with the suite name, so that
+// runs of the same suite have different ids. Test case run ids extend the
+// id of their suite run with the test case id.
+//
+// Outcomes come from the failure, error and skipped elements of the test
+// cases, and timestamps from the timestamp and time attributes. Since test
+// cases only carry a duration, they are assumed to run one after the other.
+//
+// All the events of a report share the same chainId, and are linked to each
+// other in the order they are returned with PATH links, the last one having
+// an END link.
+package junit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/uuid"
+)
+
+const (
+	// ReportFormat is the format of the TestOutputPublished event
+	ReportFormat = "application/xml"
+
+	outputTypeReport = "report"
+)
+
+var (
+	// ErrInvalidReport is returned when a report is not a valid JUnit report
+	ErrInvalidReport = errors.New("invalid JUnit report")
+
+	// timeNow is used for suites without a timestamp
+	timeNow = time.Now
+)
+
+// Adapter converts JUnit XML reports into CDEvents
+type Adapter struct {
+	source      string
+	environment *api.Reference
+	reportURI   string
+	chainId     string
+	runId       string
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithEnvironment sets the environment where the tests ran. It is required,
+// since the test events must reference an environment.
+func WithEnvironment(environment *api.Reference) Option {
+	return func(a *Adapter) {
+		a.environment = environment
+	}
+}
+
+// WithReportURI sets the uri where the report is published, referenced by
+// the TestOutputPublished event
+func WithReportURI(uri string) Option {
+	return func(a *Adapter) {
+		a.reportURI = uri
+	}
+}
+
+// WithChainId sets the chainId of the produced events, to include them in
+// an existing chain. By default, each report starts a new chain.
+func WithChainId(chainId string) Option {
+	return func(a *Adapter) {
+		a.chainId = chainId
+	}
+}
+
+// WithRunId sets the run id that the subject ids of test suite runs start
+// with, e.g. the id of the CI build. By default, it's the timestamp of the
+// first suite of each report.
+func WithRunId(runId string) Option {
+	return func(a *Adapter) {
+		a.runId = runId
+	}
+}
+
+// NewAdapter creates a new JUnit Adapter
+func NewAdapter(opts ...Option) (*Adapter, error) {
+	a := &Adapter{
+		source: "junit",
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.environment == nil || a.environment.Id == "" {
+		return nil, errors.New("an environment is required by the JUnit adapter")
+	}
+	return a, nil
+}
+
+// Convert parses a JUnit XML report and returns the corresponding events,
+// in chronological order
+func (a *Adapter) Convert(report io.Reader) ([]api.CDEventV04, error) {
+	suites, err := parseReport(report)
+	if err != nil {
+		return nil, err
+	}
+	chainId := a.chainId
+	if chainId == "" {
+		chainUUID, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		chainId = chainUUID.String()
+	}
+	events := []api.CDEventV04{}
+	ids := newIdSet()
+	runId := a.runId
+	var start time.Time
+	for _, suite := range suites {
+		if suite.Timestamp != "" {
+			if start, err = parseTimestamp(suite.Timestamp); err != nil {
+				return nil, err
+			}
+		} else if start.IsZero() {
+			start = timeNow().UTC()
+		}
+		if runId == "" {
+			runId = start.UTC().Format(time.RFC3339Nano)
+		}
+		suiteEvents, end, err := a.convertSuite(suite, runId+"/"+ids.unique(suite.Name), start)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, suiteEvents...)
+		// Suites without a timestamp are assumed to run after the previous one
+		start = end
+	}
+	output, err := a.newTestOutputPublished(start)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, output)
+	for i, event := range events {
+		event.SetSource(a.source)
+		event.SetChainId(chainId)
+		if i > 0 {
+			link(event, events[i-1], i == len(events)-1)
+		}
+	}
+	return events, nil
+}
+
+// convertSuite returns the events of a test suite started at start, and the
+// time it finished
+func (a *Adapter) convertSuite(suite testSuite, suiteRunId string, start time.Time) ([]api.CDEventV04, time.Time, error) {
+	suiteRun := &api.Reference{Id: suiteRunId, Source: a.source}
+	started, err := cdeventsv05.NewTestSuiteRunStartedEvent()
+	if err != nil {
+		return nil, start, err
+	}
+	started.SetSubjectId(suiteRunId)
+	started.SetSubjectEnvironment(a.environment)
+	started.SetSubjectTestSuite(&api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: suite.Name, Name: suite.Name})
+	started.SetTimestamp(start)
+	events := []api.CDEventV04{started}
+
+	ids := newIdSet()
+	failures, errs := 0, 0
+	end := start
+	for _, tc := range suite.TestCases {
+		duration, err := parseDuration(tc.Time)
+		if err != nil {
+			return nil, start, err
+		}
+		caseRunId := suiteRunId + "/" + ids.unique(tc.id())
+		caseEvents, err := a.convertCase(tc, caseRunId, suiteRun, end, end.Add(duration))
+		if err != nil {
+			return nil, start, err
+		}
+		events = append(events, caseEvents...)
+		end = end.Add(duration)
+		switch {
+		case tc.Skipped != nil:
+		case tc.Error != nil:
+			errs++
+		case tc.Failure != nil:
+			failures++
+		}
+	}
+	// The suite time includes setup and teardown, when provided
+	if duration, err := parseDuration(suite.Time); err != nil {
+		return nil, start, err
+	} else if start.Add(duration).After(end) {
+		end = start.Add(duration)
+	}
+
+	finished, err := cdeventsv05.NewTestSuiteRunFinishedEvent()
+	if err != nil {
+		return nil, start, err
+	}
+	finished.SetSubjectId(suiteRunId)
+	finished.SetSubjectEnvironment(a.environment)
+	finished.SetSubjectTestSuite(&api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: suite.Name, Name: suite.Name})
+	finished.SetTimestamp(end)
+	switch {
+	case errs > 0:
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeError)
+		finished.SetSubjectReason(summary(failures, errs))
+	case failures > 0:
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeFailure)
+		finished.SetSubjectReason(summary(failures, errs))
+	default:
+		finished.SetSubjectOutcome(cdeventsv05.TestSuiteRunFinishedSubjectContentOutcomeSuccess)
+	}
+	return append(events, finished), end, nil
+}
+
+// convertCase returns the events of a test case that ran from start to end
+func (a *Adapter) convertCase(tc testCase, caseRunId string, suiteRun *api.Reference, start, end time.Time) ([]api.CDEventV04, error) {
+	if tc.Skipped != nil {
+		skipped, err := cdeventsv05.NewTestCaseRunSkippedEvent()
+		if err != nil {
+			return nil, err
+		}
+		skipped.SetSubjectId(caseRunId)
+		skipped.SetSubjectEnvironment(a.environment)
+		skipped.SetSubjectTestCase(&api.TestCaseRunSkippedSubjectContentTestCaseV0_2_0{Id: tc.id(), Name: tc.Name})
+		skipped.SetSubjectTestSuiteRun(suiteRun)
+		skipped.SetSubjectReason(tc.Skipped.reason())
+		skipped.SetTimestamp(start)
+		return []api.CDEventV04{skipped}, nil
+	}
+
+	started, err := cdeventsv05.NewTestCaseRunStartedEvent()
+	if err != nil {
+		return nil, err
+	}
+	started.SetSubjectId(caseRunId)
+	started.SetSubjectEnvironment(a.environment)
+	started.SetSubjectTestCase(&api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: tc.id(), Name: tc.Name})
+	started.SetSubjectTestSuiteRun(suiteRun)
+	started.SetTimestamp(start)
+
+	finished, err := cdeventsv05.NewTestCaseRunFinishedEvent()
+	if err != nil {
+		return nil, err
+	}
+	finished.SetSubjectId(caseRunId)
+	finished.SetSubjectEnvironment(a.environment)
+	finished.SetSubjectTestCase(&api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: tc.id(), Name: tc.Name})
+	finished.SetSubjectTestSuiteRun(suiteRun)
+	finished.SetTimestamp(end)
+	switch {
+	case tc.Error != nil:
+		finished.SetSubjectOutcome(cdeventsv05.TestCaseRunFinishedSubjectContentOutcomeError)
+		finished.SetSubjectReason(tc.Error.reason())
+	case tc.Failure != nil:
+		finished.SetSubjectOutcome(cdeventsv05.TestCaseRunFinishedSubjectContentOutcomeFailure)
+		finished.SetSubjectReason(tc.Failure.reason())
+	default:
+		finished.SetSubjectOutcome(cdeventsv05.TestCaseRunFinishedSubjectContentOutcomeSuccess)
+	}
+	return []api.CDEventV04{started, finished}, nil
+}
+
+func (a *Adapter) newTestOutputPublished(timestamp time.Time) (api.CDEventV04, error) {
+	output, err := cdeventsv05.NewTestOutputPublishedEvent()
+	if err != nil {
+		return nil, err
+	}
+	subjectId := a.reportURI
+	if subjectId == "" {
+		subjectId = "junit-report"
+	}
+	output.SetSubjectId(subjectId)
+	output.SetSubjectFormat(ReportFormat)
+	output.SetSubjectOutputType(outputTypeReport)
+	output.SetSubjectUri(a.reportURI)
+	output.SetTimestamp(timestamp)
+	return output, nil
+}
+
+// link adds a link to event from the event that precedes it in the chain
+func link(event, previous api.CDEventV04, last bool) {
+	l := api.NewEmbeddedLinkPath()
+	if last {
+		l = api.NewEmbeddedLinkEnd()
+	}
+	l.SetFrom(api.EventReference{ContextId: previous.GetId()})
+	l.SetTags(api.Tags{})
+	event.SetLinks(api.EmbeddedLinksArray{l})
+}
+
+func summary(failures, errs int) string {
+	parts := []string{}
+	if failures > 0 {
+		parts = append(parts, plural(failures, "failure"))
+	}
+	if errs > 0 {
+		parts = append(parts, plural(errs, "error"))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// idSet makes ids unique, since reports may contain several suites or test
+// cases with the same name, e.g. for parameterized tests
+type idSet map[string]int
+
+func newIdSet() idSet {
+	return idSet{}
+}
+
+func (s idSet) unique(id string) string {
+	s[id]++
+	if s[id] == 1 {
+		return id
+	}
+	return id + "#" + strconv.Itoa(s[id])
+}
@@ -0,0 +1,477 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package junit_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/junit"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/cdeventstest"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testSource    = "/ci/greeter/build/42"
+	testChainId   = "4c8f2a1e-7b3d-4f6a-9e2c-1d5b8a7f3e90"
+	testReportURI = "https://ci.example.com/greeter/build/42/artifacts/TEST-report.xml"
+)
+
+var testEnvironment = &api.Reference{Id: "ci", Source: "/environments"}
+
+// eventSummary holds the fields of an event checked by the tests
+type eventSummary struct {
+	Type      api.CDEventType
+	SubjectId string
+	Timestamp time.Time
+	Content   interface{}
+}
+
+func summarize(events []api.CDEventV04) []eventSummary {
+	summaries := []eventSummary{}
+	for _, event := range events {
+		summaries = append(summaries, eventSummary{
+			Type:      event.GetType(),
+			SubjectId: event.GetSubjectId(),
+			Timestamp: event.GetTimestamp(),
+			Content:   event.GetSubjectContent(),
+		})
+	}
+	return summaries
+}
+
+func openReport(t *testing.T, name string) *os.File {
+	t.Helper()
+	report, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("cannot open report %s: %v", name, err)
+	}
+	t.Cleanup(func() { report.Close() })
+	return report
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func newAdapter(t *testing.T) *junit.Adapter {
+	t.Helper()
+	adapter, err := junit.NewAdapter(
+		junit.WithSource(testSource),
+		junit.WithEnvironment(testEnvironment),
+		junit.WithReportURI(testReportURI),
+		junit.WithChainId(testChainId))
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	return adapter
+}
+
+func TestConvert(t *testing.T) {
+	unitSuiteName := "com.example.GreeterTest"
+	itSuiteName := "com.example.GreeterIT"
+	// The run id defaults to the timestamp of the first suite
+	unitSuite := "2024-03-01T10:00:00Z/" + unitSuiteName
+	itSuite := "2024-03-01T10:00:00Z/" + itSuiteName
+	unitRun := &api.Reference{Id: unitSuite, Source: testSource}
+	itRun := &api.Reference{Id: itSuite, Source: testSource}
+	tests := []struct {
+		report string
+		want   []eventSummary
+	}{{
+		report: "surefire.xml",
+		want: []eventSummary{{
+			Type:      cdeventsv05.TestSuiteRunStartedEventType,
+			SubjectId: unitSuite,
+			Timestamp: mustParseTime("2024-03-01T10:00:00Z"),
+			Content: api.TestSuiteRunStartedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				TestSuite:   &api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: unitSuiteName, Name: unitSuiteName},
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunStartedEventType,
+			SubjectId: unitSuite + "/com.example.GreeterTest.greetsByName",
+			Timestamp: mustParseTime("2024-03-01T10:00:00Z"),
+			Content: api.TestCaseRunStartedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				TestCase:     &api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterTest.greetsByName", Name: "greetsByName"},
+				TestSuiteRun: unitRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunFinishedEventType,
+			SubjectId: unitSuite + "/com.example.GreeterTest.greetsByName",
+			Timestamp: mustParseTime("2024-03-01T10:00:00.25Z"),
+			Content: api.TestCaseRunFinishedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				Outcome:      "success",
+				TestCase:     &api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterTest.greetsByName", Name: "greetsByName"},
+				TestSuiteRun: unitRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunStartedEventType,
+			SubjectId: unitSuite + "/com.example.GreeterTest.greetsAnonymous",
+			Timestamp: mustParseTime("2024-03-01T10:00:00.25Z"),
+			Content: api.TestCaseRunStartedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				TestCase:     &api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterTest.greetsAnonymous", Name: "greetsAnonymous"},
+				TestSuiteRun: unitRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunFinishedEventType,
+			SubjectId: unitSuite + "/com.example.GreeterTest.greetsAnonymous",
+			Timestamp: mustParseTime("2024-03-01T10:00:01Z"),
+			Content: api.TestCaseRunFinishedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				Outcome:      "failure",
+				Reason:       "expected: <Hello, stranger> but was: <Hello, null>",
+				TestCase:     &api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterTest.greetsAnonymous", Name: "greetsAnonymous"},
+				TestSuiteRun: unitRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunSkippedEventType,
+			SubjectId: unitSuite + "/com.example.GreeterTest.greetsInFrench",
+			Timestamp: mustParseTime("2024-03-01T10:00:01Z"),
+			Content: api.TestCaseRunSkippedSubjectContentV0_2_0{
+				Environment:  testEnvironment,
+				Reason:       "French locale not available",
+				TestCase:     &api.TestCaseRunSkippedSubjectContentTestCaseV0_2_0{Id: "com.example.GreeterTest.greetsInFrench", Name: "greetsInFrench"},
+				TestSuiteRun: unitRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestSuiteRunFinishedEventType,
+			SubjectId: unitSuite,
+			Timestamp: mustParseTime("2024-03-01T10:00:01.5Z"),
+			Content: api.TestSuiteRunFinishedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				Outcome:     "failure",
+				Reason:      "1 failure",
+				TestSuite:   &api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: unitSuiteName, Name: unitSuiteName},
+			},
+		}, {
+			Type:      cdeventsv05.TestSuiteRunStartedEventType,
+			SubjectId: itSuite,
+			Timestamp: mustParseTime("2024-03-01T10:00:02Z"),
+			Content: api.TestSuiteRunStartedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				TestSuite:   &api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: itSuiteName, Name: itSuiteName},
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunStartedEventType,
+			SubjectId: itSuite + "/com.example.GreeterIT.servesGreeting",
+			Timestamp: mustParseTime("2024-03-01T10:00:02Z"),
+			Content: api.TestCaseRunStartedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				TestCase:     &api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterIT.servesGreeting", Name: "servesGreeting"},
+				TestSuiteRun: itRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunFinishedEventType,
+			SubjectId: itSuite + "/com.example.GreeterIT.servesGreeting",
+			Timestamp: mustParseTime("2024-03-01T10:00:03Z"),
+			Content: api.TestCaseRunFinishedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				Outcome:      "success",
+				TestCase:     &api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterIT.servesGreeting", Name: "servesGreeting"},
+				TestSuiteRun: itRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunStartedEventType,
+			SubjectId: itSuite + "/com.example.GreeterIT.servesGreetingOverTLS",
+			Timestamp: mustParseTime("2024-03-01T10:00:03Z"),
+			Content: api.TestCaseRunStartedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				TestCase:     &api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterIT.servesGreetingOverTLS", Name: "servesGreetingOverTLS"},
+				TestSuiteRun: itRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunFinishedEventType,
+			SubjectId: itSuite + "/com.example.GreeterIT.servesGreetingOverTLS",
+			Timestamp: mustParseTime("2024-03-01T10:00:03.5Z"),
+			Content: api.TestCaseRunFinishedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				Outcome:      "error",
+				Reason:       "Connection refused",
+				TestCase:     &api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "com.example.GreeterIT.servesGreetingOverTLS", Name: "servesGreetingOverTLS"},
+				TestSuiteRun: itRun,
+			},
+		}, {
+			Type:      cdeventsv05.TestSuiteRunFinishedEventType,
+			SubjectId: itSuite,
+			Timestamp: mustParseTime("2024-03-01T10:00:04Z"),
+			Content: api.TestSuiteRunFinishedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				Outcome:     "error",
+				Reason:      "1 error",
+				TestSuite:   &api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: itSuiteName, Name: itSuiteName},
+			},
+		}, {
+			Type:      cdeventsv05.TestOutputPublishedEventType,
+			SubjectId: testReportURI,
+			Timestamp: mustParseTime("2024-03-01T10:00:04Z"),
+			Content: api.TestOutputPublishedSubjectContentV0_3_0{
+				Format:     junit.ReportFormat,
+				OutputType: "report",
+				Uri:        testReportURI,
+			},
+		}},
+	}, {
+		report: "nested.xml",
+		want: []eventSummary{{
+			Type:      cdeventsv05.TestSuiteRunStartedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/unit",
+			Timestamp: mustParseTime("2024-03-01T12:00:00Z"),
+			Content: api.TestSuiteRunStartedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				TestSuite:   &api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: "unit", Name: "unit"},
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunStartedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/unit/parser.TestParse",
+			Timestamp: mustParseTime("2024-03-01T12:00:00Z"),
+			Content: api.TestCaseRunStartedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				TestCase:     &api.TestCaseRunStartedSubjectContentTestCaseV0_3_0{Id: "parser.TestParse", Name: "TestParse"},
+				TestSuiteRun: &api.Reference{Id: "2024-03-01T12:00:00Z/unit", Source: testSource},
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunFinishedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/unit/parser.TestParse",
+			Timestamp: mustParseTime("2024-03-01T12:00:00.1Z"),
+			Content: api.TestCaseRunFinishedSubjectContentV0_3_0{
+				Environment:  testEnvironment,
+				Outcome:      "success",
+				TestCase:     &api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "parser.TestParse", Name: "TestParse"},
+				TestSuiteRun: &api.Reference{Id: "2024-03-01T12:00:00Z/unit", Source: testSource},
+			},
+		}, {
+			Type:      cdeventsv05.TestSuiteRunFinishedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/unit",
+			Timestamp: mustParseTime("2024-03-01T12:00:00.1Z"),
+			Content: api.TestSuiteRunFinishedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				Outcome:     "success",
+				TestSuite:   &api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: "unit", Name: "unit"},
+			},
+		}, {
+			Type:      cdeventsv05.TestSuiteRunStartedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/integration",
+			Timestamp: mustParseTime("2024-03-01T12:00:00.1Z"),
+			Content: api.TestSuiteRunStartedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				TestSuite:   &api.TestSuiteRunStartedSubjectContentTestSuiteV0_3_0{Id: "integration", Name: "integration"},
+			},
+		}, {
+			Type:      cdeventsv05.TestCaseRunSkippedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/integration/server.TestServe",
+			Timestamp: mustParseTime("2024-03-01T12:00:00.1Z"),
+			Content: api.TestCaseRunSkippedSubjectContentV0_2_0{
+				Environment:  testEnvironment,
+				TestCase:     &api.TestCaseRunSkippedSubjectContentTestCaseV0_2_0{Id: "server.TestServe", Name: "TestServe"},
+				TestSuiteRun: &api.Reference{Id: "2024-03-01T12:00:00Z/integration", Source: testSource},
+			},
+		}, {
+			Type:      cdeventsv05.TestSuiteRunFinishedEventType,
+			SubjectId: "2024-03-01T12:00:00Z/integration",
+			Timestamp: mustParseTime("2024-03-01T12:00:00.3Z"),
+			Content: api.TestSuiteRunFinishedSubjectContentV0_3_0{
+				Environment: testEnvironment,
+				Outcome:     "success",
+				TestSuite:   &api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: "integration", Name: "integration"},
+			},
+		}, {
+			Type:      cdeventsv05.TestOutputPublishedEventType,
+			SubjectId: testReportURI,
+			Timestamp: mustParseTime("2024-03-01T12:00:00.3Z"),
+			Content: api.TestOutputPublishedSubjectContentV0_3_0{
+				Format:     junit.ReportFormat,
+				OutputType: "report",
+				Uri:        testReportURI,
+			},
+		}},
+	}}
+	adapter := newAdapter(t)
+	for _, tc := range tests {
+		t.Run(tc.report, func(t *testing.T) {
+			events, err := adapter.Convert(openReport(t, tc.report))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, summarize(events)); d != "" {
+				t.Errorf("events diff(-want,+got):\n%s", d)
+			}
+			for _, event := range events {
+				if d := cmp.Diff(testSource, event.GetSource()); d != "" {
+					t.Errorf("source diff(-want,+got):\n%s", d)
+				}
+				if err := api.Validate(event); err != nil {
+					t.Errorf("produced event is not valid: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertChain(t *testing.T) {
+	events, err := newAdapter(t).Convert(openReport(t, "surefire.xml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	recorder := record(t, events)
+	recorder.AssertNoInvalidEvents(t)
+	recorder.AssertCount(t, len(events))
+	recorder.AssertChainComplete(t, testChainId)
+	for i, event := range events {
+		links := event.GetLinks()
+		if i == 0 {
+			if len(links) != 0 {
+				t.Errorf("expected no links on the first event, got %d", len(links))
+			}
+			continue
+		}
+		if len(links) != 1 {
+			t.Fatalf("expected one link on event %d, got %d", i, len(links))
+		}
+		wantLinkType := api.LinkTypePath
+		if i == len(events)-1 {
+			wantLinkType = api.LinkTypeEnd
+		}
+		if d := cmp.Diff(wantLinkType, links[0].GetLinkType()); d != "" {
+			t.Errorf("link type diff(-want,+got):\n%s", d)
+		}
+		from := links[0].(api.EmbeddedLinkWithTagsAndSource).GetFrom()
+		if d := cmp.Diff(events[i-1].GetId(), from.ContextId); d != "" {
+			t.Errorf("link from diff(-want,+got):\n%s", d)
+		}
+	}
+}
+
+// record sends events through a recording client and returns it
+func record(t *testing.T, events []api.CDEventV04) *cdeventstest.Recorder {
+	t.Helper()
+	recorder := cdeventstest.NewRecorder()
+	for _, event := range events {
+		ce, err := api.AsCloudEvent(event)
+		if err != nil {
+			t.Fatalf("cannot render event as CloudEvent: %v", err)
+		}
+		if result := recorder.Send(context.Background(), *ce); !cloudevents.IsACK(result) {
+			t.Fatalf("send failed: %v", result)
+		}
+	}
+	return recorder
+}
+
+func TestConvertNewChain(t *testing.T) {
+	adapter, err := junit.NewAdapter(junit.WithEnvironment(testEnvironment), junit.WithRunId("build-42"))
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	first, err := adapter.Convert(openReport(t, "pytest.xml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := adapter.Convert(openReport(t, "pytest.xml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first[0].GetChainId() == "" || first[0].GetChainId() == second[0].GetChainId() {
+		t.Errorf("expected a new chain per report, got %q and %q", first[0].GetChainId(), second[0].GetChainId())
+	}
+	// Parameterized tests share the same name
+	gotIds := []string{}
+	for _, event := range first {
+		if event.GetType() == cdeventsv05.TestCaseRunStartedEventType {
+			gotIds = append(gotIds, event.GetSubjectId())
+		}
+	}
+	wantIds := []string{
+		"build-42/pytest/tests.test_greeter.test_greet[alice]",
+		"build-42/pytest/tests.test_greeter.test_greet[alice]#2",
+		"build-42/pytest/tests.test_greeter.test_greet_empty",
+	}
+	if d := cmp.Diff(wantIds, gotIds); d != "" {
+		t.Errorf("subject ids diff(-want,+got):\n%s", d)
+	}
+	output := first[len(first)-1]
+	if d := cmp.Diff(api.TestOutputPublishedSubjectContentV0_3_0{Format: junit.ReportFormat, OutputType: "report"}, output.GetSubjectContent()); d != "" {
+		t.Errorf("output content diff(-want,+got):\n%s", d)
+	}
+	for _, event := range first {
+		if err := api.Validate(event); err != nil {
+			t.Errorf("produced event is not valid: %v", err)
+		}
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		report    string
+		wantError error
+	}{{
+		name:      "not junit",
+		report:    `<html><body>Not a test report</body></html>`,
+		wantError: junit.ErrInvalidReport,
+	}, {
+		name:      "invalid time",
+		report:    `<testsuite name="s"><testcase name="t" time="soon"/></testsuite>`,
+		wantError: junit.ErrInvalidReport,
+	}, {
+		name:      "invalid timestamp",
+		report:    `<testsuite name="s" timestamp="yesterday"><testcase name="t"/></testsuite>`,
+		wantError: junit.ErrInvalidReport,
+	}, {
+		name:   "malformed",
+		report: `<testsuite name="s"><testcase`,
+	}, {
+		name:   "empty",
+		report: ``,
+	}}
+	adapter := newAdapter(t)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := adapter.Convert(strings.NewReader(tc.report))
+			if err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if tc.wantError != nil && !errors.Is(err, tc.wantError) {
+				t.Errorf("error %v, want %v", err, tc.wantError)
+			}
+		})
+	}
+}
+
+func TestNewAdapterNoEnvironment(t *testing.T) {
+	_, err := junit.NewAdapter(junit.WithSource(testSource))
+	if err == nil {
+		t.Error("expected an error but got none")
+	}
+}
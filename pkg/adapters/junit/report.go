@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package junit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The types in this file hold the subset of the JUnit XML format used to
+// build CDEvents. There is no formal standard for the format, so they
+// follow the elements and attributes produced by the most common tools
+// (Maven Surefire, Gradle, pytest, go-junit-report, jest-junit).
+
+// junitTimeFormats lists the formats found in the timestamp attribute.
+// Most tools omit the time zone, in which case UTC is assumed.
+var junitTimeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+type result struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// reason returns a single line description of the result
+func (r *result) reason() string {
+	reason := r.Message
+	if reason == "" {
+		reason = r.Type
+	}
+	if reason == "" {
+		reason = strings.TrimSpace(r.Text)
+	}
+	first, _, _ := strings.Cut(reason, "\n")
+	return strings.TrimSpace(first)
+}
+
+type testCase struct {
+	Name      string  `xml:"name,attr"`
+	Classname string  `xml:"classname,attr"`
+	Time      string  `xml:"time,attr"`
+	Failure   *result `xml:"failure"`
+	Error     *result `xml:"error"`
+	Skipped   *result `xml:"skipped"`
+}
+
+// id returns the fully qualified name of the test case
+func (tc testCase) id() string {
+	if tc.Classname == "" {
+		return tc.Name
+	}
+	return tc.Classname + "." + tc.Name
+}
+
+type testSuite struct {
+	Name       string      `xml:"name,attr"`
+	Timestamp  string      `xml:"timestamp,attr"`
+	Time       string      `xml:"time,attr"`
+	TestCases  []testCase  `xml:"testcase"`
+	TestSuites []testSuite `xml:"testsuite"`
+}
+
+type testSuites struct {
+	TestSuites []testSuite `xml:"testsuite"`
+}
+
+// parseReport reads a JUnit report, whose root is either a testsuites or a
+// testsuite element, and returns its test suites with nested suites
+// flattened
+func parseReport(report io.Reader) ([]testSuite, error) {
+	data, err := io.ReadAll(report)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the JUnit report: %w", err)
+	}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var root xml.StartElement
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the JUnit report: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			root = start
+			break
+		}
+	}
+	var suites []testSuite
+	switch root.Name.Local {
+	case "testsuites":
+		var s testSuites
+		err = decoder.DecodeElement(&s, &root)
+		suites = s.TestSuites
+	case "testsuite":
+		var s testSuite
+		err = decoder.DecodeElement(&s, &root)
+		suites = []testSuite{s}
+	default:
+		return nil, fmt.Errorf("%w: unexpected root element %q", ErrInvalidReport, root.Name.Local)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the JUnit report: %w", err)
+	}
+	return flatten(suites), nil
+}
+
+func flatten(suites []testSuite) []testSuite {
+	flat := []testSuite{}
+	for _, suite := range suites {
+		if len(suite.TestCases) > 0 || len(suite.TestSuites) == 0 {
+			flat = append(flat, suite)
+		}
+		flat = append(flat, flatten(suite.TestSuites)...)
+	}
+	return flat
+}
+
+// parseDuration parses a time attribute, in seconds
+func parseDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	// Some tools format large values with a thousands separator
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+	if err != nil || seconds < 0 {
+		return 0, fmt.Errorf("%w: invalid time %q", ErrInvalidReport, value)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// parseTimestamp parses a timestamp attribute
+func parseTimestamp(value string) (time.Time, error) {
+	for _, format := range junitTimeFormats {
+		parsed, err := time.Parse(format, value)
+		if err == nil {
+			return parsed.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid timestamp %q", ErrInvalidReport, value)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package registry
+
+import (
+	"strings"
+	"time"
+)
+
+// The types in this file hold the subset of the notification payloads used
+// by the adapter.
+// Docker Distribution: https://distribution.github.io/distribution/about/notifications/
+// Harbor: https://goharbor.io/docs/main/working-with-projects/project-configuration/configure-webhooks/
+
+const (
+	distributionActionPush   = "push"
+	distributionActionPull   = "pull"
+	distributionActionDelete = "delete"
+
+	harborPushArtifact   = "PUSH_ARTIFACT"
+	harborPullArtifact   = "PULL_ARTIFACT"
+	harborDeleteArtifact = "DELETE_ARTIFACT"
+)
+
+// envelope holds the fields used to detect the format of a notification
+type envelope struct {
+	Events    []distributionEvent `json:"events"`
+	Type      string              `json:"type"`
+	EventData *harborEventData    `json:"event_data"`
+}
+
+type distributionEvent struct {
+	Id        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	Target    struct {
+		MediaType  string `json:"mediaType"`
+		Digest     string `json:"digest"`
+		Repository string `json:"repository"`
+		URL        string `json:"url"`
+		Tag        string `json:"tag"`
+	} `json:"target"`
+	Request struct {
+		Host string `json:"host"`
+	} `json:"request"`
+	Actor struct {
+		Name string `json:"name"`
+	} `json:"actor"`
+}
+
+// isManifest tells whether the event is about a manifest, rather than a
+// layer blob. Deletions of manifests have no media type.
+func (e distributionEvent) isManifest() bool {
+	mediaType := e.Target.MediaType
+	return mediaType == "" || strings.Contains(mediaType, "manifest") || strings.Contains(mediaType, "image.index")
+}
+
+type harborEvent struct {
+	Type      string          `json:"type"`
+	OccurAt   int64           `json:"occur_at"`
+	Operator  string          `json:"operator"`
+	EventData harborEventData `json:"event_data"`
+}
+
+type harborEventData struct {
+	Resources []struct {
+		Digest      string `json:"digest"`
+		Tag         string `json:"tag"`
+		ResourceURL string `json:"resource_url"`
+	} `json:"resources"`
+	Repository struct {
+		Name         string `json:"name"`
+		Namespace    string `json:"namespace"`
+		RepoFullName string `json:"repo_full_name"`
+	} `json:"repository"`
+}
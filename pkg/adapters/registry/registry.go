@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package registry converts the notifications of container registries into
+// CDEvents v0.5 artifact events. Docker Distribution notification envelopes
+// and Harbor webhooks are supported:
+//
+//   - push: ArtifactPublished
+//   - pull: ArtifactDownloaded
+//   - delete: ArtifactDeleted
+//
+// The subject id of the events is the pkg:oci purl of the image, built from
+// the registry, repository, digest and tag of the notification. Notifications
+// about layer blobs produce no events.
+//
+// Clients may push the same image again, and registries notify each push.
+// Pushes of a digest and tag that were already published, within the
+// deduplication window, produce no events. Each tag of an image is published
+// once, since the purl of the event includes the tag.
+package registry
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+)
+
+const (
+	// DefaultDedupWindow is the default time during which pushes of the same
+	// digest and tag are deduplicated
+	DefaultDedupWindow = time.Hour
+
+	// maxPayloadSize is the maximum size of a notification payload accepted
+	maxPayloadSize = 25 * 1024 * 1024
+)
+
+var (
+	// ErrUnsupportedEvent is returned for payloads that are neither Docker
+	// Distribution nor Harbor notifications
+	ErrUnsupportedEvent = errors.New("unsupported registry notification")
+
+	// ErrUnauthorized is returned when the Authorization header of a
+	// request does not match the configured one
+	ErrUnauthorized = errors.New("invalid registry notification credentials")
+)
+
+// Adapter converts registry notifications into CDEvents. It is safe for
+// concurrent use.
+type Adapter struct {
+	source        string
+	registry      string
+	authorization string
+	dedupWindow   time.Duration
+
+	mu        sync.Mutex
+	published map[image]time.Time
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events. By default, the URL
+// of the registry is used.
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithRegistry sets the host of the registry used in the purls, for
+// registries that are known to clients under a different name than the one
+// in their notifications
+func WithRegistry(host string) Option {
+	return func(a *Adapter) {
+		a.registry = host
+	}
+}
+
+// WithAuthorization sets the value expected in the Authorization header of
+// the requests in ParseRequest, as configured in the registry. When not set,
+// requests are not verified.
+func WithAuthorization(authorization string) Option {
+	return func(a *Adapter) {
+		a.authorization = authorization
+	}
+}
+
+// WithDedupWindow sets the time during which pushes of the same digest and
+// tag are deduplicated. A zero window disables deduplication.
+func WithDedupWindow(window time.Duration) Option {
+	return func(a *Adapter) {
+		a.dedupWindow = window
+	}
+}
+
+// NewAdapter creates a new registry notification Adapter
+func NewAdapter(opts ...Option) *Adapter {
+	a := &Adapter{
+		dedupWindow: DefaultDedupWindow,
+		published:   map[image]time.Time{},
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// ParseRequest verifies the Authorization header of a notification request,
+// if configured, and converts its payload into CDEvents
+func (a *Adapter) ParseRequest(r *http.Request) ([]api.CDEventV04, error) {
+	if a.authorization != "" {
+		if subtle.ConstantTimeCompare([]byte(a.authorization), []byte(r.Header.Get("Authorization"))) != 1 {
+			return nil, ErrUnauthorized
+		}
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the notification payload: %w", err)
+	}
+	return a.Convert(payload)
+}
+
+// Convert converts a Docker Distribution notification envelope or a Harbor
+// webhook payload into CDEvents
+func (a *Adapter) Convert(payload []byte) ([]api.CDEventV04, error) {
+	var e envelope
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, fmt.Errorf("cannot parse the notification payload: %w", err)
+	}
+	switch {
+	case e.Events != nil:
+		return a.convertDistribution(e.Events)
+	case e.Type != "" && e.EventData != nil:
+		var event harborEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, fmt.Errorf("cannot parse the Harbor payload: %w", err)
+		}
+		return a.convertHarbor(event)
+	}
+	return nil, ErrUnsupportedEvent
+}
+
+func (a *Adapter) convertDistribution(notifications []distributionEvent) ([]api.CDEventV04, error) {
+	events := []api.CDEventV04{}
+	for _, n := range notifications {
+		if !n.isManifest() || n.Target.Digest == "" {
+			continue
+		}
+		host := a.registry
+		if host == "" {
+			host = n.Request.Host
+			if u, err := url.Parse(n.Target.URL); err == nil && u.Host != "" {
+				host = u.Host
+			}
+		}
+		event, err := a.newEvent(n.Action, image{
+			registry:   host,
+			repository: n.Target.Repository,
+			digest:     n.Target.Digest,
+			tag:        n.Target.Tag,
+		}, n.Actor.Name)
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			continue
+		}
+		// The id of the notification is kept across retries
+		if n.Id != "" {
+			event.SetId(n.Id)
+		}
+		if !n.Timestamp.IsZero() {
+			event.SetTimestamp(n.Timestamp)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (a *Adapter) convertHarbor(notification harborEvent) ([]api.CDEventV04, error) {
+	var action string
+	switch notification.Type {
+	case harborPushArtifact:
+		action = distributionActionPush
+	case harborPullArtifact:
+		action = distributionActionPull
+	case harborDeleteArtifact:
+		action = distributionActionDelete
+	default:
+		// Other Harbor events, like scans or quotas, have no mapping
+		return []api.CDEventV04{}, nil
+	}
+	events := []api.CDEventV04{}
+	repository := notification.EventData.Repository.RepoFullName
+	for _, resource := range notification.EventData.Resources {
+		host := a.registry
+		if host == "" {
+			// The resource URL is the image reference, e.g. harbor.example.com/library/nginx:v1
+			if image, err := api.ParseImageReference(resource.ResourceURL); err == nil {
+				host = image.Domain
+			}
+		}
+		// Resources without a digest cannot be identified by a purl
+		if resource.Digest == "" {
+			continue
+		}
+		event, err := a.newEvent(action, image{
+			registry:   host,
+			repository: repository,
+			digest:     resource.Digest,
+			tag:        resource.Tag,
+		}, notification.Operator)
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			continue
+		}
+		if notification.OccurAt > 0 {
+			event.SetTimestamp(time.Unix(notification.OccurAt, 0).UTC())
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// image identifies an image in a registry
+type image struct {
+	registry   string
+	repository string
+	digest     string
+	tag        string
+}
+
+func (i image) reference() string {
+	ref := i.registry + "/" + i.repository
+	if i.tag != "" {
+		ref += ":" + i.tag
+	}
+	return ref + "@" + i.digest
+}
+
+// newEvent returns the event for an action on an image, or nil when the
+// action has no mapping or the push is a duplicate
+func (a *Adapter) newEvent(action string, img image, user string) (api.CDEventV04, error) {
+	artifactId, err := api.ImageReferenceToPurl(img.reference())
+	if err != nil {
+		return nil, err
+	}
+	var event api.CDEventV04
+	switch action {
+	case distributionActionPush:
+		if a.duplicate(img) {
+			return nil, nil
+		}
+		e, err := cdeventsv05.NewArtifactPublishedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectUser(user)
+		event = e
+	case distributionActionPull:
+		e, err := cdeventsv05.NewArtifactDownloadedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectUser(user)
+		event = e
+	case distributionActionDelete:
+		a.forget(img)
+		e, err := cdeventsv05.NewArtifactDeletedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectUser(user)
+		event = e
+	default:
+		return nil, nil
+	}
+	source := a.source
+	if source == "" {
+		source = "https://" + img.registry
+	}
+	event.SetSource(source)
+	event.SetSubjectId(artifactId)
+	return event, nil
+}
+
+// duplicate records the push of an image, and tells whether its digest and
+// tag were already pushed within the deduplication window
+func (a *Adapter) duplicate(img image) bool {
+	if a.dedupWindow <= 0 {
+		return false
+	}
+	now := time.Now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k, pushed := range a.published {
+		if now.Sub(pushed) > a.dedupWindow {
+			delete(a.published, k)
+		}
+	}
+	if _, ok := a.published[img]; ok {
+		return true
+	}
+	a.published[img] = now
+	return false
+}
+
+// forget removes all the tags of a deleted image from the deduplication
+// entries, so that pushing it again produces an event
+func (a *Adapter) forget(img image) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k := range a.published {
+		if k.registry == img.registry && k.repository == img.repository && k.digest == img.digest {
+			delete(a.published, k)
+		}
+	}
+}
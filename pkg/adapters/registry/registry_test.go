@@ -0,0 +1,337 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package registry_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/registry"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testDigestPurl    = "sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427"
+	testCheckoutPurl  = "pkg:oci/checkout@" + testDigestPurl + "?repository_url=registry.example.com%2Facme%2Fcheckout&tag=v1.4.2"
+	testHarborPurl    = "pkg:oci/checkout@" + testDigestPurl + "?repository_url=harbor.example.com%2Fshop%2Fcheckout&tag=v1.4.2"
+	testAuthorization = "Bearer It's a Secret to Everybody"
+)
+
+type wantEvent struct {
+	eventType api.CDEventType
+	id        string
+	subjectId string
+	source    string
+	content   interface{}
+	timestamp time.Time
+}
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func checkEvents(t *testing.T, want []wantEvent, events []api.CDEventV04) {
+	t.Helper()
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(events))
+	}
+	for i, event := range events {
+		w := want[i]
+		if d := cmp.Diff(w.eventType, event.GetType()); d != "" {
+			t.Errorf("type diff(-want,+got):\n%s", d)
+		}
+		if w.id != "" {
+			if d := cmp.Diff(w.id, event.GetId()); d != "" {
+				t.Errorf("id diff(-want,+got):\n%s", d)
+			}
+		}
+		if d := cmp.Diff(w.subjectId, event.GetSubjectId()); d != "" {
+			t.Errorf("subject id diff(-want,+got):\n%s", d)
+		}
+		if d := cmp.Diff(w.source, event.GetSource()); d != "" {
+			t.Errorf("source diff(-want,+got):\n%s", d)
+		}
+		if d := cmp.Diff(w.content, event.GetSubjectContent()); d != "" {
+			t.Errorf("content diff(-want,+got):\n%s", d)
+		}
+		if d := cmp.Diff(w.timestamp, event.GetTimestamp()); d != "" {
+			t.Errorf("timestamp diff(-want,+got):\n%s", d)
+		}
+		if err := api.Validate(event); err != nil {
+			t.Errorf("produced event is not valid: %v", err)
+		}
+		if err := api.CheckPurl(event.GetSubjectId()); err != nil {
+			t.Errorf("produced purl is not valid: %v", err)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		fixture string
+		opts    []registry.Option
+		want    []wantEvent
+	}{{
+		// The layer is skipped, and each tag of the image is published
+		fixture: "distribution_push",
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactPublishedEventType,
+			id:        "9e1d0a47-3b3f-4b4b-9f4e-6c8b2f0a8d21",
+			subjectId: testCheckoutPurl,
+			source:    "https://registry.example.com",
+			content:   api.ArtifactPublishedSubjectContentV0_3_0{User: "ci-bot"},
+			timestamp: mustParseTime("2024-03-01T10:00:01.118450152Z"),
+		}, {
+			eventType: cdeventsv05.ArtifactPublishedEventType,
+			id:        "a7c3e9f1-5d2b-4c8a-b0e6-3f1d7a9c5e2b",
+			subjectId: "pkg:oci/checkout@" + testDigestPurl + "?repository_url=registry.example.com%2Facme%2Fcheckout&tag=latest",
+			source:    "https://registry.example.com",
+			content:   api.ArtifactPublishedSubjectContentV0_3_0{User: "ci-bot"},
+			timestamp: mustParseTime("2024-03-01T10:00:01.254873001Z"),
+		}},
+	}, {
+		fixture: "distribution_pull_delete",
+		opts:    []registry.Option{registry.WithSource("/registries/main")},
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactDownloadedEventType,
+			id:        "c4e2a1b0-7d6f-4e3a-9b8c-1d0e2f3a4b5c",
+			subjectId: testCheckoutPurl,
+			source:    "/registries/main",
+			content:   api.ArtifactDownloadedSubjectContentV0_2_0{User: "k8s-puller"},
+			timestamp: mustParseTime("2024-03-01T11:00:00Z"),
+		}, {
+			eventType: cdeventsv05.ArtifactDeletedEventType,
+			id:        "d5f3b2c1-8e7a-4f4b-0c9d-2e1f3a4b5c6d",
+			subjectId: "pkg:oci/checkout@" + testDigestPurl + "?repository_url=registry.example.com%2Facme%2Fcheckout",
+			source:    "/registries/main",
+			content:   api.ArtifactDeletedSubjectContentV0_2_0{User: "admin"},
+			timestamp: mustParseTime("2024-03-01T12:00:00Z"),
+		}},
+	}, {
+		fixture: "distribution_pull_delete",
+		opts:    []registry.Option{registry.WithRegistry("cr.acme.io")},
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactDownloadedEventType,
+			subjectId: "pkg:oci/checkout@" + testDigestPurl + "?repository_url=cr.acme.io%2Facme%2Fcheckout&tag=v1.4.2",
+			source:    "https://cr.acme.io",
+			content:   api.ArtifactDownloadedSubjectContentV0_2_0{User: "k8s-puller"},
+			timestamp: mustParseTime("2024-03-01T11:00:00Z"),
+		}, {
+			eventType: cdeventsv05.ArtifactDeletedEventType,
+			subjectId: "pkg:oci/checkout@" + testDigestPurl + "?repository_url=cr.acme.io%2Facme%2Fcheckout",
+			source:    "https://cr.acme.io",
+			content:   api.ArtifactDeletedSubjectContentV0_2_0{User: "admin"},
+			timestamp: mustParseTime("2024-03-01T12:00:00Z"),
+		}},
+	}, {
+		fixture: "harbor_push",
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactPublishedEventType,
+			subjectId: testHarborPurl,
+			source:    "https://harbor.example.com",
+			content:   api.ArtifactPublishedSubjectContentV0_3_0{User: "ci-bot"},
+			timestamp: time.Unix(1709287200, 0).UTC(),
+		}},
+	}, {
+		// The resource without a digest is skipped
+		fixture: "harbor_push_tags",
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactPublishedEventType,
+			subjectId: "pkg:oci/checkout@" + testDigestPurl + "?repository_url=harbor.example.com%2Fshop%2Fcheckout&tag=latest",
+			source:    "https://harbor.example.com",
+			content:   api.ArtifactPublishedSubjectContentV0_3_0{User: "ci-bot"},
+			timestamp: time.Unix(1709290800, 0).UTC(),
+		}},
+	}, {
+		fixture: "harbor_pull",
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactDownloadedEventType,
+			subjectId: testHarborPurl,
+			source:    "https://harbor.example.com",
+			content:   api.ArtifactDownloadedSubjectContentV0_2_0{User: "k8s-puller"},
+			timestamp: time.Unix(1709287200, 0).UTC(),
+		}},
+	}, {
+		fixture: "harbor_delete",
+		want: []wantEvent{{
+			eventType: cdeventsv05.ArtifactDeletedEventType,
+			subjectId: testHarborPurl,
+			source:    "https://harbor.example.com",
+			content:   api.ArtifactDeletedSubjectContentV0_2_0{User: "admin"},
+			timestamp: time.Unix(1709287200, 0).UTC(),
+		}},
+	}, {
+		fixture: "harbor_scan",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, err := registry.NewAdapter(tc.opts...).Convert(loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			checkEvents(t, tc.want, events)
+		})
+	}
+}
+
+func TestConvertDedup(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []registry.Option
+		fixtures []string
+		wait     time.Duration
+		want     int
+	}{{
+		name:     "repeated push",
+		fixtures: []string{"distribution_push", "distribution_push", "harbor_push", "harbor_push"},
+		want:     3,
+	}, {
+		name:     "new tag",
+		fixtures: []string{"harbor_push", "harbor_push_tags", "harbor_push_tags"},
+		want:     2,
+	}, {
+		name:     "push after delete",
+		fixtures: []string{"harbor_push", "harbor_delete", "harbor_push"},
+		want:     3,
+	}, {
+		// Deleting the digest forgets all its tags
+		name:     "push of tags after delete",
+		fixtures: []string{"distribution_push", "distribution_pull_delete", "distribution_push"},
+		want:     6,
+	}, {
+		name:     "disabled",
+		opts:     []registry.Option{registry.WithDedupWindow(0)},
+		fixtures: []string{"distribution_push", "distribution_push"},
+		want:     4,
+	}, {
+		name:     "expired",
+		opts:     []registry.Option{registry.WithDedupWindow(time.Millisecond)},
+		fixtures: []string{"harbor_push", "harbor_push"},
+		wait:     10 * time.Millisecond,
+		want:     2,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			adapter := registry.NewAdapter(tc.opts...)
+			count := 0
+			for _, fixture := range tc.fixtures {
+				events, err := adapter.Convert(loadFixture(t, fixture))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				count += len(events)
+				time.Sleep(tc.wait)
+			}
+			if count != tc.want {
+				t.Errorf("expected %d events, got %d", tc.want, count)
+			}
+		})
+	}
+}
+
+func TestConvertDedupConcurrent(t *testing.T) {
+	adapter := registry.NewAdapter()
+	payload := loadFixture(t, "harbor_push")
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	count := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			events, err := adapter.Convert(payload)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			mu.Lock()
+			count += len(events)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if count != 1 {
+		t.Errorf("expected 1 event, got %d", count)
+	}
+}
+
+func TestConvertUnsupported(t *testing.T) {
+	_, err := registry.NewAdapter().Convert([]byte(`{"kind": "Pod"}`))
+	if !errors.Is(err, registry.ErrUnsupportedEvent) {
+		t.Errorf("expected ErrUnsupportedEvent, got %v", err)
+	}
+	if _, err := registry.NewAdapter().Convert([]byte("not json")); err == nil {
+		t.Error("expected an error, got none")
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantError     error
+	}{{
+		name:          "valid",
+		authorization: testAuthorization,
+	}, {
+		name:          "invalid",
+		authorization: "Bearer nope",
+		wantError:     registry.ErrUnauthorized,
+	}, {
+		name:      "missing",
+		wantError: registry.ErrUnauthorized,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			adapter := registry.NewAdapter(registry.WithAuthorization(testAuthorization))
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(loadFixture(t, "harbor_push")))
+			if tc.authorization != "" {
+				r.Header.Set("Authorization", tc.authorization)
+			}
+			events, err := adapter.ParseRequest(r)
+			if !errors.Is(err, tc.wantError) {
+				t.Fatalf("error %v, want %v", err, tc.wantError)
+			}
+			if tc.wantError == nil && len(events) != 1 {
+				t.Errorf("expected 1 event, got %d", len(events))
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tekton
+
+import (
+	"strings"
+	"time"
+)
+
+// The types in this file hold the subset of the Tekton PipelineRun and
+// TaskRun resources used to build CDEvents. They work with both the
+// tekton.dev/v1 and tekton.dev/v1beta1 API versions.
+// Spec: https://tekton.dev/docs/pipelines/
+
+const (
+	pipelineRunKind = "PipelineRun"
+	taskRunKind     = "TaskRun"
+
+	succeededCondition = "Succeeded"
+
+	pipelineLabel     = "tekton.dev/pipeline"
+	pipelineRunLabel  = "tekton.dev/pipelineRun"
+	pipelineTaskLabel = "tekton.dev/pipelineTask"
+	taskLabel         = "tekton.dev/task"
+
+	// pipelineRunPending is the spec.status of a PipelineRun that
+	// must not be started yet
+	pipelineRunPending = "PipelineRunPending"
+)
+
+type ownerReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	UID        string `json:"uid"`
+	Controller *bool  `json:"controller,omitempty"`
+}
+
+type objectMeta struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace"`
+	UID               string            `json:"uid"`
+	CreationTimestamp *time.Time        `json:"creationTimestamp,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	OwnerReferences   []ownerReference  `json:"ownerReferences,omitempty"`
+}
+
+type ref struct {
+	Name string `json:"name"`
+}
+
+type runSpec struct {
+	PipelineRef *ref   `json:"pipelineRef,omitempty"`
+	TaskRef     *ref   `json:"taskRef,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type runStatus struct {
+	Conditions     []condition `json:"conditions,omitempty"`
+	StartTime      *time.Time  `json:"startTime,omitempty"`
+	CompletionTime *time.Time  `json:"completionTime,omitempty"`
+}
+
+type run struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   objectMeta `json:"metadata"`
+	Spec       runSpec    `json:"spec"`
+	Status     runStatus  `json:"status"`
+}
+
+// succeeded returns the Succeeded condition of the run, if any
+func (r run) succeeded() *condition {
+	for i := range r.Status.Conditions {
+		if r.Status.Conditions[i].Type == succeededCondition {
+			return &r.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// state returns the State of the run
+func (r run) state() State {
+	if c := r.succeeded(); c != nil && (c.Status == "True" || c.Status == "False") {
+		return StateFinished
+	}
+	if r.Status.StartTime != nil && r.Spec.Status != pipelineRunPending {
+		return StateStarted
+	}
+	return StateQueued
+}
+
+// controller returns the owner reference of the controller of the run,
+// falling back to the first owner when none is marked as controller
+func (r run) controller() *ownerReference {
+	for i, owner := range r.Metadata.OwnerReferences {
+		if owner.Controller != nil && *owner.Controller {
+			return &r.Metadata.OwnerReferences[i]
+		}
+	}
+	if len(r.Metadata.OwnerReferences) > 0 {
+		return &r.Metadata.OwnerReferences[0]
+	}
+	return nil
+}
+
+// resource returns the plural resource name of a Tekton kind
+func resource(kind string) string {
+	return strings.ToLower(kind) + "s"
+}
+
+// apiPath returns the path of an object in the Kubernetes API
+func apiPath(apiVersion, kind, namespace, name string) string {
+	return "/apis/" + apiVersion + "/namespaces/" + namespace + "/" + resource(kind) + "/" + name
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package tekton converts Tekton PipelineRun and TaskRun objects into
+// CDEvents v0.5, without depending on the Tekton libraries.
+//
+// Objects are passed as unstructured JSON together with the State last
+// observed for them. The state of a run is derived from its Succeeded
+// condition and start time:
+//
+//   - PipelineRun: PipelineRunQueued, PipelineRunStarted, PipelineRunFinished
+//   - TaskRun: TaskRunStarted, TaskRunFinished
+//
+// When a run skips states between two observations, for instance because it
+// is first observed once finished, an event is produced for each skipped
+// state, so that consumers always see a complete sequence.
+package tekton
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+)
+
+// State is the lifecycle state of a PipelineRun or TaskRun
+type State int
+
+const (
+	// StateUnknown is the state of a run that was never observed
+	StateUnknown State = iota
+	// StateQueued is the state of a run that was not started yet
+	StateQueued
+	// StateStarted is the state of a run that is running
+	StateStarted
+	// StateFinished is the state of a run that completed, successfully or not
+	StateFinished
+)
+
+// ErrUnsupportedKind is returned for objects that are neither a PipelineRun
+// nor a TaskRun
+var ErrUnsupportedKind = errors.New("unsupported Tekton object kind")
+
+// URIData is the data available to the dashboard URI template
+type URIData struct {
+	// Kind is the kind of the run, PipelineRun or TaskRun
+	Kind string
+	// Resource is the plural resource name of the run, pipelineruns or taskruns
+	Resource  string
+	Namespace string
+	Name      string
+	UID       string
+}
+
+// Adapter converts Tekton runs into CDEvents
+type Adapter struct {
+	source      string
+	uriTemplate string
+	uri         *template.Template
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events. By default, the path
+// of the run in the Kubernetes API is used, e.g.
+// /apis/tekton.dev/v1/namespaces/default/pipelineruns/build-1
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithDashboardURI sets the text/template used to build the uri of the
+// runs, e.g.
+// https://dashboard.example.com/#/namespaces/{{.Namespace}}/{{.Resource}}/{{.Name}}
+// The template is executed with URIData. By default, the path of the
+// run in the Kubernetes API is used.
+func WithDashboardURI(uriTemplate string) Option {
+	return func(a *Adapter) {
+		a.uriTemplate = uriTemplate
+	}
+}
+
+// NewAdapter creates a new Tekton Adapter
+func NewAdapter(opts ...Option) (*Adapter, error) {
+	a := &Adapter{}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.uriTemplate != "" {
+		uri, err := template.New("uri").Option("missingkey=error").Parse(a.uriTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dashboard uri template: %w", err)
+		}
+		a.uri = uri
+	}
+	return a, nil
+}
+
+// StateOf returns the State of a PipelineRun or TaskRun
+func StateOf(object []byte) (State, error) {
+	r, err := parseRun(object)
+	if err != nil {
+		return StateUnknown, err
+	}
+	return r.state(), nil
+}
+
+// Convert returns the CDEvents for the transition of a PipelineRun or
+// TaskRun from the previous state to its current one, along with the
+// current state, which should be passed as previous state on the next
+// call for the same run. No events are produced when the state did not
+// change.
+func (a *Adapter) Convert(object []byte, previous State) ([]api.CDEventV04, State, error) {
+	r, err := parseRun(object)
+	if err != nil {
+		return nil, previous, err
+	}
+	current := r.state()
+	events := []api.CDEventV04{}
+	for state := previous + 1; state <= current; state++ {
+		var event api.CDEventV04
+		switch r.Kind {
+		case pipelineRunKind:
+			event, err = a.pipelineRunEvent(r, state)
+		case taskRunKind:
+			event, err = a.taskRunEvent(r, state)
+		}
+		if err != nil {
+			return nil, previous, err
+		}
+		if event == nil {
+			continue
+		}
+		a.setCommon(event, r)
+		events = append(events, event)
+	}
+	return events, max(current, previous), nil
+}
+
+// ConvertUnstructured is like Convert, for objects already decoded into
+// a map, like the Object field of a Kubernetes unstructured.Unstructured
+func (a *Adapter) ConvertUnstructured(object map[string]interface{}, previous State) ([]api.CDEventV04, State, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, previous, fmt.Errorf("cannot encode the Tekton object: %w", err)
+	}
+	return a.Convert(data, previous)
+}
+
+func parseRun(object []byte) (run, error) {
+	var r run
+	if err := json.Unmarshal(object, &r); err != nil {
+		return r, fmt.Errorf("cannot parse the Tekton object: %w", err)
+	}
+	if r.Kind != pipelineRunKind && r.Kind != taskRunKind {
+		return r, fmt.Errorf("%w: %q", ErrUnsupportedKind, r.Kind)
+	}
+	return r, nil
+}
+
+func (a *Adapter) pipelineRunEvent(r run, state State) (api.CDEventV04, error) {
+	uri, err := a.runURI(r)
+	if err != nil {
+		return nil, err
+	}
+	name := pipelineName(r)
+	switch state {
+	case StateQueued:
+		e, err := cdeventsv05.NewPipelineRunQueuedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectPipelineName(name)
+		e.SetSubjectUri(uri)
+		setTimestamp(e, r.Metadata.CreationTimestamp)
+		return e, nil
+	case StateStarted:
+		e, err := cdeventsv05.NewPipelineRunStartedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectPipelineName(name)
+		e.SetSubjectUri(uri)
+		setTimestamp(e, r.Status.StartTime)
+		return e, nil
+	case StateFinished:
+		e, err := cdeventsv05.NewPipelineRunFinishedEvent()
+		if err != nil {
+			return nil, err
+		}
+		outcome, errs := result(r)
+		e.SetSubjectPipelineName(name)
+		e.SetSubjectUri(uri)
+		e.SetSubjectOutcome(outcome)
+		e.SetSubjectErrors(errs)
+		setTimestamp(e, r.Status.CompletionTime)
+		return e, nil
+	}
+	return nil, nil
+}
+
+func (a *Adapter) taskRunEvent(r run, state State) (api.CDEventV04, error) {
+	uri, err := a.runURI(r)
+	if err != nil {
+		return nil, err
+	}
+	name := taskName(r)
+	pipelineRun := a.pipelineRunReference(r)
+	switch state {
+	case StateStarted:
+		e, err := cdeventsv05.NewTaskRunStartedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectTaskName(name)
+		e.SetSubjectUri(uri)
+		e.SetSubjectPipelineRun(pipelineRun)
+		setTimestamp(e, r.Status.StartTime)
+		return e, nil
+	case StateFinished:
+		e, err := cdeventsv05.NewTaskRunFinishedEvent()
+		if err != nil {
+			return nil, err
+		}
+		outcome, errs := result(r)
+		e.SetSubjectTaskName(name)
+		e.SetSubjectUri(uri)
+		e.SetSubjectPipelineRun(pipelineRun)
+		// Task run outcomes are free text, with the pipeline run values
+		e.SetSubjectOutcome(string(outcome))
+		e.SetSubjectErrors(errs)
+		setTimestamp(e, r.Status.CompletionTime)
+		return e, nil
+	}
+	// CDEvents has no queued event for tasks
+	return nil, nil
+}
+
+// setCommon sets the fields shared by all the events of a run
+func (a *Adapter) setCommon(event api.CDEventV04, r run) {
+	source := a.source
+	if source == "" {
+		source = apiPath(r.APIVersion, r.Kind, r.Metadata.Namespace, r.Metadata.Name)
+	}
+	event.SetSource(source)
+	event.SetSubjectId(r.Metadata.Name)
+	if chainId := chainId(r); chainId != "" {
+		event.SetChainId(chainId)
+	}
+}
+
+// runURI returns the uri of the run, rendered from the dashboard template
+// when one is configured
+func (a *Adapter) runURI(r run) (string, error) {
+	if a.uri == nil {
+		return apiPath(r.APIVersion, r.Kind, r.Metadata.Namespace, r.Metadata.Name), nil
+	}
+	var uri bytes.Buffer
+	err := a.uri.Execute(&uri, URIData{
+		Kind:      r.Kind,
+		Resource:  resource(r.Kind),
+		Namespace: r.Metadata.Namespace,
+		Name:      r.Metadata.Name,
+		UID:       r.Metadata.UID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot render the dashboard uri: %w", err)
+	}
+	return uri.String(), nil
+}
+
+// pipelineRunReference returns a reference to the PipelineRun a TaskRun
+// belongs to, if any
+func (a *Adapter) pipelineRunReference(r run) *api.Reference {
+	name := r.Metadata.Labels[pipelineRunLabel]
+	apiVersion := r.APIVersion
+	if owner := r.controller(); owner != nil && owner.Kind == pipelineRunKind {
+		name = owner.Name
+		apiVersion = owner.APIVersion
+	}
+	if name == "" {
+		return nil
+	}
+	source := a.source
+	if source == "" {
+		source = apiPath(apiVersion, pipelineRunKind, r.Metadata.Namespace, name)
+	}
+	return &api.Reference{Id: name, Source: source}
+}
+
+// chainId returns the chain of a run: runs owned by another object, like
+// the TaskRuns of a PipelineRun, belong to the chain of their owner, while
+// other runs start their own chain
+func chainId(r run) string {
+	if owner := r.controller(); owner != nil && owner.UID != "" {
+		return owner.UID
+	}
+	return r.Metadata.UID
+}
+
+// result returns the outcome and errors of a finished run, based on its
+// Succeeded condition
+func result(r run) (cdeventsv05.PipelineRunFinishedSubjectContentOutcome, string) {
+	c := r.succeeded()
+	if c == nil || c.Status == "True" {
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeSuccess, ""
+	}
+	switch c.Reason {
+	case "Cancelled", "PipelineRunCancelled", "TaskRunCancelled", "StoppedRunFinally", "CancelledRunFinally":
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeCancel, c.Message
+	case "Failed", "PipelineRunTimeout", "TaskRunTimeout", "TaskRunImagePullFailed":
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeFailure, c.Message
+	default:
+		// The run could not be executed, e.g. because of an invalid spec
+		return cdeventsv05.PipelineRunFinishedSubjectContentOutcomeError, c.Message
+	}
+}
+
+func pipelineName(r run) string {
+	if r.Spec.PipelineRef != nil && r.Spec.PipelineRef.Name != "" {
+		return r.Spec.PipelineRef.Name
+	}
+	if name := r.Metadata.Labels[pipelineLabel]; name != "" {
+		return name
+	}
+	return r.Metadata.Name
+}
+
+func taskName(r run) string {
+	if name := r.Metadata.Labels[pipelineTaskLabel]; name != "" {
+		return name
+	}
+	if r.Spec.TaskRef != nil && r.Spec.TaskRef.Name != "" {
+		return r.Spec.TaskRef.Name
+	}
+	if name := r.Metadata.Labels[taskLabel]; name != "" {
+		return name
+	}
+	return r.Metadata.Name
+}
+
+// setTimestamp sets the time of the occurrence, when the object provides one
+func setTimestamp(event api.CDEventV04, timestamp *time.Time) {
+	if timestamp != nil && !timestamp.IsZero() {
+		event.SetTimestamp(timestamp.UTC())
+	}
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tekton_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/tekton"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testPipelineRunUID  = "5a8e2f3c-0b1d-4c6e-9f7a-2d4b6c8e0a12"
+	testPipelineRunPath = "/apis/tekton.dev/v1/namespaces/ci/pipelineruns/build-and-test-r7k2p"
+	testTaskRunPath     = "/apis/tekton.dev/v1/namespaces/ci/taskruns/build-and-test-r7k2p-unit-test"
+)
+
+var testPipelineRunRef = &api.Reference{Id: "build-and-test-r7k2p", Source: testPipelineRunPath}
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	object, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return object
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		fixture       string
+		previous      tekton.State
+		wantState     tekton.State
+		wantTypes     []api.CDEventType
+		wantSubjectId string
+		wantSource    string
+		wantChainId   string
+		// wantContent and wantTimestamp are checked on the last event
+		wantContent   interface{}
+		wantTimestamp time.Time
+	}{{
+		fixture:       "pipelinerun_new",
+		previous:      tekton.StateUnknown,
+		wantState:     tekton.StateQueued,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunQueuedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunQueuedSubjectContentV0_3_0{
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:00Z"),
+	}, {
+		fixture:       "pipelinerun_pending",
+		previous:      tekton.StateUnknown,
+		wantState:     tekton.StateQueued,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunQueuedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunQueuedSubjectContentV0_3_0{
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:00Z"),
+	}, {
+		fixture:       "pipelinerun_running",
+		previous:      tekton.StateQueued,
+		wantState:     tekton.StateStarted,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunStartedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunStartedSubjectContentV0_3_0{
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:02Z"),
+	}, {
+		fixture:       "pipelinerun_succeeded",
+		previous:      tekton.StateStarted,
+		wantState:     tekton.StateFinished,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunFinishedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Outcome:      "success",
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:05:00Z"),
+	}, {
+		fixture:   "pipelinerun_succeeded",
+		previous:  tekton.StateUnknown,
+		wantState: tekton.StateFinished,
+		wantTypes: []api.CDEventType{
+			cdeventsv05.PipelineRunQueuedEventType,
+			cdeventsv05.PipelineRunStartedEventType,
+			cdeventsv05.PipelineRunFinishedEventType,
+		},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Outcome:      "success",
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:05:00Z"),
+	}, {
+		fixture:       "pipelinerun_failed",
+		previous:      tekton.StateStarted,
+		wantState:     tekton.StateFinished,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunFinishedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Errors:       "Tasks Completed: 2 (Failed: 1, Cancelled 0), Skipped: 0",
+			Outcome:      "failure",
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:05:00Z"),
+	}, {
+		fixture:       "pipelinerun_cancelled",
+		previous:      tekton.StateStarted,
+		wantState:     tekton.StateFinished,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunFinishedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Errors:       `PipelineRun "build-and-test-r7k2p" was cancelled`,
+			Outcome:      "cancel",
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:05:00Z"),
+	}, {
+		fixture:       "pipelinerun_invalid",
+		previous:      tekton.StateStarted,
+		wantState:     tekton.StateFinished,
+		wantTypes:     []api.CDEventType{cdeventsv05.PipelineRunFinishedEventType},
+		wantSubjectId: "build-and-test-r7k2p",
+		wantSource:    testPipelineRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.PipelineRunFinishedSubjectContentV0_3_0{
+			Errors:       `Error retrieving pipeline for pipelinerun ci/build-and-test-r7k2p: pipelines.tekton.dev "build-and-test" not found`,
+			Outcome:      "error",
+			PipelineName: "build-and-test",
+			Uri:          testPipelineRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:02Z"),
+	}, {
+		fixture:       "taskrun_running",
+		previous:      tekton.StateQueued,
+		wantState:     tekton.StateStarted,
+		wantTypes:     []api.CDEventType{cdeventsv05.TaskRunStartedEventType},
+		wantSubjectId: "build-and-test-r7k2p-unit-test",
+		wantSource:    testTaskRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.TaskRunStartedSubjectContentV0_3_0{
+			PipelineRun: testPipelineRunRef,
+			TaskName:    "unit-test",
+			Uri:         testTaskRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:00:06Z"),
+	}, {
+		fixture:       "taskrun_failed",
+		previous:      tekton.StateStarted,
+		wantState:     tekton.StateFinished,
+		wantTypes:     []api.CDEventType{cdeventsv05.TaskRunFinishedEventType},
+		wantSubjectId: "build-and-test-r7k2p-unit-test",
+		wantSource:    testTaskRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.TaskRunFinishedSubjectContentV0_3_0{
+			Errors:      `"step-test" exited with code 1`,
+			Outcome:     "failure",
+			PipelineRun: testPipelineRunRef,
+			TaskName:    "unit-test",
+			Uri:         testTaskRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:03:00Z"),
+	}, {
+		fixture:       "taskrun_timeout",
+		previous:      tekton.StateStarted,
+		wantState:     tekton.StateFinished,
+		wantTypes:     []api.CDEventType{cdeventsv05.TaskRunFinishedEventType},
+		wantSubjectId: "build-and-test-r7k2p-unit-test",
+		wantSource:    testTaskRunPath,
+		wantChainId:   testPipelineRunUID,
+		wantContent: api.TaskRunFinishedSubjectContentV0_3_0{
+			Errors:      `TaskRun "build-and-test-r7k2p-unit-test" failed to finish within "1h0m0s"`,
+			Outcome:     "failure",
+			PipelineRun: testPipelineRunRef,
+			TaskName:    "unit-test",
+			Uri:         testTaskRunPath,
+		},
+		wantTimestamp: mustParseTime("2024-03-01T11:00:06Z"),
+	}, {
+		fixture:   "taskrun_standalone_succeeded",
+		previous:  tekton.StateUnknown,
+		wantState: tekton.StateFinished,
+		wantTypes: []api.CDEventType{
+			cdeventsv05.TaskRunStartedEventType,
+			cdeventsv05.TaskRunFinishedEventType,
+		},
+		wantSubjectId: "go-test-x9q4m",
+		wantSource:    "/apis/tekton.dev/v1/namespaces/ci/taskruns/go-test-x9q4m",
+		wantChainId:   "c3f1e9a7-6d2b-4e8f-a0c4-7b9d1e3f5a24",
+		wantContent: api.TaskRunFinishedSubjectContentV0_3_0{
+			Outcome:  "success",
+			TaskName: "go-test",
+			Uri:      "/apis/tekton.dev/v1/namespaces/ci/taskruns/go-test-x9q4m",
+		},
+		wantTimestamp: mustParseTime("2024-03-01T10:03:00Z"),
+	}}
+	adapter, err := tekton.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, state, err := adapter.Convert(loadFixture(t, tc.fixture), tc.previous)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantState, state); d != "" {
+				t.Errorf("state diff(-want,+got):\n%s", d)
+			}
+			gotTypes := []api.CDEventType{}
+			for _, event := range events {
+				gotTypes = append(gotTypes, event.GetType())
+				if d := cmp.Diff(tc.wantSource, event.GetSource()); d != "" {
+					t.Errorf("source diff(-want,+got):\n%s", d)
+				}
+				if d := cmp.Diff(tc.wantSubjectId, event.GetSubjectId()); d != "" {
+					t.Errorf("subject id diff(-want,+got):\n%s", d)
+				}
+				if d := cmp.Diff(tc.wantChainId, event.GetChainId()); d != "" {
+					t.Errorf("chain id diff(-want,+got):\n%s", d)
+				}
+				if err := api.Validate(event); err != nil {
+					t.Errorf("produced event is not valid: %v", err)
+				}
+			}
+			if d := cmp.Diff(tc.wantTypes, gotTypes); d != "" {
+				t.Fatalf("types diff(-want,+got):\n%s", d)
+			}
+			last := events[len(events)-1]
+			if d := cmp.Diff(tc.wantContent, last.GetSubjectContent()); d != "" {
+				t.Errorf("content diff(-want,+got):\n%s", d)
+			}
+			if !tc.wantTimestamp.Equal(last.GetTimestamp()) {
+				t.Errorf("timestamp %v, want %v", last.GetTimestamp(), tc.wantTimestamp)
+			}
+		})
+	}
+}
+
+func TestConvertNoTransition(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		previous tekton.State
+	}{{
+		fixture:  "pipelinerun_running",
+		previous: tekton.StateStarted,
+	}, {
+		fixture:  "pipelinerun_succeeded",
+		previous: tekton.StateFinished,
+	}, {
+		// CDEvents has no queued event for tasks
+		fixture:  "taskrun_pending",
+		previous: tekton.StateUnknown,
+	}}
+	adapter, err := tekton.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			events, _, err := adapter.Convert(loadFixture(t, tc.fixture), tc.previous)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 0 {
+				t.Errorf("expected no events, got %d", len(events))
+			}
+		})
+	}
+}
+
+func TestConvertWithOptions(t *testing.T) {
+	adapter, err := tekton.NewAdapter(
+		tekton.WithSource("/tekton/ci"),
+		tekton.WithDashboardURI("https://dashboard.example.com/#/namespaces/{{.Namespace}}/{{.Resource}}/{{.Name}}"))
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	events, _, err := adapter.Convert(loadFixture(t, "taskrun_running"), tekton.StateQueued)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected one event, got %d", len(events))
+	}
+	want := api.TaskRunStartedSubjectContentV0_3_0{
+		PipelineRun: &api.Reference{Id: "build-and-test-r7k2p", Source: "/tekton/ci"},
+		TaskName:    "unit-test",
+		Uri:         "https://dashboard.example.com/#/namespaces/ci/taskruns/build-and-test-r7k2p-unit-test",
+	}
+	if d := cmp.Diff(want, events[0].GetSubjectContent()); d != "" {
+		t.Errorf("content diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff("/tekton/ci", events[0].GetSource()); d != "" {
+		t.Errorf("source diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestConvertUnstructured(t *testing.T) {
+	var object map[string]interface{}
+	if err := json.Unmarshal(loadFixture(t, "pipelinerun_running"), &object); err != nil {
+		t.Fatalf("cannot decode fixture: %v", err)
+	}
+	adapter, err := tekton.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	events, state, err := adapter.ConvertUnstructured(object, tekton.StateQueued)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state != tekton.StateStarted {
+		t.Errorf("state %v, want %v", state, tekton.StateStarted)
+	}
+	if len(events) != 1 || events[0].GetType() != cdeventsv05.PipelineRunStartedEventType {
+		t.Errorf("expected one PipelineRunStarted event, got %v", events)
+	}
+}
+
+func TestStateOf(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    tekton.State
+	}{{
+		fixture: "pipelinerun_new",
+		want:    tekton.StateQueued,
+	}, {
+		fixture: "pipelinerun_pending",
+		want:    tekton.StateQueued,
+	}, {
+		fixture: "pipelinerun_running",
+		want:    tekton.StateStarted,
+	}, {
+		fixture: "pipelinerun_failed",
+		want:    tekton.StateFinished,
+	}, {
+		fixture: "taskrun_pending",
+		want:    tekton.StateQueued,
+	}, {
+		fixture: "taskrun_running",
+		want:    tekton.StateStarted,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			got, err := tekton.StateOf(loadFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("state diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	adapter, err := tekton.NewAdapter()
+	if err != nil {
+		t.Fatalf("cannot create adapter: %v", err)
+	}
+	_, _, err = adapter.Convert(loadFixture(t, "pod"), tekton.StateUnknown)
+	if !errors.Is(err, tekton.ErrUnsupportedKind) {
+		t.Errorf("expected ErrUnsupportedKind, got %v", err)
+	}
+	_, _, err = adapter.Convert([]byte(`{not json}`), tekton.StateUnknown)
+	if err == nil {
+		t.Error("expected an error for a malformed object, got none")
+	}
+}
+
+func TestNewAdapterInvalidTemplate(t *testing.T) {
+	_, err := tekton.NewAdapter(tekton.WithDashboardURI("https://dashboard.example.com/{{.Name"))
+	if err == nil {
+		t.Error("expected an error but got none")
+	}
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tickets
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+)
+
+// ErrInvalidMapping is returned by NewAdapter for incomplete mappings
+var ErrInvalidMapping = errors.New("invalid ticket mapping")
+
+// Mapping describes where the fields of ticket events are found in the
+// payloads of a tracker. Fields are paths made of dot-separated object keys
+// and array indexes, where "*" selects all the elements of an array, e.g.
+// "issue.labels.*.name". Empty paths are not mapped.
+type Mapping struct {
+	// Action is the path of the action of the payload, like "created"
+	Action string
+	// CreatedActions, UpdatedActions and ClosedActions are the actions that
+	// produce TicketCreated, TicketUpdated and TicketClosed events. Other
+	// actions produce no event.
+	CreatedActions []string
+	UpdatedActions []string
+	ClosedActions  []string
+
+	// Id and Uri of the ticket are required
+	Id  string
+	Uri string
+	// Source is the path of the source of the events. When not set, the
+	// source is set with WithSource.
+	Source string
+
+	Summary    string
+	Creator    string
+	Assignees  string
+	Group      string
+	Labels     string
+	Milestone  string
+	Priority   string
+	TicketType string
+	Resolution string
+	UpdatedBy  string
+	// Timestamp is the path of the time of the event, either a RFC 3339
+	// string or a Unix time in milliseconds
+	Timestamp string
+
+	Changelog Changelog
+}
+
+// Changelog describes the changelog entries of the payloads, used to find
+// the resolution of tickets and who updated them
+type Changelog struct {
+	// Items is the path of the changelog entries
+	Items string
+	// Field, To and Author are paths within an entry, of the name of the
+	// changed field, of its new value and of the author of the change
+	Field  string
+	To     string
+	Author string
+	// ResolutionField is the name of the field of resolution changes.
+	// Updates that set a resolution produce TicketClosed events.
+	ResolutionField string
+}
+
+// Adapter converts tracker payloads into ticket events according to a
+// Mapping
+type Adapter struct {
+	mapping     Mapping
+	source      string
+	resolutions map[string]string
+}
+
+// Option configures an Adapter
+type Option func(*Adapter)
+
+// WithSource sets the source of the produced events, for mappings without
+// a Source path, or payloads without a source
+func WithSource(source string) Option {
+	return func(a *Adapter) {
+		a.source = source
+	}
+}
+
+// WithResolutions adds resolutions of the tracker and the CDEvents
+// resolutions they map to, overriding the default ones for the same
+// tracker resolutions. The map is copied.
+func WithResolutions(overrides map[string]string) Option {
+	return func(a *Adapter) {
+		a.resolutions = maps.Clone(a.resolutions)
+		maps.Copy(a.resolutions, overrides)
+	}
+}
+
+// NewAdapter creates an Adapter for the given mapping
+func NewAdapter(mapping Mapping, opts ...Option) (*Adapter, error) {
+	a := &Adapter{mapping: mapping, resolutions: resolutions}
+	for _, opt := range opts {
+		opt(a)
+	}
+	switch {
+	case mapping.Action == "":
+		return nil, fmt.Errorf("%w: the action path is required", ErrInvalidMapping)
+	case mapping.Id == "":
+		return nil, fmt.Errorf("%w: the id path is required", ErrInvalidMapping)
+	case mapping.Uri == "":
+		return nil, fmt.Errorf("%w: the uri path is required", ErrInvalidMapping)
+	case mapping.Source == "" && a.source == "":
+		return nil, fmt.Errorf("%w: either a source path or WithSource is required", ErrInvalidMapping)
+	case mapping.Changelog.Items != "" && mapping.Changelog.Field == "":
+		return nil, fmt.Errorf("%w: the changelog field path is required", ErrInvalidMapping)
+	}
+	return a, nil
+}
+
+// Convert converts a tracker payload into ticket events. Payloads whose
+// action is not mapped produce no events and no error.
+func (a *Adapter) Convert(payload []byte) ([]api.CDEventV04, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("cannot parse the payload: %w", err)
+	}
+	m := a.mapping
+	action := lookupString(doc, m.Action)
+	var kind Kind
+	switch {
+	case slices.Contains(m.ClosedActions, action):
+		kind = KindClosed
+	case slices.Contains(m.UpdatedActions, action):
+		kind = KindUpdated
+	case slices.Contains(m.CreatedActions, action):
+		kind = KindCreated
+	default:
+		return nil, nil
+	}
+	ticket := Ticket{
+		Id:         lookupString(doc, m.Id),
+		Source:     lookupString(doc, m.Source),
+		Uri:        lookupString(doc, m.Uri),
+		Summary:    lookupString(doc, m.Summary),
+		Creator:    lookupString(doc, m.Creator),
+		Assignees:  lookupStrings(doc, m.Assignees),
+		Group:      lookupString(doc, m.Group),
+		Labels:     lookupStrings(doc, m.Labels),
+		Milestone:  lookupString(doc, m.Milestone),
+		Priority:   lookupString(doc, m.Priority),
+		TicketType: lookupString(doc, m.TicketType),
+		Resolution: lookupString(doc, m.Resolution),
+		UpdatedBy:  lookupString(doc, m.UpdatedBy),
+	}
+	if ticket.Source == "" {
+		ticket.Source = a.source
+	}
+	if m.Timestamp != "" {
+		timestamp, err := parseTimestamp(lookup(doc, m.Timestamp))
+		if err != nil {
+			return nil, err
+		}
+		ticket.Timestamp = timestamp
+	}
+	// Changelog entries take precedence over the fields of the ticket
+	if m.Changelog.Items != "" {
+		for _, item := range lookupItems(doc, m.Changelog.Items) {
+			if author := lookupString(item, m.Changelog.Author); author != "" {
+				ticket.UpdatedBy = author
+			}
+			if m.Changelog.ResolutionField != "" && strings.EqualFold(lookupString(item, m.Changelog.Field), m.Changelog.ResolutionField) {
+				ticket.Resolution = lookupString(item, m.Changelog.To)
+				if kind == KindUpdated && ticket.Resolution != "" {
+					kind = KindClosed
+				}
+			}
+		}
+	}
+	event, err := newEvent(kind, ticket, a.resolutions)
+	if err != nil {
+		return nil, err
+	}
+	return []api.CDEventV04{event}, nil
+}
+
+// lookup returns the values found at path in value
+func lookup(value any, path string) []any {
+	if path == "" || value == nil {
+		return nil
+	}
+	values := []any{value}
+	for _, key := range strings.Split(path, ".") {
+		var next []any
+		for _, v := range values {
+			switch v := v.(type) {
+			case map[string]any:
+				if child, ok := v[key]; ok && child != nil {
+					next = append(next, child)
+				}
+			case []any:
+				if key == "*" {
+					for _, child := range v {
+						if child != nil {
+							next = append(next, child)
+						}
+					}
+				} else if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(v) && v[i] != nil {
+					next = append(next, v[i])
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// lookupItems returns the elements of the arrays found at path
+func lookupItems(value any, path string) []any {
+	var items []any
+	for _, v := range lookup(value, path) {
+		if array, ok := v.([]any); ok {
+			items = append(items, array...)
+		} else {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+// lookupString returns the first scalar value found at path, as a string
+func lookupString(value any, path string) string {
+	if values := lookupStrings(value, path); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// lookupStrings returns the scalar values found at path, as strings. Arrays
+// of scalars are flattened.
+func lookupStrings(value any, path string) []string {
+	var result []string
+	for _, v := range lookup(value, path) {
+		if array, ok := v.([]any); ok {
+			for _, element := range array {
+				if s, ok := scalarString(element); ok {
+					result = append(result, s)
+				}
+			}
+		} else if s, ok := scalarString(v); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func scalarString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+func parseTimestamp(values []any) (time.Time, error) {
+	if len(values) == 0 {
+		return time.Time{}, nil
+	}
+	switch v := values[0].(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q: %w", v, err)
+		}
+		return t, nil
+	case json.Number:
+		ms, err := v.Int64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %s: %w", v, err)
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %v", values[0])
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package tickets converts the webhook payloads of issue trackers into
+// CDEvents v0.5 ticket events, using a configurable Mapping of the payload
+// fields:
+//
+//   - created tickets: TicketCreated
+//   - updated tickets: TicketUpdated
+//   - closed tickets: TicketClosed
+//
+// Updates whose changelog sets a resolution produce TicketClosed events, and
+// the author of the last changelog entry, if any, is the updatedBy of the
+// ticket. The package also provides the Ticket type, used by the adapters of
+// specific trackers to build ticket events.
+package tickets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+)
+
+// Kind is the kind of ticket event
+type Kind int
+
+const (
+	// KindNone is used for payloads that produce no event
+	KindNone Kind = iota
+	// KindCreated produces a TicketCreated event
+	KindCreated
+	// KindUpdated produces a TicketUpdated event
+	KindUpdated
+	// KindClosed produces a TicketClosed event
+	KindClosed
+)
+
+var (
+	// resolutions maps common resolutions of trackers to the ones defined
+	// by CDEvents. Resolutions not listed here are used unchanged.
+	resolutions = map[string]string{
+		"Done":             "completed",
+		"Fixed":            "completed",
+		"Resolved":         "completed",
+		"Completed":        "completed",
+		"Won't Do":         "withdrawn",
+		"Won't Fix":        "withdrawn",
+		"Cannot Reproduce": "withdrawn",
+		"Incomplete":       "withdrawn",
+		"Declined":         "withdrawn",
+		"not_planned":      "withdrawn",
+		"Duplicate":        "duplicate",
+	}
+)
+
+// Ticket holds the fields of a ticket event
+type Ticket struct {
+	Id     string
+	Source string
+	Uri    string
+
+	Summary    string
+	Creator    string
+	Assignees  []string
+	Group      string
+	Labels     []string
+	Milestone  string
+	Priority   string
+	TicketType string
+	Resolution string
+	UpdatedBy  string
+
+	// Timestamp is the time of the event, if known
+	Timestamp time.Time
+}
+
+// NewEvent creates the ticket event of the given kind. The resolution of
+// closed tickets is normalized to the resolutions defined by CDEvents, e.g.
+// "Won't Fix" becomes "withdrawn".
+func NewEvent(kind Kind, t Ticket) (api.CDEventV04, error) {
+	return newEvent(kind, t, resolutions)
+}
+
+// newEvent creates the ticket event of the given kind, with the resolution
+// of closed tickets normalized with the given resolutions
+func newEvent(kind Kind, t Ticket, resolutions map[string]string) (api.CDEventV04, error) {
+	var event api.CDEventV04
+	switch kind {
+	case KindCreated:
+		e, err := cdeventsv05.NewTicketCreatedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.Subject.Content = api.TicketCreatedSubjectContentV0_2_0{
+			Assignees:  t.Assignees,
+			Creator:    t.Creator,
+			Group:      t.Group,
+			Labels:     t.Labels,
+			Milestone:  t.Milestone,
+			Priority:   t.Priority,
+			Summary:    t.Summary,
+			TicketType: t.TicketType,
+			Uri:        t.Uri,
+		}
+		event = e
+	case KindUpdated:
+		e, err := cdeventsv05.NewTicketUpdatedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.Subject.Content = api.TicketUpdatedSubjectContentV0_2_0{
+			Assignees:  t.Assignees,
+			Creator:    t.Creator,
+			Group:      t.Group,
+			Labels:     t.Labels,
+			Milestone:  t.Milestone,
+			Priority:   t.Priority,
+			Summary:    t.Summary,
+			TicketType: t.TicketType,
+			UpdatedBy:  t.UpdatedBy,
+			Uri:        t.Uri,
+		}
+		event = e
+	case KindClosed:
+		e, err := cdeventsv05.NewTicketClosedEvent()
+		if err != nil {
+			return nil, err
+		}
+		resolution := t.Resolution
+		if normalized, ok := resolutions[resolution]; ok {
+			resolution = normalized
+		}
+		e.Subject.Content = api.TicketClosedSubjectContentV0_2_0{
+			Assignees:  t.Assignees,
+			Creator:    t.Creator,
+			Group:      t.Group,
+			Labels:     t.Labels,
+			Milestone:  t.Milestone,
+			Priority:   t.Priority,
+			Resolution: resolution,
+			Summary:    t.Summary,
+			TicketType: t.TicketType,
+			UpdatedBy:  t.UpdatedBy,
+			Uri:        t.Uri,
+		}
+		event = e
+	default:
+		return nil, fmt.Errorf("unknown ticket event kind %d", kind)
+	}
+	event.SetSource(t.Source)
+	event.SetSubjectId(t.Id)
+	if !t.Timestamp.IsZero() {
+		event.SetTimestamp(t.Timestamp)
+	}
+	return event, nil
+}
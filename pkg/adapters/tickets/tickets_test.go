@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tickets_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/adapters/tickets"
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testTrackerSource = "https://tracker.example.com"
+
+var (
+	githubMapping = tickets.Mapping{
+		Action:         "action",
+		CreatedActions: []string{"opened"},
+		UpdatedActions: []string{"edited", "assigned", "labeled", "reopened"},
+		ClosedActions:  []string{"closed"},
+		Id:             "issue.number",
+		Uri:            "issue.html_url",
+		Source:         "repository.html_url",
+		Summary:        "issue.title",
+		Creator:        "issue.user.login",
+		Assignees:      "issue.assignees.*.login",
+		Group:          "repository.full_name",
+		Labels:         "issue.labels.*.name",
+		Milestone:      "issue.milestone.title",
+		Resolution:     "issue.state_reason",
+		UpdatedBy:      "sender.login",
+		Timestamp:      "issue.updated_at",
+	}
+
+	trackerMapping = tickets.Mapping{
+		Action:         "event",
+		CreatedActions: []string{"ticket.created"},
+		UpdatedActions: []string{"ticket.changed"},
+		Id:             "ticket.id",
+		Uri:            "ticket.url",
+		Summary:        "ticket.title",
+		Creator:        "ticket.reporter",
+		Group:          "ticket.team",
+		Labels:         "ticket.tags",
+		Priority:       "ticket.priority",
+		TicketType:     "ticket.type",
+		Timestamp:      "occurred_at",
+		Changelog: tickets.Changelog{
+			Items:           "changes",
+			Field:           "field",
+			To:              "new",
+			Author:          "by.name",
+			ResolutionField: "resolution",
+		},
+	}
+)
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("cannot load fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func mustParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return parsed
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name          string
+		mapping       tickets.Mapping
+		payload       []byte
+		wantType      api.CDEventType
+		wantSubjectId string
+		wantSource    string
+		wantContent   interface{}
+		wantTimestamp time.Time
+	}{{
+		name:          "github issue closed",
+		mapping:       githubMapping,
+		payload:       loadFixture(t, "github_issue_closed"),
+		wantType:      cdeventsv05.TicketClosedEventType,
+		wantSubjectId: "7",
+		wantSource:    "https://github.com/acme/shop",
+		wantContent: api.TicketClosedSubjectContentV0_2_0{
+			Assignees:  []string{"bob", "carol"},
+			Creator:    "alice",
+			Group:      "acme/shop",
+			Labels:     []string{"bug", "payments"},
+			Milestone:  "v1.0",
+			Resolution: "withdrawn",
+			Summary:    "Checkout fails with expired cards",
+			UpdatedBy:  "bob",
+			Uri:        "https://github.com/acme/shop/issues/7",
+		},
+		wantTimestamp: mustParseTime("2024-03-02T16:30:00Z"),
+	}, {
+		name:          "tracker resolution in changelog",
+		mapping:       trackerMapping,
+		payload:       loadFixture(t, "tracker_changed"),
+		wantType:      cdeventsv05.TicketClosedEventType,
+		wantSubjectId: "123",
+		wantSource:    testTrackerSource,
+		wantContent: api.TicketClosedSubjectContentV0_2_0{
+			Group:      "platform",
+			Labels:     []string{"backup", "ops"},
+			Priority:   "P1",
+			Resolution: "completed",
+			Summary:    "Nightly backup did not run",
+			TicketType: "incident",
+			UpdatedBy:  "dave",
+			Uri:        "https://tracker.example.com/tickets/123",
+		},
+		wantTimestamp: time.UnixMilli(1709301600000).UTC(),
+	}, {
+		name:    "tracker update",
+		mapping: trackerMapping,
+		payload: []byte(`{"event": "ticket.changed", "ticket": {"id": "124", "url": "https://tracker.example.com/tickets/124"},
+			"changes": [{"field": "priority", "new": "P2", "by": {"name": "erin"}}]}`),
+		wantType:      cdeventsv05.TicketUpdatedEventType,
+		wantSubjectId: "124",
+		wantSource:    testTrackerSource,
+		wantContent: api.TicketUpdatedSubjectContentV0_2_0{
+			UpdatedBy: "erin",
+			Uri:       "https://tracker.example.com/tickets/124",
+		},
+	}, {
+		name:    "tracker created",
+		mapping: trackerMapping,
+		payload: []byte(`{"event": "ticket.created", "occurred_at": 1709287200000, "ticket": {"id": 125,
+			"url": "https://tracker.example.com/tickets/125", "title": "Rotate the TLS certificates", "reporter": "frank", "tags": "ops"}}`),
+		wantType:      cdeventsv05.TicketCreatedEventType,
+		wantSubjectId: "125",
+		wantSource:    testTrackerSource,
+		wantContent: api.TicketCreatedSubjectContentV0_2_0{
+			Creator: "frank",
+			Labels:  []string{"ops"},
+			Summary: "Rotate the TLS certificates",
+			Uri:     "https://tracker.example.com/tickets/125",
+		},
+		wantTimestamp: time.UnixMilli(1709287200000).UTC(),
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			adapter, err := tickets.NewAdapter(tc.mapping, tickets.WithSource(testTrackerSource))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			events, err := adapter.Convert(tc.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(events))
+			}
+			event := events[0]
+			if d := cmp.Diff(tc.wantType, event.GetType()); d != "" {
+				t.Errorf("type diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSubjectId, event.GetSubjectId()); d != "" {
+				t.Errorf("subject id diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSource, event.GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantContent, event.GetSubjectContent()); d != "" {
+				t.Errorf("content diff(-want,+got):\n%s", d)
+			}
+			if !tc.wantTimestamp.IsZero() {
+				if d := cmp.Diff(tc.wantTimestamp, event.GetTimestamp()); d != "" {
+					t.Errorf("timestamp diff(-want,+got):\n%s", d)
+				}
+			}
+			if err := api.Validate(event); err != nil {
+				t.Errorf("produced event is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestConvertWithResolutions(t *testing.T) {
+	overrides := map[string]string{"Fixed": "duplicate"}
+	adapter, err := tickets.NewAdapter(trackerMapping, tickets.WithSource(testTrackerSource), tickets.WithResolutions(overrides))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The adapter keeps its own copy of the resolutions
+	overrides["Fixed"] = "withdrawn"
+	defaultAdapter, err := tickets.NewAdapter(trackerMapping, tickets.WithSource(testTrackerSource))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		name           string
+		adapter        *tickets.Adapter
+		wantResolution string
+	}{{
+		name:           "overridden resolution",
+		adapter:        adapter,
+		wantResolution: "duplicate",
+	}, {
+		name:           "default resolution",
+		adapter:        defaultAdapter,
+		wantResolution: "completed",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.adapter.Convert(loadFixture(t, "tracker_changed"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(events))
+			}
+			content, ok := events[0].GetSubjectContent().(api.TicketClosedSubjectContentV0_2_0)
+			if !ok {
+				t.Fatalf("expected a ticket closed content, got %T", events[0].GetSubjectContent())
+			}
+			if d := cmp.Diff(tc.wantResolution, content.Resolution); d != "" {
+				t.Errorf("resolution diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestConvertNoEvents(t *testing.T) {
+	adapter, err := tickets.NewAdapter(githubMapping)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	events, err := adapter.Convert([]byte(`{"action": "pinned", "issue": {"number": 7}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events, got %d", len(events))
+	}
+	if _, err := adapter.Convert([]byte("not json")); err == nil {
+		t.Error("expected an error, got none")
+	}
+	if _, err := adapter.Convert([]byte(`{"action": "closed", "issue": {"updated_at": "yesterday"}}`)); err == nil {
+		t.Error("expected an error for an invalid timestamp, got none")
+	}
+}
+
+func TestNewAdapterInvalidMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping tickets.Mapping
+		opts    []tickets.Option
+	}{{
+		name:    "no action",
+		mapping: tickets.Mapping{Id: "id", Uri: "url", Source: "source"},
+	}, {
+		name:    "no id",
+		mapping: tickets.Mapping{Action: "action", Uri: "url", Source: "source"},
+	}, {
+		name:    "no uri",
+		mapping: tickets.Mapping{Action: "action", Id: "id", Source: "source"},
+	}, {
+		name:    "no source",
+		mapping: tickets.Mapping{Action: "action", Id: "id", Uri: "url"},
+	}, {
+		name:    "changelog without field",
+		mapping: tickets.Mapping{Action: "action", Id: "id", Uri: "url", Changelog: tickets.Changelog{Items: "changes"}},
+		opts:    []tickets.Option{tickets.WithSource(testTrackerSource)},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tickets.NewAdapter(tc.mapping, tc.opts...); !errors.Is(err, tickets.ErrInvalidMapping) {
+				t.Errorf("expected ErrInvalidMapping, got %v", err)
+			}
+		})
+	}
+}
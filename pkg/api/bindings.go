@@ -20,10 +20,13 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
 	"regexp"
+	"slices"
+	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/go-playground/validator/v10"
@@ -40,6 +43,10 @@ var (
 	// Validation helper as singleton
 	validate              *validator.Validate
 	CDEventsSchemaIdRegex = regexp.MustCompile(SCHEMA_ID_REGEX)
+
+	// ErrInvalidEnumValue is returned by Validate for content fields
+	// that hold a value which is not defined in the enum of the schema
+	ErrInvalidEnumValue = errors.New("invalid enum value")
 )
 
 func init() {
@@ -48,7 +55,7 @@ func init() {
 	validate.RegisterStructValidation(ValidateEventType, CDEventType{})
 	err := validate.RegisterValidation("uri-reference", ValidateUriReference)
 	panicOnError(err)
-	err = validate.RegisterValidation("purl", ValidatePurl)
+	err = validate.RegisterValidation("purl", validatePurl)
 	panicOnError(err)
 	err = validate.RegisterValidation("event-link-type", ValidateLinkType)
 	panicOnError(err)
@@ -102,7 +109,7 @@ func ValidateLinkType(fl validator.FieldLevel) bool {
 }
 
 // AsCloudEvent renders a CDEvent as a CloudEvent
-func AsCloudEvent(event CDEventReader) (*cloudevents.Event, error) {
+func AsCloudEvent(event CDEventReader, opts ...CloudEventOption) (*cloudevents.Event, error) {
 	if event == nil {
 		return nil, fmt.Errorf("nil CDEvent cannot be rendered as CloudEvent")
 	}
@@ -116,6 +123,15 @@ func AsCloudEvent(event CDEventReader) (*cloudevents.Event, error) {
 	ce.SetSource(event.GetSource())
 	ce.SetSubject(event.GetSubjectId())
 	ce.SetType(event.GetType().String())
+	options := &cloudEventOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.contextExtensions {
+		for name, value := range contextExtensions(event) {
+			ce.SetExtension(name, value)
+		}
+	}
 	err = ce.SetData(cloudevents.ApplicationJSON, event)
 	return &ce, err
 }
@@ -157,7 +173,7 @@ func Validate(event CDEventReader) error {
 	}
 	// Validate the "validate" tags
 	if err := validate.Struct(event); err != nil {
-		return err
+		return enumValueErrors(err)
 	}
 	// Validate the "jsonschema" tags
 	if err := sch.Validate(v); err != nil {
@@ -182,6 +198,64 @@ func Validate(event CDEventReader) error {
 	return nil
 }
 
+// enumValues holds the values of the generated enum types, by type. It is
+// only written by the init functions of the generated code.
+var enumValues = map[reflect.Type][]string{}
+
+// registerEnumValues registers the values of a generated enum type, which
+// are reported when a content field holds another value
+func registerEnumValues[T ~string](values ...T) {
+	stringValues := make([]string, 0, len(values))
+	for _, value := range values {
+		stringValues = append(stringValues, string(value))
+	}
+	enumValues[reflect.TypeFor[T]()] = stringValues
+}
+
+// enumErrors reports invalid enum values along with the other validation
+// errors, and unwraps to ErrInvalidEnumValue and the validation errors
+type enumErrors struct {
+	errs             []error
+	validationErrors validator.ValidationErrors
+}
+
+func (e *enumErrors) Error() string {
+	return errors.Join(e.errs...).Error()
+}
+
+func (e *enumErrors) Unwrap() []error {
+	return append(slices.Clone(e.errs), e.validationErrors)
+}
+
+// enumValueErrors reports the allowed values for the enum fields that
+// failed validation. Other validation errors are returned as they are.
+func enumValueErrors(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+	foundEnum := false
+	errs := []error{}
+	for _, fieldError := range validationErrors {
+		fieldType := fieldError.Type()
+		if fieldType != nil && fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		values, ok := enumValues[fieldType]
+		if fieldError.Tag() != "oneof" || !ok {
+			errs = append(errs, fieldError)
+			continue
+		}
+		foundEnum = true
+		errs = append(errs, fmt.Errorf("%w %q for %s, allowed values: %s", ErrInvalidEnumValue,
+			fieldError.Value(), fieldError.Namespace(), strings.Join(values, ", ")))
+	}
+	if !foundEnum {
+		return err
+	}
+	return &enumErrors{errs: errs, validationErrors: validationErrors}
+}
+
 // NewFromJsonBytesContext[ContextType] builds a new CDEventReader from a JSON string as []bytes
 // This works by unmarshalling the context first, extracting the event type and using
 // that to unmarshal the rest of the event into the correct object.
@@ -204,6 +278,24 @@ func NewFromJsonBytesContext[CDEventType CDEvent](event []byte, cdeventsMap map[
 		if !ok {
 			return nilReturn, fmt.Errorf("custom events not supported by this map")
 		}
+		// Registered custom types are returned if they match the custom
+		// event type of this map, which defines the context format
+		customType, err := LookupCustomType(eventType)
+		if err != nil {
+			return nilReturn, err
+		}
+		if base, ok := any(receiver).(CDEventV04); ok && customType != nil {
+			registered, found := newCustomTypeEvent(customType, base)
+			if typedReceiver, ok := registered.(CDEventType); found && ok {
+				if err := json.Unmarshal(event, typedReceiver); err != nil {
+					return nilReturn, err
+				}
+				if err := validateCustomType(customType, registered, event); err != nil {
+					return nilReturn, err
+				}
+				return typedReceiver, nil
+			}
+		}
 	} else {
 		receiver, ok = cdeventsMap[eventType.UnversionedString()]
 		if !ok {
@@ -20,6 +20,7 @@ package api_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	"github.com/cdevents/sdk-go/pkg/api"
 	testapi "github.com/cdevents/sdk-go/pkg/api/v991"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 )
@@ -497,6 +499,138 @@ func TestInvalidEvent(t *testing.T) {
 	}
 }
 
+func TestValidateOptionalArtifactId(t *testing.T) {
+	incidentDetected := func(artifactId string) api.CDEventReader {
+		e, _ := api.NewIncidentDetectedEventV0_3_0("0.5.1")
+		e.SetSource(testSource)
+		e.SetSubjectId(testSubjectId)
+		e.SetSubjectEnvironment(&api.Reference{Id: "prod"})
+		e.SetSubjectArtifactId(artifactId)
+		return e
+	}
+
+	tests := []struct {
+		name      string
+		event     api.CDEventReader
+		wantError bool
+	}{{
+		name:  "empty optional artifact id",
+		event: incidentDetected(""),
+	}, {
+		name:  "valid optional artifact id",
+		event: incidentDetected(testArtifactId),
+	}, {
+		name:      "invalid optional artifact id",
+		event:     incidentDetected(testInvalidArtifactId),
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := api.Validate(tc.event)
+			if tc.wantError && err == nil {
+				t.Fatal("expected an error but got none")
+			}
+			if !tc.wantError && err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateEnum(t *testing.T) {
+	pipelineRunFinished := func(outcome api.PipelineRunFinishedSubjectContentOutcomeV0_3_0) api.CDEventReader {
+		e, _ := api.NewPipelineRunFinishedEventV0_3_0("0.5.1")
+		e.SetSource(testSource)
+		e.SetSubjectId(testSubjectId)
+		e.SetSubjectOutcome(outcome)
+		return e
+	}
+	testCaseRunFinished := func(testCaseType api.TestCaseRunFinishedSubjectContentTestCaseTypeV0_3_0) api.CDEventReader {
+		e, _ := api.NewTestCaseRunFinishedEventV0_3_0("0.5.1")
+		e.SetSource(testSource)
+		e.SetSubjectId(testSubjectId)
+		e.SetSubjectEnvironment(&api.Reference{Id: "prod"})
+		e.SetSubjectOutcome(api.TestCaseRunFinishedSubjectContentOutcomeSuccessV0_3_0)
+		e.SetSubjectTestCase(&api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "test", Type: testCaseType})
+		return e
+	}
+	ticketCreated := func(priority string) api.CDEventReader {
+		e, _ := api.NewTicketCreatedEventV0_2_0("0.5.1")
+		e.SetSource(testSource)
+		e.SetSubjectId(testSubjectId)
+		e.SetSubjectCreator("alice")
+		e.SetSubjectSummary("summary")
+		e.SetSubjectUri("https://example.com/tickets/1")
+		e.SetSubjectPriority(priority)
+		return e
+	}
+	fooSubjectBarPredicate := func(mode testapi.FooSubjectBarPredicateSubjectContentMode) api.CDEventReader {
+		e, _ := testapi.NewFooSubjectBarPredicateEvent()
+		setContext(e, testSubjectId)
+		e.SetSubjectPlainField(testValue)
+		e.SetSubjectReferenceField(&api.Reference{Id: "1234"})
+		e.SetSubjectMode(mode)
+		return e
+	}
+
+	tests := []struct {
+		name      string
+		event     api.CDEventReader
+		wantError string
+	}{{
+		name:  "valid outcome",
+		event: pipelineRunFinished(api.PipelineRunFinishedSubjectContentOutcomeSuccessV0_3_0),
+	}, {
+		name:  "optional outcome",
+		event: pipelineRunFinished(""),
+	}, {
+		name:      "invalid outcome",
+		event:     pipelineRunFinished("succes"),
+		wantError: `invalid enum value "succes" for PipelineRunFinishedEventV0_3_0.Subject.Content.Outcome, allowed values: success, failure, cancel, error`,
+	}, {
+		name:  "valid nested enum",
+		event: testCaseRunFinished(api.TestCaseRunFinishedSubjectContentTestCaseTypeE2eV0_3_0),
+	}, {
+		name:      "invalid nested enum",
+		event:     testCaseRunFinished("smoke"),
+		wantError: `invalid enum value "smoke" for TestCaseRunFinishedEventV0_3_0.Subject.Content.TestCase.Type, allowed values: performance, functional, unit, security, compliance, integration, e2e, other`,
+	}, {
+		name:  "enum value of anyOf",
+		event: ticketCreated(api.TicketCreatedSubjectContentPriorityHighV0_2_0),
+	}, {
+		name:  "free text of anyOf",
+		event: ticketCreated("urgent"),
+	}, {
+		name:  "enum value with spaces",
+		event: fooSubjectBarPredicate(testapi.FooSubjectBarPredicateSubjectContentModeDryRun),
+	}, {
+		name:      "invalid enum value with spaces",
+		event:     fooSubjectBarPredicate("plan"),
+		wantError: `invalid enum value "plan" for FooSubjectBarPredicateEventV2_2_3.Subject.Content.Mode, allowed values: dry run, apply`,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := api.Validate(tc.event)
+			if tc.wantError == "" {
+				if err != nil {
+					t.Fatalf("didn't expected it to fail, but it did: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, api.ErrInvalidEnumValue) {
+				t.Fatalf("expected %v, got %v", api.ErrInvalidEnumValue, err)
+			}
+			var validationErrors validator.ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Errorf("expected %v to unwrap to validator.ValidationErrors", err)
+			}
+			if d := cmp.Diff(tc.wantError, err.Error()); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
 func TestAsJsonStringEmpty(t *testing.T) {
 	obtainedJsonString, err := api.AsJsonString(nil)
 	if err != nil {
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// CloudEvents extension attributes that carry CDEvents context fields.
+// Extension names are limited to lowercase letters and digits by the
+// CloudEvents specification.
+const (
+	ExtensionChainId       = "cdchainid"
+	ExtensionSpecVersion   = "cdspecversion"
+	ExtensionSchemaUri     = "cdschemauri"
+	ExtensionSubjectSource = "cdsubjectsource"
+)
+
+// ErrCloudEventMismatch is returned when the attributes of a CloudEvent do
+// not match the CDEvent in its payload
+var ErrCloudEventMismatch = errors.New("cloudevent attributes do not match the cdevent")
+
+// CloudEventOption configures how AsCloudEvent renders a CDEvent
+type CloudEventOption func(*cloudEventOptions)
+
+type cloudEventOptions struct {
+	contextExtensions bool
+}
+
+// WithContextExtensions sets the chainId, specversion, schemaUri and subject
+// source of the CDEvent as CloudEvents extension attributes, so that brokers
+// can filter on them. Empty fields are not set.
+func WithContextExtensions() CloudEventOption {
+	return func(o *cloudEventOptions) {
+		o.contextExtensions = true
+	}
+}
+
+// contextExtensions returns the values of the extension attributes of a
+// CDEvent, leaving out empty fields
+func contextExtensions(event CDEventReader) map[string]string {
+	extensions := map[string]string{
+		ExtensionSpecVersion:   event.GetVersion(),
+		ExtensionSubjectSource: event.GetSubjectSource(),
+	}
+	if v04, ok := event.(CDEventReaderV04); ok {
+		extensions[ExtensionChainId] = v04.GetChainId()
+		extensions[ExtensionSchemaUri] = v04.GetSchemaUri()
+	}
+	for name, value := range extensions {
+		if value == "" {
+			delete(extensions, name)
+		}
+	}
+	return extensions
+}
+
+// CheckCloudEvent checks that the attributes of a CloudEvent match the
+// CDEvent in its payload: the id, source, type and subject, and the context
+// extension attributes, when present. It returns an error that wraps
+// ErrCloudEventMismatch and lists the mismatches, if any.
+func CheckCloudEvent(ce cloudevents.Event, event CDEventReader) error {
+	var mismatches []error
+	check := func(attribute, got, want string) {
+		if got != want {
+			mismatches = append(mismatches, fmt.Errorf("%s is %q, but %q in the cdevent", attribute, got, want))
+		}
+	}
+	check("id", ce.ID(), event.GetId())
+	check("source", ce.Source(), event.GetSource())
+	check("type", ce.Type(), event.GetType().String())
+	check("subject", ce.Subject(), event.GetSubjectId())
+	want := contextExtensions(event)
+	for _, name := range []string{ExtensionChainId, ExtensionSpecVersion, ExtensionSchemaUri, ExtensionSubjectSource} {
+		value, ok := ce.Extensions()[name]
+		if !ok {
+			continue
+		}
+		got, err := types.ToString(value)
+		if err != nil {
+			mismatches = append(mismatches, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		check(name, got, want[name])
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%w: %w", ErrCloudEventMismatch, errors.Join(mismatches...))
+	}
+	return nil
+}
+
+// NewFromCloudEventContext[CDEventType] builds a new CDEvent from the payload
+// of a CloudEvent, like NewFromJsonBytesContext. The chainId, schemaUri and
+// subject source are restored from the context extension attributes when
+// they are missing from the payload, and the CloudEvent is then checked with
+// CheckCloudEvent.
+func NewFromCloudEventContext[CDEventType CDEvent](ce cloudevents.Event, cdeventsMap map[string]CDEventType) (CDEventType, error) {
+	var nilReturn CDEventType
+	event, err := NewFromJsonBytesContext(ce.Data(), cdeventsMap)
+	if err != nil {
+		return nilReturn, err
+	}
+	extension := func(name string) string {
+		value, _ := types.ToString(ce.Extensions()[name])
+		return value
+	}
+	if event.GetSubjectSource() == "" {
+		event.SetSubjectSource(extension(ExtensionSubjectSource))
+	}
+	if v04, ok := any(event).(CDEventV04); ok {
+		if v04.GetChainId() == "" {
+			v04.SetChainId(extension(ExtensionChainId))
+		}
+		if v04.GetSchemaUri() == "" {
+			v04.SetSchemaUri(extension(ExtensionSchemaUri))
+		}
+	}
+	if err := CheckCloudEvent(ce, event); err != nil {
+		return nilReturn, err
+	}
+	return event, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	testapi "github.com/cdevents/sdk-go/pkg/api/v991"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAsCloudEventContextExtensions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []api.CloudEventOption
+		want map[string]interface{}
+	}{{
+		name: "no options",
+		want: map[string]interface{}{},
+	}, {
+		name: "context extensions",
+		opts: []api.CloudEventOption{api.WithContextExtensions()},
+		want: map[string]interface{}{
+			api.ExtensionChainId:       testChainId,
+			api.ExtensionSpecVersion:   testapi.SpecVersion,
+			api.ExtensionSchemaUri:     testSchemaUri,
+			api.ExtensionSubjectSource: testSource,
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ce, err := api.AsCloudEvent(eventJsonCustomData, tc.opts...)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if err := ce.Validate(); err != nil {
+				t.Fatalf("expected a valid CloudEvent, got %v", err)
+			}
+			if d := cmp.Diff(tc.want, ce.Extensions(), cmpopts.EquateEmpty()); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestNewFromCloudEvent(t *testing.T) {
+	// The payload lacks the chainId, schemaUri and subject source, which
+	// are restored from the extension attributes
+	stripped := *eventJsonCustomData
+	stripped.SetChainId("")
+	stripped.SetSchemaUri("")
+	stripped.SetSubjectSource("")
+
+	tests := []struct {
+		name    string
+		payload api.CDEventReader
+	}{{
+		name:    "full payload",
+		payload: eventJsonCustomData,
+	}, {
+		name:    "restored from extensions",
+		payload: &stripped,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ce, err := api.AsCloudEvent(eventJsonCustomData, api.WithContextExtensions())
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if err := ce.SetData(cloudevents.ApplicationJSON, tc.payload); err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			got, err := testapi.NewFromCloudEvent(*ce)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if d := cmp.Diff(api.CDEventV04(eventJsonCustomData), got, cmpopts.IgnoreFields(api.CDEventCustomData{}, "CustomData")); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestNewFromCloudEventMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ce *cloudevents.Event)
+	}{{
+		name:   "id",
+		mutate: func(ce *cloudevents.Event) { ce.SetID("another-id") },
+	}, {
+		name:   "type",
+		mutate: func(ce *cloudevents.Event) { ce.SetType("dev.cdevents.foosubject.barpredicate.2.3.0") },
+	}, {
+		name:   "subject",
+		mutate: func(ce *cloudevents.Event) { ce.SetSubject("anotherSubject") },
+	}, {
+		name:   "chain id",
+		mutate: func(ce *cloudevents.Event) { ce.SetExtension(api.ExtensionChainId, "another-chain") },
+	}, {
+		name:   "spec version",
+		mutate: func(ce *cloudevents.Event) { ce.SetExtension(api.ExtensionSpecVersion, "0.4.1") },
+	}, {
+		name:   "schema uri",
+		mutate: func(ce *cloudevents.Event) { ce.SetExtension(api.ExtensionSchemaUri, "https://example.com/other") },
+	}, {
+		name:   "subject source",
+		mutate: func(ce *cloudevents.Event) { ce.SetExtension(api.ExtensionSubjectSource, "/another/source") },
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ce, err := api.AsCloudEvent(eventJsonCustomData, api.WithContextExtensions())
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			tc.mutate(ce)
+			if err := api.CheckCloudEvent(*ce, eventJsonCustomData); !errors.Is(err, api.ErrCloudEventMismatch) {
+				t.Errorf("expected ErrCloudEventMismatch from CheckCloudEvent, got %v", err)
+			}
+			if _, err := testapi.NewFromCloudEvent(*ce); !errors.Is(err, api.ErrCloudEventMismatch) {
+				t.Errorf("expected ErrCloudEventMismatch from NewFromCloudEvent, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Content types of the built-in custom data codecs
+const (
+	ContentTypeJson = "application/json"
+	ContentTypeYaml = "application/yaml"
+	ContentTypeXml  = "application/xml"
+	ContentTypeText = "text/plain"
+)
+
+var (
+	// customDataCodecs holds the codecs by media type
+	customDataCodecs = map[string]CustomDataCodec{
+		ContentTypeJson:      jsonCodec{},
+		ContentTypeYaml:      yamlCodec{},
+		"application/x-yaml": yamlCodec{},
+		"text/yaml":          yamlCodec{},
+		ContentTypeXml:       xmlCodec{},
+		"text/xml":           xmlCodec{},
+		ContentTypeText:      textCodec{},
+	}
+	customDataCodecsMu sync.RWMutex
+
+	// Media types with a structured syntax suffix, like
+	// application/vnd.cyclonedx+xml, use the codec of the suffix
+	suffixMediaTypes = map[string]string{
+		"+json": ContentTypeJson,
+		"+yaml": ContentTypeYaml,
+		"+xml":  ContentTypeXml,
+	}
+)
+
+// CustomDataCodec encodes Go values into custom data of a content type,
+// and decodes custom data into Go values
+type CustomDataCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// RegisterCustomDataCodec registers the codec for the customDataContentType
+// contentType, e.g. to support protobuf payloads. Parameters of the content
+// type are ignored. Registering a codec again replaces the previous one.
+func RegisterCustomDataCodec(contentType string, codec CustomDataCodec) error {
+	mediaType, err := parseMediaType(contentType)
+	if err != nil {
+		return err
+	}
+	if codec == nil {
+		return fmt.Errorf("nil codec for content type %s", contentType)
+	}
+	customDataCodecsMu.Lock()
+	defer customDataCodecsMu.Unlock()
+	customDataCodecs[mediaType] = codec
+	return nil
+}
+
+// UnregisterCustomDataCodec removes the codec registered for the
+// customDataContentType contentType, if any
+func UnregisterCustomDataCodec(contentType string) {
+	mediaType, err := parseMediaType(contentType)
+	if err != nil {
+		return
+	}
+	customDataCodecsMu.Lock()
+	defer customDataCodecsMu.Unlock()
+	delete(customDataCodecs, mediaType)
+}
+
+// LookupCustomDataCodec returns the codec registered for the
+// customDataContentType contentType, if any
+func LookupCustomDataCodec(contentType string) (CustomDataCodec, bool) {
+	mediaType, err := parseMediaType(contentType)
+	if err != nil {
+		return nil, false
+	}
+	customDataCodecsMu.RLock()
+	defer customDataCodecsMu.RUnlock()
+	if codec, found := customDataCodecs[mediaType]; found {
+		return codec, true
+	}
+	for suffix, suffixMediaType := range suffixMediaTypes {
+		if strings.HasSuffix(mediaType, suffix) {
+			codec, found := customDataCodecs[suffixMediaType]
+			return codec, found
+		}
+	}
+	return nil, false
+}
+
+// EncodeCustomData returns the custom data to be stored in an event for
+// data. JSON data and []byte are stored as they are, other Go values are
+// encoded with the codec registered for contentType.
+func EncodeCustomData(contentType string, data interface{}) (interface{}, error) {
+	if _, isBytes := data.([]byte); isBytes || contentType == ContentTypeJson || contentType == "" {
+		return data, nil
+	}
+	codec, found := LookupCustomDataCodec(contentType)
+	if !found {
+		return nil, fmt.Errorf("%s data must be set as []bytes, got %v", contentType, data)
+	}
+	encoded, err := codec.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode %s data: %w", contentType, err)
+	}
+	return encoded, nil
+}
+
+func parseMediaType(contentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("invalid content type %s: %w", contentType, err)
+	}
+	return mediaType, nil
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// yamlCodec uses the json tags of Go types, like the JSON codec
+type yamlCodec struct{}
+
+func (yamlCodec) Marshal(v interface{}) ([]byte, error) {
+	return yaml.Marshal(v)
+}
+
+func (yamlCodec) Unmarshal(data []byte, v interface{}) error {
+	return yaml.Unmarshal(data, v)
+}
+
+type xmlCodec struct{}
+
+func (xmlCodec) Marshal(v interface{}) ([]byte, error) {
+	return xml.Marshal(v)
+}
+
+func (xmlCodec) Unmarshal(data []byte, v interface{}) error {
+	return xml.Unmarshal(data, v)
+}
+
+// textCodec encodes strings, and types that implement
+// encoding.TextMarshaler or fmt.Stringer
+type textCodec struct{}
+
+func (textCodec) Marshal(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case string:
+		return []byte(v), nil
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	case fmt.Stringer:
+		return []byte(v.String()), nil
+	default:
+		return nil, fmt.Errorf("cannot encode %T as text", v)
+	}
+}
+
+func (textCodec) Unmarshal(data []byte, v interface{}) error {
+	switch v := v.(type) {
+	case *string:
+		*v = string(data)
+	case *[]byte:
+		*v = append([]byte(nil), data...)
+	case encoding.TextUnmarshaler:
+		return v.UnmarshalText(data)
+	default:
+		return fmt.Errorf("cannot decode text into %T", v)
+	}
+	return nil
+}
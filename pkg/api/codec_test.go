@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"testing"
+
+	api "github.com/cdevents/sdk-go/pkg/api"
+	testapi "github.com/cdevents/sdk-go/pkg/api/v990"
+	"github.com/google/go-cmp/cmp"
+)
+
+type testXmlType struct {
+	TestData string `xml:"testData" json:"testData"`
+}
+
+// reverseCodec stands in for a binary codec like protobuf
+type reverseCodec struct{}
+
+func (reverseCodec) Marshal(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot encode %T", v)
+	}
+	return reverse([]byte(s)), nil
+}
+
+func (reverseCodec) Unmarshal(data []byte, v interface{}) error {
+	s, ok := v.(*string)
+	if !ok {
+		return fmt.Errorf("cannot decode into %T", v)
+	}
+	*s = string(reverse(data))
+	return nil
+}
+
+func reverse(data []byte) []byte {
+	reversed := bytes.Clone(data)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	return reversed
+}
+
+func TestCustomDataCodecs(t *testing.T) {
+	err := api.RegisterCustomDataCodec("application/x-reverse", reverseCodec{})
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	t.Cleanup(func() { api.UnregisterCustomDataCodec("application/x-reverse") })
+	tests := []struct {
+		name        string
+		contentType string
+		data        interface{}
+		wantRaw     []byte
+		receiver    func() interface{}
+	}{{
+		name:        "json",
+		contentType: api.ContentTypeJson,
+		data:        testXmlType{TestData: "testValue"},
+		wantRaw:     []byte(`{"testData":"testValue"}`),
+		receiver:    func() interface{} { return &testXmlType{} },
+	}, {
+		name:        "yaml",
+		contentType: api.ContentTypeYaml,
+		data:        testXmlType{TestData: "testValue"},
+		wantRaw:     []byte("testData: testValue\n"),
+		receiver:    func() interface{} { return &testXmlType{} },
+	}, {
+		name:        "xml",
+		contentType: api.ContentTypeXml,
+		data:        testXmlType{TestData: "testValue"},
+		wantRaw:     []byte("<testXmlType><testData>testValue</testData></testXmlType>"),
+		receiver:    func() interface{} { return &testXmlType{} },
+	}, {
+		name:        "xml suffix",
+		contentType: "application/vnd.test+xml",
+		data:        testXmlType{TestData: "testValue"},
+		wantRaw:     []byte("<testXmlType><testData>testValue</testData></testXmlType>"),
+		receiver:    func() interface{} { return &testXmlType{} },
+	}, {
+		name:        "text",
+		contentType: "text/plain; charset=utf-8",
+		data:        "testValue",
+		wantRaw:     []byte("testValue"),
+		receiver:    func() interface{} { return new(string) },
+	}, {
+		name:        "text marshaler",
+		contentType: api.ContentTypeText,
+		data:        netip.MustParseAddr("10.0.0.1"),
+		wantRaw:     []byte("10.0.0.1"),
+		receiver:    func() interface{} { return &netip.Addr{} },
+	}, {
+		name:        "registered",
+		contentType: "application/x-reverse",
+		data:        "testValue",
+		wantRaw:     []byte("eulaVtset"),
+		receiver:    func() interface{} { return new(string) },
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, _ := testapi.NewFooSubjectBarPredicateEvent()
+			if err := e.SetCustomData(tc.contentType, tc.data); err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			raw, err := e.GetCustomDataRaw()
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if d := cmp.Diff(string(tc.wantRaw), string(raw)); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+			// Consumed events hold the data base64 encoded
+			eventJson, err := api.AsJsonBytes(e)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			consumed, err := testapi.NewFromJsonBytes(eventJson)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			for _, event := range []api.CDEventReader{e, consumed} {
+				receiver := tc.receiver()
+				if err := event.GetCustomDataAs(receiver); err != nil {
+					t.Fatalf("didn't expected it to fail, but it did: %v", err)
+				}
+				if d := cmp.Diff(tc.data, deref(receiver), cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); d != "" {
+					t.Errorf("args: diff(-want,+got):\n%s", d)
+				}
+			}
+		})
+	}
+}
+
+// deref returns the value a receiver points to
+func deref(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *testXmlType:
+		return *v
+	case *string:
+		return *v
+	case *netip.Addr:
+		return *v
+	default:
+		return v
+	}
+}
+
+func TestCustomDataCodecsInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		data        interface{}
+	}{{
+		name:        "not registered",
+		contentType: "application/octet-stream",
+		data:        testXmlType{TestData: "testValue"},
+	}, {
+		name:        "not text",
+		contentType: api.ContentTypeText,
+		data:        testXmlType{TestData: "testValue"},
+	}, {
+		name:        "not xml",
+		contentType: api.ContentTypeXml,
+		data:        map[string]string{"testData": "testValue"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, _ := testapi.NewFooSubjectBarPredicateEvent()
+			if err := e.SetCustomData(tc.contentType, tc.data); err == nil {
+				t.Error("expected an error but got none")
+			}
+		})
+	}
+}
+
+func TestRegisterCustomDataCodecInvalid(t *testing.T) {
+	if err := api.RegisterCustomDataCodec("not a content type;", reverseCodec{}); err == nil {
+		t.Error("expected an error but got none")
+	}
+	if err := api.RegisterCustomDataCodec("application/x-nil", nil); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func TestUnregisterCustomDataCodec(t *testing.T) {
+	if err := api.RegisterCustomDataCodec("application/x-reverse", reverseCodec{}); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	api.UnregisterCustomDataCodec("application/x-reverse; charset=utf-8")
+	if _, found := api.LookupCustomDataCodec("application/x-reverse"); found {
+		t.Error("expected the codec to be unregistered")
+	}
+	if _, err := api.EncodeCustomData("application/x-reverse", "hello"); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CustomEvent is a custom event whose subject content is of the Go type
+// Content. It embeds CustomTypeEventV0_5_1, so it can be used wherever a
+// custom event is expected, and unmarshals the subject content into
+// Content instead of a generic interface{}.
+type CustomEvent[Content any] struct {
+	CustomTypeEventV0_5_1
+}
+
+// NewCustomEvent creates a new CustomEvent of the custom eventType
+func NewCustomEvent[Content any](specVersion string, eventType CDEventType) (*CustomEvent[Content], error) {
+	if eventType.Custom == "" {
+		return nil, fmt.Errorf("event type %s is not a custom event type", eventType)
+	}
+	e := &CustomEvent[Content]{
+		CustomTypeEventV0_5_1: CustomTypeEventV0_5_1{
+			Context: ContextV05{
+				SharedContext: SharedContext{
+					Type: eventType,
+				},
+				SpecVersion:   specVersion,
+				ContextLinks:  ContextLinks{},
+				ContextCustom: ContextCustom{},
+			},
+			Subject: CustomTypeSubjectV0_5_1{
+				SubjectBaseV05: SubjectBaseV05{},
+			},
+		},
+	}
+	var content Content
+	e.Subject.Content = content
+	_, err := initCDEvent(e)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// GetContent returns the subject content. If the content was set through
+// SetSubjectContent with a value of a different type, the zero value of
+// Content is returned.
+func (e CustomEvent[Content]) GetContent() Content {
+	switch content := e.Subject.Content.(type) {
+	case Content:
+		return content
+	case *Content:
+		if content != nil {
+			return *content
+		}
+	}
+	var zero Content
+	return zero
+}
+
+// SetContent sets the subject content
+func (e *CustomEvent[Content]) SetContent(content Content) {
+	e.Subject.Content = content
+}
+
+// UnmarshalJSON decodes the event, with the subject content as Content
+func (e *CustomEvent[Content]) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		Context ContextV05 `json:"context"`
+		Subject struct {
+			SubjectBaseV05
+			Content Content `json:"content"`
+		} `json:"subject"`
+		CDEventCustomData
+	}{}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	e.Context = aux.Context
+	e.Subject = CustomTypeSubjectV0_5_1{
+		SubjectBaseV05: aux.Subject.SubjectBaseV05,
+		Content:        aux.Subject.Content,
+	}
+	e.CDEventCustomData = aux.CDEventCustomData
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *CustomEvent[Content]) DeepCopyInto(out *CustomEvent[Content]) {
+	*out = *in
+	in.CustomTypeEventV0_5_1.DeepCopyInto(&out.CustomTypeEventV0_5_1)
+}
+
+// DeepCopy copies the receiver, creating a new CustomEvent.
+func (in *CustomEvent[Content]) DeepCopy() *CustomEvent[Content] {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEvent[Content])
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal, see
+// CustomTypeEventV0_5_1.Equal
+func (e *CustomEvent[Content]) Equal(other *CustomEvent[Content], opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.CustomTypeEventV0_5_1.Equal(&other.CustomTypeEventV0_5_1, opts...)
+}
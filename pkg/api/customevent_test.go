@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newQuotaEvent(t *testing.T) *api.CustomEvent[quotaContent] {
+	t.Helper()
+	e, err := api.NewCustomEvent[quotaContent](cdeventsv05.SpecVersion, quotaEventType)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	e.SetSource("/registry/eu")
+	e.SetSubjectId("heavy_user")
+	e.SetContent(validQuota())
+	return e
+}
+
+func registerQuotaContent(t *testing.T, eventType api.CDEventType) {
+	t.Helper()
+	if err := api.RegisterCustomContent[quotaContent](eventType); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	t.Cleanup(func() { api.UnregisterCustomType(eventType) })
+}
+
+func TestCustomEventRoundTrip(t *testing.T) {
+	registerQuotaContent(t, quotaEventType)
+	e := newQuotaEvent(t)
+	if err := api.Validate(e); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	data, err := api.AsJsonBytes(e)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	parsed, err := cdeventsv05.NewFromJsonBytes(data)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	got, ok := parsed.(*api.CustomEvent[quotaContent])
+	if !ok {
+		t.Fatalf("expected a *api.CustomEvent[quotaContent], got %T", parsed)
+	}
+	if d := cmp.Diff(e, got, cmp.AllowUnexported(quotaContent{})); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(validQuota(), got.GetContent(), cmp.AllowUnexported(quotaContent{})); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestCustomEventCompatibleVersion(t *testing.T) {
+	registered := quotaEventType
+	registered.Version = "0.2.0"
+	registerQuotaContent(t, registered)
+	data, err := api.AsJsonBytes(newQuotaEvent(t))
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	parsed, err := cdeventsv05.NewFromJsonBytes(data)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	if _, ok := parsed.(*api.CustomEvent[quotaContent]); !ok {
+		t.Errorf("expected a *api.CustomEvent[quotaContent], got %T", parsed)
+	}
+}
+
+func TestCustomEventIncompatibleVersion(t *testing.T) {
+	registered := quotaEventType
+	registered.Version = "1.0.0"
+	registerQuotaContent(t, registered)
+	data, err := api.AsJsonBytes(newQuotaEvent(t))
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	if _, err := cdeventsv05.NewFromJsonBytes(data); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func TestCustomEventNotRegistered(t *testing.T) {
+	data, err := api.AsJsonBytes(newQuotaEvent(t))
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	parsed, err := cdeventsv05.NewFromJsonBytes(data)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	got, ok := parsed.(*cdeventsv05.CustomTypeEvent)
+	if !ok {
+		t.Fatalf("expected a *cdeventsv05.CustomTypeEvent, got %T", parsed)
+	}
+	if _, ok := got.GetSubjectContent().(map[string]interface{}); !ok {
+		t.Errorf("expected generic content, got %T", got.GetSubjectContent())
+	}
+}
+
+func TestCustomEventGetContent(t *testing.T) {
+	e := newQuotaEvent(t)
+	content := validQuota()
+	content.User = "pointer_user"
+	e.SetSubjectContent(&content)
+	if d := cmp.Diff(content, e.GetContent(), cmp.AllowUnexported(quotaContent{})); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+	e.SetSubjectContent(map[string]interface{}{"user": "map_user"})
+	if d := cmp.Diff(quotaContent{}, e.GetContent(), cmp.AllowUnexported(quotaContent{})); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestCustomEventInvalidType(t *testing.T) {
+	if _, err := api.NewCustomEvent[quotaContent](cdeventsv05.SpecVersion, cdeventsv05.BuildQueuedEventType); err == nil {
+		t.Error("expected an error but got none")
+	}
+	if err := api.RegisterCustomContent[quotaContent](cdeventsv05.BuildQueuedEventType); err == nil {
+		t.Error("expected an error but got none")
+	}
+	invalidVersion := quotaEventType
+	invalidVersion.Version = "one"
+	if err := api.RegisterCustomContent[quotaContent](invalidVersion); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
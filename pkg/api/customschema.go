@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// CustomSchema is the JSON schema of a custom event type, generated from
+// the Go type of its subject content
+type CustomSchema struct {
+	// Id is the $id of the schema, to be set as schemaUri of the events
+	Id string
+	// SpecVersion is the CDEvents spec version of the envelope
+	SpecVersion string
+	// EventType is the custom event type
+	EventType CDEventType
+	// Schema is the JSON schema
+	Schema []byte
+}
+
+// NewCustomSchema generates the schema of a custom event type and loads it
+// into the SDK, so that events with schemaUri set to schemaId are validated
+// against it. The schema combines the custom event envelope of the spec
+// version with the schema of the subject content, reflected from the Go
+// type of content, see ContentSchema.
+func NewCustomSchema(specVersion string, eventType CDEventType, schemaId string, content any) (*CustomSchema, error) {
+	if eventType.Custom == "" {
+		return nil, fmt.Errorf("event type %s is not a custom event type", eventType)
+	}
+	if !semver.IsValid("v" + eventType.Version) {
+		return nil, fmt.Errorf("invalid version format %s", eventType.Version)
+	}
+	if u, err := url.Parse(schemaId); err != nil || !u.IsAbs() {
+		return nil, fmt.Errorf("schema id %s must be an absolute URI", schemaId)
+	}
+	baseId := fmt.Sprintf(CDEventsCustomSchemaURLTemplate, specVersion)
+	base, found := SchemasById[baseId]
+	if !found {
+		return nil, fmt.Errorf("custom event schema not found for spec %s", specVersion)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(base), &schema); err != nil {
+		return nil, err
+	}
+	contentSchema, err := ContentSchema(content)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveRefs(schema, baseId); err != nil {
+		return nil, err
+	}
+	schema["$id"] = schemaId
+	context := schemaProperty(schema, "context")
+	setConst(schemaProperty(context, "type"), eventType.String())
+	setConst(schemaProperty(context, "schemaUri"), schemaId)
+	subject := schemaProperty(schema, "subject")
+	if subjectType := schemaProperty(subject, "type"); subjectType != nil {
+		// The subject type is part of the envelope up to v0.4
+		setConst(subjectType, eventType.FQSubject())
+	}
+	if properties, ok := subject["properties"].(map[string]any); ok {
+		properties["content"] = contentSchema
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := LoadJsonSchema(schemaId, data); err != nil {
+		return nil, fmt.Errorf("cannot load the schema of %s: %w", eventType, err)
+	}
+	return &CustomSchema{Id: schemaId, SpecVersion: specVersion, EventType: eventType, Schema: data}, nil
+}
+
+// FileName returns the conventional file name of the schema, e.g.
+// myregistry-quotaexceeded_schema.json
+func (s *CustomSchema) FileName() string {
+	return s.EventType.FQSubject() + s.EventType.Predicate + "_schema.json"
+}
+
+// WriteFile writes the schema to a file
+func (s *CustomSchema) WriteFile(name string) error {
+	// Schemas are published for consumers, so they are world readable
+	return os.WriteFile(name, append(s.Schema, '\n'), 0o644) //nolint: gosec
+}
+
+// schemaProperty returns the schema of a property of an object schema, or
+// nil if there is none
+func schemaProperty(schema map[string]any, name string) map[string]any {
+	properties, _ := schema["properties"].(map[string]any)
+	property, _ := properties[name].(map[string]any)
+	return property
+}
+
+// setConst restricts a string property to a single value
+func setConst(property map[string]any, value string) {
+	if property == nil {
+		return
+	}
+	delete(property, "pattern")
+	property["enum"] = []any{value}
+	property["default"] = value
+}
+
+// resolveRefs makes the $ref of a schema absolute, so that they still
+// resolve once the $id of the schema changes
+func resolveRefs(value any, baseId string) error {
+	base, err := url.Parse(baseId)
+	if err != nil {
+		return err
+	}
+	var walk func(any) error
+	walk = func(value any) error {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, item := range v {
+				if ref, ok := item.(string); ok && key == "$ref" {
+					resolved, err := base.Parse(ref)
+					if err != nil {
+						return err
+					}
+					v[key] = resolved.String()
+					continue
+				}
+				if err := walk(item); err != nil {
+					return err
+				}
+			}
+		case []any:
+			for _, item := range v {
+				if err := walk(item); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return walk(value)
+}
+
+// ContentSchema reflects the JSON schema of subject content from its Go
+// type. Exported struct fields are named after their json tag. Fields
+// without omitempty are required, like those with a "required" jsonschema
+// tag, and may be null if they are pointers, slices or maps. The
+// jsonschema tag may also set minLength, maxLength, minimum, maximum,
+// pattern, format, description, default and enum, whose values cannot
+// contain commas, e.g.
+//
+//	Level string `json:"level" jsonschema:"minLength=1,enum=INFO,enum=WARNING,enum=CRITICAL"`
+func ContentSchema(content any) (map[string]any, error) {
+	t := reflect.TypeOf(content)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("content must be a struct, got %v", reflect.TypeOf(content))
+	}
+	return reflectSchema(t, map[reflect.Type]bool{})
+}
+
+func reflectSchema(t reflect.Type, visiting map[reflect.Type]bool) (map[string]any, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}, nil
+	case rawMessageType:
+		return map[string]any{}, nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}, nil
+	case reflect.Interface:
+		return map[string]any{}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "contentEncoding": "base64"}, nil
+		}
+		items, err := reflectSchema(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "array", "items": items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %s", t.Key())
+		}
+		values, err := reflectSchema(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		if visiting[t] {
+			// Recursive types accept any value past the first level
+			return map[string]any{"type": "object"}, nil
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		properties := map[string]any{}
+		required := []any{}
+		if err := reflectFields(t, properties, &required, visiting); err != nil {
+			return nil, err
+		}
+		schema := map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func reflectFields(t reflect.Type, properties map[string]any, required *[]any, visiting map[reflect.Type]bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(jsonTag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				// Fields of embedded structs are promoted, like in encoding/json
+				if err := reflectFields(embedded, properties, required, visiting); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema, err := reflectSchema(field.Type, visiting)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		isRequired, err := applySchemaTag(schema, field)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		properties[name] = schema
+		omitEmpty := strings.Contains(","+options+",", ",omitempty,")
+		if !omitEmpty {
+			switch field.Type.Kind() {
+			case reflect.Pointer, reflect.Slice, reflect.Map:
+				// Nil values are rendered as null
+				allowNull(schema)
+			}
+		}
+		if isRequired || !omitEmpty {
+			*required = append(*required, name)
+		}
+	}
+	return nil
+}
+
+// applySchemaTag applies the jsonschema tag of a field to its schema, and
+// returns whether the tag marks the field as required
+func applySchemaTag(schema map[string]any, field reflect.StructField) (bool, error) {
+	tag := field.Tag.Get("jsonschema")
+	if tag == "" {
+		return false, nil
+	}
+	required := false
+	var enum []any
+	for _, item := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(item, "=")
+		switch key {
+		case "required":
+			required = true
+		case "minLength", "maxLength", "minItems", "maxItems":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return false, fmt.Errorf("invalid %s %q", key, value)
+			}
+			schema[key] = n
+		case "minimum", "maximum":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return false, fmt.Errorf("invalid %s %q", key, value)
+			}
+			schema[key] = n
+		case "pattern", "format", "description":
+			schema[key] = value
+		case "default", "enum":
+			typed, err := tagValue(schema, value)
+			if err != nil {
+				return false, fmt.Errorf("invalid %s %q: %w", key, value, err)
+			}
+			if key == "default" {
+				schema[key] = typed
+			} else {
+				enum = append(enum, typed)
+			}
+		default:
+			return false, fmt.Errorf("unsupported jsonschema tag %q", key)
+		}
+	}
+	if len(enum) > 0 {
+		schema["enum"] = enum
+	}
+	return required, nil
+}
+
+// allowNull adds null to the type, and enum if any, of a schema
+func allowNull(schema map[string]any) {
+	schemaType, ok := schema["type"].(string)
+	if !ok {
+		// Schemas without a type accept null already
+		return
+	}
+	schema["type"] = []any{schemaType, "null"}
+	if enum, ok := schema["enum"].([]any); ok {
+		schema["enum"] = append(enum, nil)
+	}
+}
+
+// tagValue converts a value of a jsonschema tag to the type of the schema
+func tagValue(schema map[string]any, value string) (any, error) {
+	switch schema["type"] {
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+type quotaContent struct {
+	User      string            `json:"user" jsonschema:"minLength=1"`
+	Limit     string            `json:"limit" jsonschema:"minLength=1,pattern=^[0-9]+[KMGT]b$"`
+	Current   int               `json:"current" jsonschema:"minimum=0,maximum=100"`
+	Threshold int               `json:"threshold"`
+	Level     string            `json:"level" jsonschema:"enum=INFO,enum=WARNING,enum=CRITICAL"`
+	Owner     *api.Reference    `json:"owner,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Since     time.Time         `json:"since,omitempty"`
+	internal  string            //nolint:unused
+}
+
+var quotaEventType = api.CDEventType{
+	Subject:   "quota",
+	Predicate: "exceeded",
+	Version:   "0.1.0",
+	Custom:    "myregistry",
+}
+
+func validQuota() quotaContent {
+	return quotaContent{
+		User:      "heavy_user",
+		Limit:     "50Tb",
+		Current:   90,
+		Threshold: 85,
+		Level:     "WARNING",
+		Owner:     &api.Reference{Id: "team-a"},
+	}
+}
+
+func TestContentSchema(t *testing.T) {
+	type nested struct {
+		Name     string   `json:"name" jsonschema:"required"`
+		Tags     []string `json:"tags,omitempty"`
+		Children []nested `json:"children,omitempty"`
+	}
+	type embedded struct {
+		Embedded bool `json:"embedded,omitempty" jsonschema:"default=true"`
+	}
+	type content struct {
+		embedded
+		Count   uint64  `json:"count,omitempty" jsonschema:"enum=1,enum=2"`
+		Ratio   float64 `json:"ratio,omitempty"`
+		Nested  nested  `json:"nested"`
+		Data    []byte  `json:"data,omitempty"`
+		Any     any     `json:"any,omitempty"`
+		Skipped string  `json:"-"`
+		// Nil values of these are rendered as null
+		Items  []string       `json:"items"`
+		Labels map[string]int `json:"labels"`
+		Level  *string        `json:"level" jsonschema:"enum=low,enum=high"`
+	}
+	got, err := api.ContentSchema(&content{})
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	nestedSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name":     map[string]any{"type": "string"},
+			"tags":     map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"children": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+		},
+		"additionalProperties": false,
+		"required":             []any{"name"},
+	}
+	want := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"embedded": map[string]any{"type": "boolean", "default": true},
+			"count":    map[string]any{"type": "integer", "enum": []any{int64(1), int64(2)}},
+			"ratio":    map[string]any{"type": "number"},
+			"nested":   nestedSchema,
+			"data":     map[string]any{"type": "string", "contentEncoding": "base64"},
+			"any":      map[string]any{},
+			"items":    map[string]any{"type": []any{"array", "null"}, "items": map[string]any{"type": "string"}},
+			"labels":   map[string]any{"type": []any{"object", "null"}, "additionalProperties": map[string]any{"type": "integer"}},
+			"level":    map[string]any{"type": []any{"string", "null"}, "enum": []any{"low", "high", nil}},
+		},
+		"additionalProperties": false,
+		"required":             []any{"nested", "items", "labels", "level"},
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestContentSchemaInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content any
+	}{{
+		name:    "not a struct",
+		content: "quota",
+	}, {
+		name:    "nil",
+		content: nil,
+	}, {
+		name: "unsupported tag",
+		content: struct {
+			Name string `json:"name" jsonschema:"title=Name"`
+		}{},
+	}, {
+		name: "invalid enum",
+		content: struct {
+			Count int `json:"count" jsonschema:"enum=one"`
+		}{},
+	}, {
+		name: "unsupported type",
+		content: struct {
+			Notify chan string `json:"notify"`
+		}{},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := api.ContentSchema(tc.content); err == nil {
+				t.Error("expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestNewCustomSchema(t *testing.T) {
+	tests := []struct {
+		name        string
+		specVersion string
+		newEvent    func() (api.CDEventWriterV04, error)
+	}{{
+		name:        "v0.5",
+		specVersion: cdeventsv05.SpecVersion,
+		newEvent: func() (api.CDEventWriterV04, error) {
+			return cdeventsv05.NewCustomTypeEvent()
+		},
+	}, {
+		name:        "v0.4",
+		specVersion: cdeventsv04.SpecVersion,
+		newEvent: func() (api.CDEventWriterV04, error) {
+			return cdeventsv04.NewCustomTypeEvent()
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schemaId := "https://myregistry.dev/schemas/cdevents/quota-exceeded/" + tc.specVersion
+			schema, err := api.NewCustomSchema(tc.specVersion, quotaEventType, schemaId, quotaContent{})
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if d := cmp.Diff("myregistry-quotaexceeded_schema.json", schema.FileName()); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+
+			newQuotaEvent := func(content quotaContent) api.CDEventReader {
+				event, err := tc.newEvent()
+				if err != nil {
+					t.Fatalf("didn't expected it to fail, but it did: %v", err)
+				}
+				custom := event.(api.CustomCDEventWriter)
+				custom.SetEventType(quotaEventType)
+				custom.SetSubjectContent(content)
+				event.SetSource("/myregistry")
+				event.SetSubjectId("quotaRule123")
+				event.SetSchemaUri(schemaId)
+				return event.(api.CDEventReader)
+			}
+			if err := api.Validate(newQuotaEvent(validQuota())); err != nil {
+				t.Errorf("expected a valid event, got %v", err)
+			}
+			invalid := map[string]func(*quotaContent){
+				"missing required": func(c *quotaContent) { c.User = "" },
+				"not in enum":      func(c *quotaContent) { c.Level = "DEBUG" },
+				"above maximum":    func(c *quotaContent) { c.Current = 120 },
+				"pattern":          func(c *quotaContent) { c.Limit = "lots" },
+			}
+			for name, mutate := range invalid {
+				content := validQuota()
+				mutate(&content)
+				if err := api.Validate(newQuotaEvent(content)); err == nil {
+					t.Errorf("%s: expected a validation error, got none", name)
+				}
+			}
+			// Other custom types do not match the schema
+			other := newQuotaEvent(validQuota())
+			otherType := quotaEventType
+			otherType.Predicate = "reset"
+			other.(api.CustomCDEventWriter).SetEventType(otherType)
+			if err := api.Validate(other); err == nil {
+				t.Error("expected a validation error for another event type, got none")
+			}
+		})
+	}
+}
+
+func TestNewCustomSchemaNilFields(t *testing.T) {
+	type backupContent struct {
+		Volumes []string       `json:"volumes"`
+		Owner   *api.Reference `json:"owner"`
+	}
+	eventType := api.CDEventType{
+		Subject:   "backup",
+		Predicate: "completed",
+		Version:   "0.1.0",
+		Custom:    "mystorage",
+	}
+	schemaId := "https://mystorage.dev/schemas/cdevents/backup-completed"
+	if _, err := api.NewCustomSchema(cdeventsv05.SpecVersion, eventType, schemaId, backupContent{}); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	tests := []struct {
+		name    string
+		content backupContent
+	}{{
+		name:    "nil fields",
+		content: backupContent{},
+	}, {
+		name:    "set fields",
+		content: backupContent{Volumes: []string{"data"}, Owner: &api.Reference{Id: "team-a"}},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := api.NewCustomEvent[backupContent](cdeventsv05.SpecVersion, eventType)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			e.SetSource("/mystorage")
+			e.SetSubjectId("backup123")
+			e.SetSchemaUri(schemaId)
+			e.SetContent(tc.content)
+			if err := api.Validate(e); err != nil {
+				t.Errorf("expected a valid event, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewCustomSchemaInvalid(t *testing.T) {
+	schemaId := "https://myregistry.dev/schemas/cdevents/invalid"
+	notCustom := quotaEventType
+	notCustom.Custom = ""
+	badVersion := quotaEventType
+	badVersion.Version = "one"
+	tests := []struct {
+		name        string
+		specVersion string
+		eventType   api.CDEventType
+		schemaId    string
+	}{{
+		name:        "not a custom type",
+		specVersion: cdeventsv05.SpecVersion,
+		eventType:   notCustom,
+		schemaId:    schemaId,
+	}, {
+		name:        "invalid version",
+		specVersion: cdeventsv05.SpecVersion,
+		eventType:   badVersion,
+		schemaId:    schemaId,
+	}, {
+		name:        "relative schema id",
+		specVersion: cdeventsv05.SpecVersion,
+		eventType:   quotaEventType,
+		schemaId:    "schemas/quota",
+	}, {
+		name:        "unknown spec version",
+		specVersion: "0.1.0",
+		eventType:   quotaEventType,
+		schemaId:    schemaId,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := api.NewCustomSchema(tc.specVersion, tc.eventType, tc.schemaId, quotaContent{}); err == nil {
+				t.Error("expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestCustomSchemaWriteFile(t *testing.T) {
+	schemaId := "https://myregistry.dev/schemas/cdevents/quota-exceeded/export"
+	schema, err := api.NewCustomSchema(cdeventsv05.SpecVersion, quotaEventType, schemaId, quotaContent{})
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	name := filepath.Join(t.TempDir(), schema.FileName())
+	if err := schema.WriteFile(name); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	written, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	// The exported schema can be loaded back, e.g. by consumers
+	if err := api.LoadJsonSchema(schemaId+"/reloaded", written); err != nil {
+		t.Errorf("cannot load the exported schema: %v", err)
+	}
+	if d := cmp.Diff(string(schema.Schema)+"\n", string(written)); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"golang.org/x/mod/semver"
+)
+
+var (
+	// ErrIncompatibleCustomType is returned when a custom event is parsed
+	// and the version of the type registered for it has a different major
+	// version
+	ErrIncompatibleCustomType = errors.New("custom event type not compatible with the registered type")
+
+	// customTypes holds the registered custom event types
+	customTypes   = map[customTypeKey]CustomType{}
+	customTypesMu sync.RWMutex
+
+	// customEventBases holds the custom event types of the spec versions,
+	// registered at init
+	customEventBases []reflect.Type
+)
+
+// customTypeKey identifies a custom event type regardless of its version
+type customTypeKey struct {
+	tool      string
+	subject   string
+	predicate string
+}
+
+func keyOf(eventType CDEventType) customTypeKey {
+	return customTypeKey{
+		tool:      eventType.Custom,
+		subject:   eventType.Subject,
+		predicate: eventType.Predicate,
+	}
+}
+
+// CustomType is a custom event type registered with RegisterCustomType
+type CustomType struct {
+	// EventType is the registered event type. Events with the same tool,
+	// subject and predicate and the same major version are compatible.
+	EventType CDEventType
+	// SchemaUri is the id of the custom schema of the events, if any
+	SchemaUri string
+	// New returns a new, empty event of the registered Go type
+	New func() CDEventV04
+}
+
+// CustomTypeOption configures the registration of a custom event type
+type CustomTypeOption func(*CustomType)
+
+// WithSchemaUri sets the id of the custom schema that events of the type
+// are validated against when parsed. The schema must be loaded in the SDK,
+// e.g. with LoadJsonSchema or NewCustomSchema.
+func WithSchemaUri(schemaUri string) CustomTypeOption {
+	return func(t *CustomType) {
+		t.SchemaUri = schemaUri
+	}
+}
+
+// RegisterCustomType registers the Go type of events of the custom
+// eventType, by tool, subject and predicate. Parsers return events of
+// compatible types as values produced by newEvent. The Go type must be, or
+// embed, the custom event type of the spec version of the parser, e.g.
+// CustomTypeEventV0_5_1; other parsers keep returning their generic custom
+// events. Types that are not, and don't embed, a custom event type of a
+// spec version are rejected. Registering a type again replaces the
+// previous registration.
+func RegisterCustomType(eventType CDEventType, newEvent func() CDEventV04, opts ...CustomTypeOption) error {
+	if eventType.Custom == "" {
+		return fmt.Errorf("event type %s is not a custom event type", eventType)
+	}
+	if !semver.IsValid("v" + eventType.Version) {
+		return fmt.Errorf("invalid version format %s", eventType.Version)
+	}
+	if newEvent == nil {
+		return fmt.Errorf("no constructor for custom event type %s", eventType)
+	}
+	if _, ok := customEventBase(reflect.TypeOf(newEvent())); !ok {
+		return fmt.Errorf("the constructor for custom event type %s does not return a custom event", eventType)
+	}
+	customType := CustomType{
+		EventType: eventType,
+		New:       newEvent,
+	}
+	for _, opt := range opts {
+		opt(&customType)
+	}
+	customTypesMu.Lock()
+	defer customTypesMu.Unlock()
+	customTypes[keyOf(eventType)] = customType
+	return nil
+}
+
+// RegisterCustomContent registers *CustomEvent[Content] as the Go type of
+// events of the custom eventType, see RegisterCustomType. It is returned
+// by the parsers of spec versions with the v0.5 context format.
+func RegisterCustomContent[Content any](eventType CDEventType, opts ...CustomTypeOption) error {
+	return RegisterCustomType(eventType, func() CDEventV04 { return &CustomEvent[Content]{} }, opts...)
+}
+
+// UnregisterCustomType removes the registration of the custom eventType,
+// if any
+func UnregisterCustomType(eventType CDEventType) {
+	customTypesMu.Lock()
+	defer customTypesMu.Unlock()
+	delete(customTypes, keyOf(eventType))
+}
+
+// LookupCustomType returns the registration of the custom eventType, or nil
+// if none is registered. It fails with ErrIncompatibleCustomType if the
+// registered version is not compatible with eventType.
+func LookupCustomType(eventType CDEventType) (*CustomType, error) {
+	customTypesMu.RLock()
+	customType, found := customTypes[keyOf(eventType)]
+	customTypesMu.RUnlock()
+	if !found {
+		return nil, nil
+	}
+	if !eventType.IsCompatible(customType.EventType) {
+		return nil, fmt.Errorf("%w: registered version %s, event version %s",
+			ErrIncompatibleCustomType, customType.EventType.Version, eventType.Version)
+	}
+	return &customType, nil
+}
+
+// registerCustomEventBase registers the custom event type of a spec version
+func registerCustomEventBase(baseType reflect.Type) {
+	customEventBases = append(customEventBases, baseType)
+}
+
+// customEventBase returns the custom event type of a spec version that the
+// pointer eventType points to, or embeds
+func customEventBase(eventType reflect.Type) (reflect.Type, bool) {
+	if eventType == nil || eventType.Kind() != reflect.Pointer || eventType.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	for _, baseType := range customEventBases {
+		if eventType.Elem() == baseType {
+			return baseType, true
+		}
+		for i := 0; i < eventType.Elem().NumField(); i++ {
+			field := eventType.Elem().Field(i)
+			if field.Anonymous && field.Type == baseType {
+				return baseType, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// newCustomTypeEvent returns a new event of the Go type registered for
+// eventType, if it's the base custom event of the parser, or embeds it
+func newCustomTypeEvent(customType *CustomType, base CDEventV04) (CDEventV04, bool) {
+	event := customType.New()
+	baseType, ok := customEventBase(reflect.TypeOf(event))
+	if !ok || baseType != reflect.TypeOf(base).Elem() {
+		return nil, false
+	}
+	return event, true
+}
+
+// validateCustomType checks the event against the schema registered for
+// its custom type, if any
+func validateCustomType(customType *CustomType, event CDEventV04, data []byte) error {
+	if customType.SchemaUri == "" {
+		return nil
+	}
+	if schemaUri := event.GetSchemaUri(); schemaUri != "" && schemaUri != customType.SchemaUri {
+		return fmt.Errorf("event schemaUri %s does not match %s registered for %s",
+			schemaUri, customType.SchemaUri, customType.EventType.UnversionedString())
+	}
+	schema, found := CompiledCustomSchemas[customType.SchemaUri]
+	if !found {
+		return fmt.Errorf("schema with id %s could not be found in the local registry", customType.SchemaUri)
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	return schema.Validate(v)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fooBarEvent is a custom event type defined by embedding the base custom
+// event of the spec
+type fooBarEvent struct {
+	api.CustomTypeEventV0_5_1
+}
+
+// fooBarEventV04 is the v0.4 flavor of fooBarEvent
+type fooBarEventV04 struct {
+	api.CustomTypeEventV0_4_1
+}
+
+// notACustomEvent doesn't embed a base custom event
+type notACustomEvent struct {
+	*cdeventsv05.CustomTypeEvent
+}
+
+var fooBarEventType = api.CDEventType{
+	Subject:   "foo",
+	Predicate: "bar",
+	Version:   "1.2.0",
+	Custom:    "otherteam",
+}
+
+func registerCustomType(t *testing.T, eventType api.CDEventType, newEvent func() api.CDEventV04, opts ...api.CustomTypeOption) {
+	t.Helper()
+	if err := api.RegisterCustomType(eventType, newEvent, opts...); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	t.Cleanup(func() { api.UnregisterCustomType(eventType) })
+}
+
+func customEventJson(t *testing.T, eventType api.CDEventType, content any) []byte {
+	t.Helper()
+	e, err := cdeventsv05.NewCustomTypeEvent()
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	e.SetEventType(eventType)
+	e.SetSource("/registry/eu")
+	e.SetSubjectId("subject123")
+	e.SetSubjectContent(content)
+	data, err := api.AsJsonBytes(e)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	return data
+}
+
+func TestCustomTypeRegistry(t *testing.T) {
+	registerQuotaContent(t, quotaEventType)
+	registerCustomType(t, fooBarEventType, func() api.CDEventV04 { return &fooBarEvent{} })
+
+	tests := []struct {
+		name     string
+		event    []byte
+		wantType string
+	}{{
+		name:     "typed content",
+		event:    customEventJson(t, quotaEventType, validQuota()),
+		wantType: "*api.CustomEvent[github.com/cdevents/sdk-go/pkg/api_test.quotaContent]",
+	}, {
+		name:     "embedded base event",
+		event:    customEventJson(t, fooBarEventType, map[string]string{"foo": "bar"}),
+		wantType: "*api_test.fooBarEvent",
+	}, {
+		name: "not registered",
+		event: customEventJson(t, api.CDEventType{
+			Subject:   "foo",
+			Predicate: "baz",
+			Version:   "1.2.0",
+			Custom:    "otherteam",
+		}, map[string]string{"foo": "baz"}),
+		wantType: "*api.CustomTypeEventV0_5_1",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := cdeventsv05.NewFromJsonBytes(tc.event)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if d := cmp.Diff(tc.wantType, fmt.Sprintf("%T", got)); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestCustomTypeRegistryIncompatible(t *testing.T) {
+	registerCustomType(t, fooBarEventType, func() api.CDEventV04 { return &fooBarEvent{} })
+	incompatible := fooBarEventType
+	incompatible.Version = "2.0.0"
+	_, err := cdeventsv05.NewFromJsonBytes(customEventJson(t, incompatible, map[string]string{}))
+	if !errors.Is(err, api.ErrIncompatibleCustomType) {
+		t.Errorf("expected ErrIncompatibleCustomType, got %v", err)
+	}
+	if _, err := api.LookupCustomType(incompatible); !errors.Is(err, api.ErrIncompatibleCustomType) {
+		t.Errorf("expected ErrIncompatibleCustomType, got %v", err)
+	}
+}
+
+func TestCustomTypeRegistrySpecVersion(t *testing.T) {
+	registerCustomType(t, fooBarEventType, func() api.CDEventV04 { return &fooBarEventV04{} })
+	v04Event, err := cdeventsv04.NewCustomTypeEvent()
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	v04Event.SetEventType(fooBarEventType)
+	v04Event.SetSource("/registry/eu")
+	v04Event.SetSubjectId("subject123")
+	v04Event.SetSubjectContent(map[string]string{"foo": "bar"})
+	v04Json, err := api.AsJsonBytes(v04Event)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	got, err := cdeventsv04.NewFromJsonBytes(v04Json)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	if _, ok := got.(*fooBarEventV04); !ok {
+		t.Errorf("expected a *fooBarEventV04, got %T", got)
+	}
+	// The v0.5 parser doesn't use the v0.4 type
+	got, err = cdeventsv05.NewFromJsonBytes(customEventJson(t, fooBarEventType, map[string]string{}))
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	if _, ok := got.(*cdeventsv05.CustomTypeEvent); !ok {
+		t.Errorf("expected a *cdeventsv05.CustomTypeEvent, got %T", got)
+	}
+}
+
+func TestCustomTypeRegistrySchema(t *testing.T) {
+	schemaId := "https://myregistry.example.com/schemas/registry/quota-exceeded"
+	if _, err := api.NewCustomSchema(cdeventsv05.SpecVersion, quotaEventType, schemaId, quotaContent{}); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	registerQuotaContent(t, quotaEventType)
+	if err := api.RegisterCustomContent[quotaContent](quotaEventType, api.WithSchemaUri(schemaId)); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	invalidQuota := validQuota()
+	invalidQuota.Current = 150
+
+	tests := []struct {
+		name      string
+		content   quotaContent
+		schemaUri string
+		wantError bool
+	}{{
+		name:      "valid",
+		content:   validQuota(),
+		schemaUri: schemaId,
+	}, {
+		name:    "valid without schemaUri",
+		content: validQuota(),
+	}, {
+		name:      "invalid content",
+		content:   invalidQuota,
+		schemaUri: schemaId,
+		wantError: true,
+	}, {
+		name:      "schemaUri mismatch",
+		content:   validQuota(),
+		schemaUri: "https://example.com/other",
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := newQuotaEvent(t)
+			e.SetContent(tc.content)
+			e.SetSchemaUri(tc.schemaUri)
+			data, err := api.AsJsonBytes(e)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			_, err = cdeventsv05.NewFromJsonBytes(data)
+			if tc.wantError && err == nil {
+				t.Error("expected an error but got none")
+			}
+			if !tc.wantError && err != nil {
+				t.Errorf("didn't expected it to fail, but it did: %v", err)
+			}
+		})
+	}
+}
+
+func TestCustomTypeRegistryMissingSchema(t *testing.T) {
+	registerQuotaContent(t, quotaEventType)
+	if err := api.RegisterCustomContent[quotaContent](quotaEventType, api.WithSchemaUri("https://example.com/not-loaded")); err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	if _, err := cdeventsv05.NewFromJsonBytes(customEventJson(t, quotaEventType, validQuota())); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func TestRegisterCustomTypeInvalid(t *testing.T) {
+	newEvent := func() api.CDEventV04 { return &fooBarEvent{} }
+	invalidVersion := fooBarEventType
+	invalidVersion.Version = "one"
+	tests := []struct {
+		name      string
+		eventType api.CDEventType
+		newEvent  func() api.CDEventV04
+	}{{
+		name:      "not custom",
+		eventType: cdeventsv05.BuildQueuedEventType,
+		newEvent:  newEvent,
+	}, {
+		name:      "invalid version",
+		eventType: invalidVersion,
+		newEvent:  newEvent,
+	}, {
+		name:      "no constructor",
+		eventType: fooBarEventType,
+	}, {
+		name:      "not embedded",
+		eventType: fooBarEventType,
+		newEvent:  func() api.CDEventV04 { return &notACustomEvent{} },
+	}, {
+		name:      "nil event",
+		eventType: fooBarEventType,
+		newEvent:  func() api.CDEventV04 { return nil },
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := api.RegisterCustomType(tc.eventType, tc.newEvent); err == nil {
+				t.Error("expected an error but got none")
+			}
+		})
+	}
+}
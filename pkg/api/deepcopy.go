@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"reflect"
+)
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *Context) DeepCopyInto(out *Context) {
+	*out = *in
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ContextV04) DeepCopyInto(out *ContextV04) {
+	*out = *in
+	in.ContextLinks.DeepCopyInto(&out.ContextLinks)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ContextV05) DeepCopyInto(out *ContextV05) {
+	*out = *in
+	in.ContextLinks.DeepCopyInto(&out.ContextLinks)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ContextLinks) DeepCopyInto(out *ContextLinks) {
+	*out = *in
+	// Links are pointers to the embedded link types
+	out.Links = deepCopyValue(in.Links)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *CDEventCustomData) DeepCopyInto(out *CDEventCustomData) {
+	*out = *in
+	out.CustomData = DeepCopyJSONValue(in.CustomData)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *Reference) DeepCopyInto(out *Reference) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new Reference.
+func (in *Reference) DeepCopy() *Reference {
+	if in == nil {
+		return nil
+	}
+	out := new(Reference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyJSONValue copies values decoded from JSON, like custom data
+// and the content of custom events. Maps, slices, pointers and exported
+// struct fields are copied recursively, other values are assigned.
+func DeepCopyJSONValue(value interface{}) interface{} {
+	return deepCopyValue(value)
+}
+
+// deepCopyValue copies values of content fields that hold interface{},
+// or slices and maps of slices and maps
+func deepCopyValue[T any](in T) T {
+	out, _ := deepCopyReflect(reflect.ValueOf(&in).Elem()).Interface().(T)
+	return out
+}
+
+func deepCopyReflect(in reflect.Value) reflect.Value {
+	switch in.Kind() {
+	case reflect.Interface:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.New(in.Type()).Elem()
+		out.Set(deepCopyReflect(in.Elem()))
+		return out
+	case reflect.Pointer:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.New(in.Type().Elem())
+		out.Elem().Set(deepCopyReflect(in.Elem()))
+		return out
+	case reflect.Map:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.MakeMapWithSize(in.Type(), in.Len())
+		iter := in.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), deepCopyReflect(iter.Value()))
+		}
+		return out
+	case reflect.Slice:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.MakeSlice(in.Type(), in.Len(), in.Len())
+		for i := 0; i < in.Len(); i++ {
+			out.Index(i).Set(deepCopyReflect(in.Index(i)))
+		}
+		return out
+	case reflect.Array:
+		out := reflect.New(in.Type()).Elem()
+		for i := 0; i < in.Len(); i++ {
+			out.Index(i).Set(deepCopyReflect(in.Index(i)))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(in.Type()).Elem()
+		out.Set(in)
+		// Unexported fields are assigned
+		for i := 0; i < in.NumField(); i++ {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(deepCopyReflect(in.Field(i)))
+			}
+		}
+		return out
+	default:
+		return in
+	}
+}
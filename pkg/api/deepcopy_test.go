@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestCaseFinished(t *testing.T) *api.TestCaseRunFinishedEventV0_3_0 {
+	t.Helper()
+	e, err := api.NewTestCaseRunFinishedEventV0_3_0(cdeventsv05.SpecVersion)
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	e.SetSource("/ci/greeter/build/42")
+	e.SetSubjectId("TestGreet")
+	e.SetSubjectOutcome(api.TestCaseRunFinishedSubjectContentOutcomeSuccessV0_3_0)
+	e.SetSubjectEnvironment(&api.Reference{Id: "ci", Source: "/environments"})
+	e.SetSubjectTestCase(&api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "TestGreet", Name: "TestGreet"})
+	link := api.NewEmbeddedLinkPath()
+	link.SetFrom(api.EventReference{ContextId: "271069a8-fc18-44f1-b38f-9d70a1695819"})
+	link.SetTags(api.Tags{"step": "test"})
+	e.SetLinks(api.EmbeddedLinksArray{link})
+	err = e.SetCustomData("application/json", map[string]interface{}{
+		"labels": []interface{}{"unit"},
+		"owner":  map[string]interface{}{"team": "greeter"},
+	})
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	return e
+}
+
+func TestDeepCopy(t *testing.T) {
+	e := newTestCaseFinished(t)
+	want := newTestCaseFinished(t)
+	want.SetId(e.GetId())
+	want.SetTimestamp(e.GetTimestamp())
+
+	c := e.DeepCopy()
+	if !c.Equal(e) {
+		t.Fatalf("expected the copy to be equal to the event")
+	}
+	c.Subject.Content.TestCase.Name = "TestGreetFrench"
+	c.Subject.Content.Environment.Id = "staging"
+	c.GetLinks()[0].SetTags(api.Tags{"step": "lint"})
+	data := c.CustomData.(map[string]interface{})
+	data["labels"].([]interface{})[0] = "integration"
+	data["owner"].(map[string]interface{})["team"] = "french"
+
+	if !e.Equal(want) {
+		t.Errorf("changing the copy changed the event, got %+v", e)
+	}
+	if e.Equal(c) {
+		t.Errorf("expected the changed copy to differ from the event")
+	}
+}
+
+func TestDeepCopyNil(t *testing.T) {
+	var e *api.TestCaseRunFinishedEventV0_3_0
+	if c := e.DeepCopy(); c != nil {
+		t.Errorf("expected nil but got %v", c)
+	}
+	if !e.Equal(nil) {
+		t.Errorf("expected nil events to be equal")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*api.TestCaseRunFinishedEventV0_3_0)
+		opts   []api.EqualOption
+		want   bool
+	}{{
+		name:   "same",
+		change: func(*api.TestCaseRunFinishedEventV0_3_0) {},
+		want:   true,
+	}, {
+		name:   "id",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) { e.SetId("other") },
+		want:   false,
+	}, {
+		name:   "ignore id",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) { e.SetId("other") },
+		opts:   []api.EqualOption{api.IgnoreId()},
+		want:   true,
+	}, {
+		name:   "timestamp",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) { e.SetTimestamp(e.GetTimestamp().Add(time.Second)) },
+		want:   false,
+	}, {
+		name:   "ignore timestamp",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) { e.SetTimestamp(e.GetTimestamp().Add(time.Second)) },
+		opts:   []api.EqualOption{api.IgnoreTimestamp()},
+		want:   true,
+	}, {
+		name: "timestamp location",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) {
+			e.SetTimestamp(e.GetTimestamp().In(time.FixedZone("CEST", 7200)))
+		},
+		want: true,
+	}, {
+		name: "ignore id and timestamp",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) {
+			e.SetId("other")
+			e.SetTimestamp(e.GetTimestamp().Add(time.Second))
+		},
+		opts: []api.EqualOption{api.IgnoreId(), api.IgnoreTimestamp()},
+		want: true,
+	}, {
+		name: "ignore id and timestamp but not content",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) {
+			e.SetId("other")
+			e.SetSubjectOutcome(api.TestCaseRunFinishedSubjectContentOutcomeFailureV0_3_0)
+		},
+		opts: []api.EqualOption{api.IgnoreId(), api.IgnoreTimestamp()},
+		want: false,
+	}, {
+		name: "custom data",
+		change: func(e *api.TestCaseRunFinishedEventV0_3_0) {
+			e.CustomData.(map[string]interface{})["owner"] = "nobody"
+		},
+		opts: []api.EqualOption{api.IgnoreId(), api.IgnoreTimestamp()},
+		want: false,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := newTestCaseFinished(t)
+			other := e.DeepCopy()
+			tc.change(other)
+			if got := e.Equal(other, tc.opts...); got != tc.want {
+				t.Errorf("Equal() = %v, want %v", got, tc.want)
+			}
+			if e.GetId() == "other" {
+				t.Errorf("Equal() changed the id of the event")
+			}
+		})
+	}
+}
+
+func TestGetSubjectFields(t *testing.T) {
+	e := newTestCaseFinished(t)
+	if d := cmp.Diff(api.TestCaseRunFinishedSubjectContentOutcomeSuccessV0_3_0, e.GetSubjectOutcome()); d != "" {
+		t.Errorf("outcome diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(&api.Reference{Id: "ci", Source: "/environments"}, e.GetSubjectEnvironment()); d != "" {
+		t.Errorf("environment diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(&api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0{Id: "TestGreet", Name: "TestGreet"}, e.GetSubjectTestCase()); d != "" {
+		t.Errorf("test case diff(-want,+got):\n%s", d)
+	}
+	if e.GetSubjectTestSuiteRun() != nil {
+		t.Errorf("expected no test suite run but got %v", e.GetSubjectTestSuiteRun())
+	}
+}
+
+func TestCustomEventDeepCopy(t *testing.T) {
+	e := newQuotaEvent(t)
+	c := e.DeepCopy()
+	if !c.Equal(e) {
+		t.Fatalf("expected the copy to be equal to the event")
+	}
+	c.SetSubjectId("light_user")
+	c.GetContent().Owner.Id = "team-b"
+	if d := cmp.Diff("heavy_user", e.GetSubjectId()); d != "" {
+		t.Errorf("subject id diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(validQuota(), e.GetContent(), cmp.AllowUnexported(quotaContent{})); d != "" {
+		t.Errorf("content diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestDeepCopyJSONValue(t *testing.T) {
+	value := map[string]interface{}{
+		"list":   []interface{}{"a", map[string]interface{}{"b": 1.0}},
+		"nested": map[string]interface{}{"c": true},
+		"text":   "d",
+	}
+	want := map[string]interface{}{
+		"list":   []interface{}{"a", map[string]interface{}{"b": 1.0}},
+		"nested": map[string]interface{}{"c": true},
+		"text":   "d",
+	}
+	got := api.DeepCopyJSONValue(value)
+	if d := cmp.Diff(want, got); d != "" {
+		t.Fatalf("args: diff(-want,+got):\n%s", d)
+	}
+	copied := got.(map[string]interface{})
+	copied["list"].([]interface{})[1].(map[string]interface{})["b"] = 2.0
+	copied["nested"].(map[string]interface{})["c"] = false
+	copied["text"] = "e"
+	if d := cmp.Diff(want, value); d != "" {
+		t.Errorf("changing the copy changed the value, diff(-want,+got):\n%s", d)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"time"
+)
+
+type equalOptions struct {
+	ignoreId        bool
+	ignoreTimestamp bool
+}
+
+// EqualOption configures how events are compared by Equal
+type EqualOption func(*equalOptions)
+
+// IgnoreId compares events regardless of their id
+func IgnoreId() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreId = true
+	}
+}
+
+// IgnoreTimestamp compares events regardless of their timestamp
+func IgnoreTimestamp() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreTimestamp = true
+	}
+}
+
+// compareAndClearSharedContext compares the id and timestamp of two
+// events, unless ignored, and clears them so that the rest of the events
+// can be compared with reflect.DeepEqual. Timestamps are equal if they
+// are the same instant, regardless of their location.
+func compareAndClearSharedContext(a, b *SharedContext, opts []EqualOption) bool {
+	options := &equalOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if !options.ignoreId && a.Id != b.Id {
+		return false
+	}
+	if !options.ignoreTimestamp && !a.Timestamp.Equal(b.Timestamp) {
+		return false
+	}
+	a.Id, b.Id = "", ""
+	a.Timestamp, b.Timestamp = time.Time{}, time.Time{}
+	return true
+}
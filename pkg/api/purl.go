@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-playground/validator/v10"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	// DefaultRegistry is the registry of image references without a domain
+	DefaultRegistry = "docker.io"
+
+	defaultRegistryNamespace = "library"
+	purlQualifierRepository  = "repository_url"
+	purlQualifierTag         = "tag"
+)
+
+var (
+	// Grammar of OCI image references, from the distribution reference package
+	imagePathComponentRegex = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|[-]+)[a-z0-9]+)*$`)
+	imageTagRegex           = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	imageDigestRegex        = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-fA-F0-9]{32,}$`)
+	imageDomainRegex        = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*(?::[0-9]+)?$`)
+	sha256DigestRegex       = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+	// purlRules holds the rules used by strict purl validation, by purl type.
+	// Purls whose type has no rule only need to be well-formed.
+	purlRules = map[string]PurlRule{
+		purl.TypeOCI:       checkOCIPurl,
+		purl.TypeGithub:    checkNamespacedPurl,
+		purl.TypeBitbucket: checkNamespacedPurl,
+		purl.TypeMaven:     checkNamespacedPurl,
+	}
+	purlRulesMu sync.RWMutex
+
+	// strictPurl enables strict purl validation for the "purl" tag
+	strictPurl atomic.Bool
+)
+
+// PurlRule checks a parsed package URL of a specific type
+type PurlRule func(p purl.PackageURL) error
+
+// RegisterPurlRule registers the rule that CheckPurl applies to package URLs
+// of purlType, replacing the previous rule for the type, if any
+func RegisterPurlRule(purlType string, rule PurlRule) error {
+	if purlType == "" {
+		return fmt.Errorf("empty purl type")
+	}
+	if rule == nil {
+		return fmt.Errorf("no rule for purl type %s", purlType)
+	}
+	purlRulesMu.Lock()
+	defer purlRulesMu.Unlock()
+	purlRules[strings.ToLower(purlType)] = rule
+	return nil
+}
+
+// UnregisterPurlRule removes the rule for package URLs of purlType, if any
+func UnregisterPurlRule(purlType string) {
+	purlRulesMu.Lock()
+	defer purlRulesMu.Unlock()
+	delete(purlRules, strings.ToLower(purlType))
+}
+
+// ImageReference is a parsed OCI image reference, like
+// "ghcr.io/acme/myapp:v1.2.0@sha256:..."
+type ImageReference struct {
+	// Domain is the registry hosting the image, e.g. docker.io
+	Domain string
+	// Path is the repository path within the registry, e.g. library/nginx
+	Path   string
+	Tag    string
+	Digest string
+}
+
+// Repository returns the fully qualified repository of the image
+func (r ImageReference) Repository() string {
+	return r.Domain + "/" + r.Path
+}
+
+// String returns the fully qualified image reference
+func (r ImageReference) String() string {
+	ref := r.Repository()
+	if r.Tag != "" {
+		ref += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		ref += "@" + r.Digest
+	}
+	return ref
+}
+
+// ParseImageReference parses an OCI or Docker image reference. References
+// are normalized the way Docker does, so "nginx:1.25" becomes
+// "docker.io/library/nginx:1.25".
+func ParseImageReference(ref string) (*ImageReference, error) {
+	if ref == "" {
+		return nil, fmt.Errorf("empty image reference")
+	}
+	name, digest, hasDigest := strings.Cut(ref, "@")
+	if hasDigest && !imageDigestRegex.MatchString(digest) {
+		return nil, fmt.Errorf("invalid digest %q in image reference %s", digest, ref)
+	}
+	var tag string
+	// A colon after the last slash separates the tag, others are ports
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+		if !imageTagRegex.MatchString(tag) {
+			return nil, fmt.Errorf("invalid tag %q in image reference %s", tag, ref)
+		}
+	}
+	domain, path := DefaultRegistry, name
+	if first, rest, found := strings.Cut(name, "/"); found &&
+		(strings.ContainsAny(first, ".:") || first == "localhost" || strings.ToLower(first) != first) {
+		domain, path = first, rest
+	}
+	if !imageDomainRegex.MatchString(domain) {
+		return nil, fmt.Errorf("invalid registry %q in image reference %s", domain, ref)
+	}
+	if domain == DefaultRegistry && !strings.Contains(path, "/") {
+		path = defaultRegistryNamespace + "/" + path
+	}
+	for _, component := range strings.Split(path, "/") {
+		if !imagePathComponentRegex.MatchString(component) {
+			return nil, fmt.Errorf("invalid repository %q in image reference %s", path, ref)
+		}
+	}
+	return &ImageReference{Domain: domain, Path: path, Tag: tag, Digest: digest}, nil
+}
+
+// ImageReferenceToPurl converts an OCI or Docker image reference into a
+// canonical pkg:oci purl. The digest of the image becomes the purl version,
+// and the repository and tag are set as repository_url and tag qualifiers.
+// References without a digest produce a purl without version, which does
+// not pass strict purl validation.
+func ImageReferenceToPurl(ref string) (string, error) {
+	image, err := ParseImageReference(ref)
+	if err != nil {
+		return "", err
+	}
+	qualifiers := map[string]string{purlQualifierRepository: image.Repository()}
+	if image.Tag != "" {
+		qualifiers[purlQualifierTag] = image.Tag
+	}
+	name := image.Path[strings.LastIndex(image.Path, "/")+1:]
+	p := purl.NewPackageURL(purl.TypeOCI, "", name, image.Digest, purl.QualifiersFromMap(qualifiers), "")
+	return canonicalPurl(*p), nil
+}
+
+// PurlToImageReference converts a pkg:oci or pkg:docker purl into a fully
+// qualified image reference. The repository_url qualifier, if set, is used
+// as the image repository.
+func PurlToImageReference(packageURL string) (string, error) {
+	p, err := purl.FromString(packageURL)
+	if err != nil {
+		return "", err
+	}
+	qualifiers := p.Qualifiers.Map()
+	var ref string
+	switch strings.ToLower(p.Type) {
+	case purl.TypeOCI:
+		ref = p.Name
+		if repository, ok := qualifiers[purlQualifierRepository]; ok {
+			ref = strings.TrimSuffix(repository, "/")
+		}
+		if tag, ok := qualifiers[purlQualifierTag]; ok {
+			ref += ":" + tag
+		}
+		if p.Version != "" {
+			ref += "@" + p.Version
+		}
+	case purl.TypeDocker:
+		// The version of pkg:docker purls is either a tag or a digest
+		ref = p.Name
+		if p.Namespace != "" {
+			ref = p.Namespace + "/" + ref
+		}
+		if repository, ok := qualifiers[purlQualifierRepository]; ok {
+			ref = strings.TrimSuffix(repository, "/") + "/" + ref
+		}
+		if imageDigestRegex.MatchString(p.Version) {
+			ref += "@" + p.Version
+		} else if p.Version != "" {
+			ref += ":" + p.Version
+		}
+	default:
+		return "", fmt.Errorf("purl type %s is not an image type", p.Type)
+	}
+	// Registries may be set as URLs in the repository_url qualifier
+	if _, afterScheme, found := strings.Cut(ref, "://"); found {
+		ref = afterScheme
+	}
+	image, err := ParseImageReference(ref)
+	if err != nil {
+		return "", err
+	}
+	return image.String(), nil
+}
+
+// NormalizeArtifactId returns the canonical form of an artifactId. Purls are
+// re-encoded with sorted qualifiers, and image references are converted
+// into pkg:oci purls.
+func NormalizeArtifactId(artifactId string) (string, error) {
+	if !strings.HasPrefix(artifactId, "pkg:") {
+		return ImageReferenceToPurl(artifactId)
+	}
+	p, err := purl.FromString(artifactId)
+	if err != nil {
+		return "", err
+	}
+	p.Type = strings.ToLower(p.Type)
+	if p.Type == purl.TypeOCI {
+		p.Name = strings.ToLower(p.Name)
+	}
+	p.Qualifiers = purl.QualifiersFromMap(p.Qualifiers.Map())
+	return canonicalPurl(p), nil
+}
+
+// canonicalPurl renders a package URL with the colon of the version
+// percent-encoded, as in the examples of the purl specification, e.g.
+// pkg:oci/debian@sha256%3A244fd47e07d10
+func canonicalPurl(p purl.PackageURL) string {
+	s := p.ToString()
+	if p.Version == "" {
+		return s
+	}
+	version := url.PathEscape(p.Version)
+	return strings.Replace(s, "@"+version, "@"+strings.ReplaceAll(version, ":", "%3A"), 1)
+}
+
+// CheckPurl parses a package URL and checks that it is normalized, and
+// that it follows the rule registered for its type. It returns the reason why the
+// package URL is not valid, if any.
+func CheckPurl(packageURL string) error {
+	p, err := purl.FromString(packageURL)
+	if err != nil {
+		return err
+	}
+	// Parsing lowercases the type, and the namespace and name of some types
+	if got, want := purlPath(packageURL), purlPath(canonicalPurl(p)); got != want {
+		return fmt.Errorf("purl %s is not normalized, expected it to start with %s", packageURL, want)
+	}
+	purlRulesMu.RLock()
+	rule, ok := purlRules[p.Type]
+	purlRulesMu.RUnlock()
+	if ok {
+		if err := rule(p); err != nil {
+			return fmt.Errorf("invalid %s purl %s: %w", p.Type, packageURL, err)
+		}
+	}
+	return nil
+}
+
+// purlPath returns the unescaped type, namespace and name of a package URL
+func purlPath(packageURL string) string {
+	path, _, _ := strings.Cut(packageURL, "?")
+	path, _, _ = strings.Cut(path, "#")
+	if i := strings.LastIndex(path, "@"); i >= 0 {
+		path = path[:i]
+	}
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		return unescaped
+	}
+	return path
+}
+
+// ValidatePurlStrict validates that a field is a package URL that passes
+// CheckPurl
+func ValidatePurlStrict(fl validator.FieldLevel) bool {
+	return CheckPurl(fl.Field().String()) == nil
+}
+
+// SetStrictPurlValidation enables or disables strict purl validation of
+// the "purl" fields of events. When enabled, purls are validated with
+// ValidatePurlStrict; otherwise they only need to be well-formed.
+func SetStrictPurlValidation(strict bool) {
+	strictPurl.Store(strict)
+}
+
+// validatePurl is the validator registered for the "purl" tag
+func validatePurl(fl validator.FieldLevel) bool {
+	if strictPurl.Load() {
+		return ValidatePurlStrict(fl)
+	}
+	return ValidatePurl(fl)
+}
+
+func checkOCIPurl(p purl.PackageURL) error {
+	if p.Namespace != "" {
+		return fmt.Errorf("namespace must be empty, the registry belongs to the repository_url qualifier")
+	}
+	if p.Name != strings.ToLower(p.Name) {
+		return fmt.Errorf("name must be lowercase")
+	}
+	if !imageDigestRegex.MatchString(p.Version) ||
+		(strings.HasPrefix(p.Version, "sha256:") && !sha256DigestRegex.MatchString(p.Version)) {
+		return fmt.Errorf("version must be the digest of the image")
+	}
+	qualifiers := p.Qualifiers.Map()
+	if repository, ok := qualifiers[purlQualifierRepository]; ok {
+		if strings.Contains(repository, "://") {
+			return fmt.Errorf("repository_url must not include a scheme")
+		}
+		image, err := ParseImageReference(repository)
+		if err != nil {
+			return fmt.Errorf("invalid repository_url: %w", err)
+		}
+		if image.Tag != "" || image.Digest != "" {
+			return fmt.Errorf("repository_url must not include a tag or digest")
+		}
+		if image.Path[strings.LastIndex(image.Path, "/")+1:] != p.Name {
+			return fmt.Errorf("repository_url %s does not match name %s", repository, p.Name)
+		}
+	}
+	if tag, ok := qualifiers[purlQualifierTag]; ok && !imageTagRegex.MatchString(tag) {
+		return fmt.Errorf("invalid tag %q", tag)
+	}
+	return nil
+}
+
+func checkNamespacedPurl(p purl.PackageURL) error {
+	if p.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	return nil
+}
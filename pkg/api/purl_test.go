@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	testapi "github.com/cdevents/sdk-go/pkg/api/v991"
+	"github.com/google/go-cmp/cmp"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const testDigest = "sha256:0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427"
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		ref       string
+		want      *api.ImageReference
+		wantError bool
+	}{{
+		ref:  "nginx",
+		want: &api.ImageReference{Domain: "docker.io", Path: "library/nginx"},
+	}, {
+		ref:  "docker.io/foo:tag",
+		want: &api.ImageReference{Domain: "docker.io", Path: "library/foo", Tag: "tag"},
+	}, {
+		ref:  "acme/myapp:v1.2.0",
+		want: &api.ImageReference{Domain: "docker.io", Path: "acme/myapp", Tag: "v1.2.0"},
+	}, {
+		ref:  "ghcr.io/acme/myapp:v1.2.0@" + testDigest,
+		want: &api.ImageReference{Domain: "ghcr.io", Path: "acme/myapp", Tag: "v1.2.0", Digest: testDigest},
+	}, {
+		ref:  "localhost:5000/myapp@" + testDigest,
+		want: &api.ImageReference{Domain: "localhost:5000", Path: "myapp", Digest: testDigest},
+	}, {
+		ref:  "localhost/team/myapp",
+		want: &api.ImageReference{Domain: "localhost", Path: "team/myapp"},
+	}, {
+		ref:       "",
+		wantError: true,
+	}, {
+		ref:       "Acme/MyApp",
+		wantError: true,
+	}, {
+		ref:       "myapp@sha256:abc",
+		wantError: true,
+	}, {
+		ref:       "myapp:-invalid",
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.ref, func(t *testing.T) {
+			got, err := api.ParseImageReference(tc.ref)
+			if tc.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestImageReferenceToPurl(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    string
+		wantRef string
+	}{{
+		ref:     "docker.io/foo:tag",
+		want:    "pkg:oci/foo?repository_url=docker.io%2Flibrary%2Ffoo&tag=tag",
+		wantRef: "docker.io/library/foo:tag",
+	}, {
+		ref:     "ghcr.io/acme/myapp:v1.2.0@" + testDigest,
+		want:    "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=ghcr.io%2Facme%2Fmyapp&tag=v1.2.0",
+		wantRef: "ghcr.io/acme/myapp:v1.2.0@" + testDigest,
+	}, {
+		ref:     "registry.example.com:5000/myapp@" + testDigest,
+		want:    "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=registry.example.com:5000%2Fmyapp",
+		wantRef: "registry.example.com:5000/myapp@" + testDigest,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.ref, func(t *testing.T) {
+			got, err := api.ImageReferenceToPurl(tc.ref)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("purl diff(-want,+got):\n%s", d)
+			}
+			gotRef, err := api.PurlToImageReference(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantRef, gotRef); d != "" {
+				t.Errorf("image reference diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestPurlToImageReference(t *testing.T) {
+	tests := []struct {
+		purl      string
+		want      string
+		wantError bool
+	}{{
+		purl: "pkg:oci/debian@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=https://docker.io/library/debian&tag=latest",
+		want: "docker.io/library/debian:latest@" + testDigest,
+	}, {
+		purl: "pkg:oci/myapp@" + testDigest,
+		want: "docker.io/library/myapp@" + testDigest,
+	}, {
+		purl: "pkg:docker/acme/myapp@v1.2.0",
+		want: "docker.io/acme/myapp:v1.2.0",
+	}, {
+		purl: "pkg:docker/myapp@" + testDigest + "?repository_url=gcr.io/acme",
+		want: "gcr.io/acme/myapp@" + testDigest,
+	}, {
+		purl:      "pkg:npm/left-pad@1.3.0",
+		wantError: true,
+	}, {
+		purl:      "not-a-purl",
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.purl, func(t *testing.T) {
+			got, err := api.PurlToImageReference(tc.purl)
+			if tc.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestNormalizeArtifactId(t *testing.T) {
+	tests := []struct {
+		artifactId string
+		want       string
+	}{{
+		artifactId: "docker.io/foo:tag",
+		want:       "pkg:oci/foo?repository_url=docker.io%2Flibrary%2Ffoo&tag=tag",
+	}, {
+		artifactId: "pkg:OCI/MyApp@" + testDigest + "?tag=v1&repository_url=ghcr.io/acme/myapp",
+		want:       "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=ghcr.io%2Facme%2Fmyapp&tag=v1",
+	}, {
+		artifactId: testArtifactId,
+		want:       testArtifactId,
+	}, {
+		artifactId: "pkg:golang/github.com/acme/myapp@v1.2.0",
+		want:       "pkg:golang/github.com/acme/myapp@v1.2.0",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.artifactId, func(t *testing.T) {
+			got, err := api.NormalizeArtifactId(tc.artifactId)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("args: diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestCheckPurl(t *testing.T) {
+	tests := []struct {
+		purl      string
+		wantError bool
+	}{{
+		purl: testArtifactId,
+	}, {
+		purl: "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=ghcr.io/acme/myapp&tag=v1.2.0",
+	}, {
+		purl: "pkg:github/cdevents/sdk-go@v0.5.0",
+	}, {
+		purl: "pkg:maven/org.apache.commons/commons-lang3@3.14.0",
+	}, {
+		purl: "pkg:pypi/django-rest-framework@3.15.1",
+	}, {
+		purl: "pkg:golang/github.com/masterminds/semver@v3.2.1",
+	}, {
+		purl: "pkg:generic/anything@1.0",
+	}, {
+		purl:      "pkg:oci/myapp?tag=v1",
+		wantError: true,
+	}, {
+		purl:      "pkg:oci/myapp@v1.2.0",
+		wantError: true,
+	}, {
+		purl:      "pkg:oci/myapp@sha256%3A0b31b1",
+		wantError: true,
+	}, {
+		purl:      "pkg:oci/acme/myapp@" + testDigest,
+		wantError: true,
+	}, {
+		purl:      "pkg:oci/myapp@" + testDigest + "?repository_url=ghcr.io/acme/other",
+		wantError: true,
+	}, {
+		purl:      "pkg:oci/nginx@" + testDigest + "?repository_url=ghcr.io/acme/foo-nginx",
+		wantError: true,
+	}, {
+		purl:      "pkg:oci/myapp@" + testDigest + "?repository_url=ghcr.io/acme/myapp:v1",
+		wantError: true,
+	}, {
+		purl:      "pkg:github/CDEvents/sdk-go@v0.5.0",
+		wantError: true,
+	}, {
+		purl:      "pkg:github/sdk-go@v0.5.0",
+		wantError: true,
+	}, {
+		purl:      "pkg:pypi/Django_REST@3.15.1",
+		wantError: true,
+	}, {
+		purl:      "pkg:OCI/myapp@" + testDigest,
+		wantError: true,
+	}, {
+		purl:      "pkg:golang/github.com/Masterminds/semver@v3.2.1",
+		wantError: true,
+	}, {
+		purl:      "pkg:maven/commons-lang3@3.14.0",
+		wantError: true,
+	}, {
+		purl:      testInvalidArtifactId,
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.purl, func(t *testing.T) {
+			err := api.CheckPurl(tc.purl)
+			if tc.wantError && err == nil {
+				t.Error("expected an error, got none")
+			}
+			if !tc.wantError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestStrictPurlValidation(t *testing.T) {
+	event, _ := testapi.NewFooSubjectBarPredicateEvent()
+	setContext(event, testSubjectId)
+	setContextV04(event, true, true)
+	event.SetSubjectReferenceField(&api.Reference{Id: testChangeId})
+	event.SetSubjectPlainField(testValue)
+	event.SetSubjectObjectField(&testapi.FooSubjectBarPredicateSubjectContentObjectField{Required: testChangeId, Optional: testSource})
+	// A well-formed purl without the image digest
+	event.SetSubjectArtifactId("pkg:oci/myapp?tag=v1")
+
+	if err := api.Validate(event); err != nil {
+		t.Fatalf("expected the event to be valid, got %v", err)
+	}
+	api.SetStrictPurlValidation(true)
+	defer api.SetStrictPurlValidation(false)
+	if err := api.Validate(event); err == nil {
+		t.Error("expected strict validation to fail, but it succeeded instead")
+	}
+	event.SetSubjectArtifactId(testArtifactId)
+	if err := api.Validate(event); err != nil {
+		t.Errorf("expected the event to be valid, got %v", err)
+	}
+}
+
+func TestRegisterPurlRule(t *testing.T) {
+	noVersion := errors.New("a version is required")
+	err := api.RegisterPurlRule("generic", func(p purl.PackageURL) error {
+		if p.Version == "" {
+			return noVersion
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("didn't expected it to fail, but it did: %v", err)
+	}
+	t.Cleanup(func() { api.UnregisterPurlRule("generic") })
+	if err := api.CheckPurl("pkg:generic/anything@1.0"); err != nil {
+		t.Errorf("didn't expected it to fail, but it did: %v", err)
+	}
+	if err := api.CheckPurl("pkg:generic/anything"); !errors.Is(err, noVersion) {
+		t.Errorf("expected the error of the rule, got %v", err)
+	}
+	api.UnregisterPurlRule("generic")
+	if err := api.CheckPurl("pkg:generic/anything"); err != nil {
+		t.Errorf("didn't expected it to fail, but it did: %v", err)
+	}
+}
+
+func TestRegisterPurlRuleInvalid(t *testing.T) {
+	if err := api.RegisterPurlRule("", func(purl.PackageURL) error { return nil }); err == nil {
+		t.Error("expected an error but got none")
+	}
+	if err := api.RegisterPurlRule("generic", nil); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
@@ -696,7 +696,9 @@ func (t CDEventCustomDataEncoding) String() string {
 // - When the content type is anything else:
 //
 //   - if the CDEvent is produced via the golang API, the `CustomData`
-//     hold an byte slice with the data passed via the API
+//     hold an byte slice with the data passed via the API, or the data
+//     encoded with the codec registered for the content type, see
+//     `RegisterCustomDataCodec`
 //
 //   - if the CDEvent is consumed and thus un-marshalled from a []byte
 //     the `CustomData` holds the data base64 encoded
@@ -734,10 +736,25 @@ func GetCustomDataRaw(contentType string, data interface{}) ([]byte, error) {
 }
 
 // Used to implement type specific GetCustomDataAs()
+// Data that is not JSON is decoded with the codec registered for its
+// content type, see RegisterCustomDataCodec
 func GetCustomDataAs(e CDEventReader, receiver interface{}) error {
 	contentType := e.GetCustomDataContentType()
 	if contentType != "application/json" && contentType != "" {
-		return fmt.Errorf("cannot unmarshal content-type %s", contentType)
+		codec, found := LookupCustomDataCodec(contentType)
+		if !found {
+			return fmt.Errorf("cannot unmarshal content-type %s", contentType)
+		}
+		// GetCustomData decodes the base64 data of consumed events
+		data, err := e.GetCustomData()
+		if err != nil {
+			return err
+		}
+		raw, isBytes := data.([]byte)
+		if !isBytes {
+			return fmt.Errorf("cannot unmarshal %v with content type %s", data, contentType)
+		}
+		return codec.Unmarshal(raw, receiver)
 	}
 	data, err := e.GetCustomDataRaw()
 	if err != nil {
@@ -783,11 +800,9 @@ func GetCustomData(contentType string, data interface{}) (interface{}, error) {
 	}
 }
 
-// Used to implement SetCustomData()
+// CheckCustomData checks that data can be set as custom data of
+// contentType, see EncodeCustomData
 func CheckCustomData(contentType string, data interface{}) error {
-	_, isBytes := data.([]byte)
-	if !isBytes && contentType != "application/json" && contentType != "" {
-		return fmt.Errorf("%s data must be set as []bytes, got %v", contentType, data)
-	}
-	return nil
+	_, err := EncodeCustomData(contentType, data)
+	return err
 }
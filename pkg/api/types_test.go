@@ -77,9 +77,12 @@ type testWrongType struct {
 
 func TestGetCustomDataAsNonJson(t *testing.T) {
 	receiver := &testType{}
-	expectedError := "cannot unmarshal content-type application/xml"
+	expectedError := "cannot unmarshal content-type application/octet-stream"
 
-	err := api.GetCustomDataAs(eventWithNonJsonCustomData, receiver)
+	e, _ := testapi.NewFooSubjectBarPredicateEvent()
+	e.CustomDataContentType = "application/octet-stream"
+	e.CustomData = []byte(testXmlString)
+	err := api.GetCustomDataAs(e, receiver)
 	if err == nil {
 		t.Fatalf("expected error, got nil")
 	}
@@ -204,7 +207,7 @@ func TestSetCustomData(t *testing.T) {
 
 func TestSetCustomDataInvalid(t *testing.T) {
 	e, _ := testapi.NewFooSubjectBarPredicateEvent()
-	err := e.SetCustomData("application/xml", testType{TestData: "testValue"})
+	err := e.SetCustomData("application/octet-stream", testType{TestData: "testValue"})
 	if err == nil {
 		t.Fatalf("did not expect this to work, but it did")
 	}
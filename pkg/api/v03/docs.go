@@ -23,7 +23,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package v03
 
-import "github.com/cdevents/sdk-go/pkg/api"
+import (
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
 
 var SpecVersion = "0.3.0"
 
@@ -321,6 +324,40 @@ type TestCaseRunFinishedSubject = api.TestCaseRunFinishedSubjectV0_1_0
 // TestCaseRunTestCaseRunSubjectContentTestCaseV0_1_0 holds the content of a TestCase field in the content
 type TestCaseRunFinishedSubjectContentTestCase = api.TestCaseRunFinishedSubjectContentTestCaseV0_1_0
 
+// TestCaseRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type TestCaseRunFinishedSubjectContentOutcome = api.TestCaseRunFinishedSubjectContentOutcomeV0_1_0
+
+const (
+	TestCaseRunFinishedSubjectContentOutcomePass   = api.TestCaseRunFinishedSubjectContentOutcomePassV0_1_0
+	TestCaseRunFinishedSubjectContentOutcomeFail   = api.TestCaseRunFinishedSubjectContentOutcomeFailV0_1_0
+	TestCaseRunFinishedSubjectContentOutcomeCancel = api.TestCaseRunFinishedSubjectContentOutcomeCancelV0_1_0
+	TestCaseRunFinishedSubjectContentOutcomeError  = api.TestCaseRunFinishedSubjectContentOutcomeErrorV0_1_0
+)
+
+// TestCaseRunFinishedSubjectContentSeverity holds the values defined for a Severity field in the content
+type TestCaseRunFinishedSubjectContentSeverity = api.TestCaseRunFinishedSubjectContentSeverityV0_1_0
+
+const (
+	TestCaseRunFinishedSubjectContentSeverityLow      = api.TestCaseRunFinishedSubjectContentSeverityLowV0_1_0
+	TestCaseRunFinishedSubjectContentSeverityMedium   = api.TestCaseRunFinishedSubjectContentSeverityMediumV0_1_0
+	TestCaseRunFinishedSubjectContentSeverityHigh     = api.TestCaseRunFinishedSubjectContentSeverityHighV0_1_0
+	TestCaseRunFinishedSubjectContentSeverityCritical = api.TestCaseRunFinishedSubjectContentSeverityCriticalV0_1_0
+)
+
+// TestCaseRunFinishedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunFinishedSubjectContentTestCaseType = api.TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0
+
+const (
+	TestCaseRunFinishedSubjectContentTestCaseTypePerformance = api.TestCaseRunFinishedSubjectContentTestCaseTypePerformanceV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunFinishedSubjectContentTestCaseTypeFunctionalV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeUnit        = api.TestCaseRunFinishedSubjectContentTestCaseTypeUnitV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunFinishedSubjectContentTestCaseTypeSecurityV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunFinishedSubjectContentTestCaseTypeComplianceV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeIntegration = api.TestCaseRunFinishedSubjectContentTestCaseTypeIntegrationV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeE2e         = api.TestCaseRunFinishedSubjectContentTestCaseTypeE2eV0_1_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeOther       = api.TestCaseRunFinishedSubjectContentTestCaseTypeOtherV0_1_0
+)
+
 func NewTestCaseRunFinishedEvent() (*TestCaseRunFinishedEvent, error) {
 	return api.NewTestCaseRunFinishedEventV0_1_0(SpecVersion)
 }
@@ -336,6 +373,31 @@ type TestCaseRunQueuedSubjectContentTestCase = api.TestCaseRunQueuedSubjectConte
 // TestCaseRunTestCaseRunSubjectContentTriggerV0_1_0 holds the content of a Trigger field in the content
 type TestCaseRunQueuedSubjectContentTrigger = api.TestCaseRunQueuedSubjectContentTriggerV0_1_0
 
+// TestCaseRunQueuedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunQueuedSubjectContentTestCaseType = api.TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0
+
+const (
+	TestCaseRunQueuedSubjectContentTestCaseTypePerformance = api.TestCaseRunQueuedSubjectContentTestCaseTypePerformanceV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunQueuedSubjectContentTestCaseTypeFunctionalV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeUnit        = api.TestCaseRunQueuedSubjectContentTestCaseTypeUnitV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunQueuedSubjectContentTestCaseTypeSecurityV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunQueuedSubjectContentTestCaseTypeComplianceV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeIntegration = api.TestCaseRunQueuedSubjectContentTestCaseTypeIntegrationV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeE2e         = api.TestCaseRunQueuedSubjectContentTestCaseTypeE2eV0_1_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeOther       = api.TestCaseRunQueuedSubjectContentTestCaseTypeOtherV0_1_0
+)
+
+// TestCaseRunQueuedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestCaseRunQueuedSubjectContentTriggerType = api.TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0
+
+const (
+	TestCaseRunQueuedSubjectContentTriggerTypeManual   = api.TestCaseRunQueuedSubjectContentTriggerTypeManualV0_1_0
+	TestCaseRunQueuedSubjectContentTriggerTypePipeline = api.TestCaseRunQueuedSubjectContentTriggerTypePipelineV0_1_0
+	TestCaseRunQueuedSubjectContentTriggerTypeEvent    = api.TestCaseRunQueuedSubjectContentTriggerTypeEventV0_1_0
+	TestCaseRunQueuedSubjectContentTriggerTypeSchedule = api.TestCaseRunQueuedSubjectContentTriggerTypeScheduleV0_1_0
+	TestCaseRunQueuedSubjectContentTriggerTypeOther    = api.TestCaseRunQueuedSubjectContentTriggerTypeOtherV0_1_0
+)
+
 func NewTestCaseRunQueuedEvent() (*TestCaseRunQueuedEvent, error) {
 	return api.NewTestCaseRunQueuedEventV0_1_0(SpecVersion)
 }
@@ -351,6 +413,31 @@ type TestCaseRunStartedSubjectContentTestCase = api.TestCaseRunStartedSubjectCon
 // TestCaseRunTestCaseRunSubjectContentTriggerV0_1_0 holds the content of a Trigger field in the content
 type TestCaseRunStartedSubjectContentTrigger = api.TestCaseRunStartedSubjectContentTriggerV0_1_0
 
+// TestCaseRunStartedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunStartedSubjectContentTestCaseType = api.TestCaseRunStartedSubjectContentTestCaseTypeV0_1_0
+
+const (
+	TestCaseRunStartedSubjectContentTestCaseTypePerformance = api.TestCaseRunStartedSubjectContentTestCaseTypePerformanceV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunStartedSubjectContentTestCaseTypeFunctionalV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeUnit        = api.TestCaseRunStartedSubjectContentTestCaseTypeUnitV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunStartedSubjectContentTestCaseTypeSecurityV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunStartedSubjectContentTestCaseTypeComplianceV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeIntegration = api.TestCaseRunStartedSubjectContentTestCaseTypeIntegrationV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeE2e         = api.TestCaseRunStartedSubjectContentTestCaseTypeE2eV0_1_0
+	TestCaseRunStartedSubjectContentTestCaseTypeOther       = api.TestCaseRunStartedSubjectContentTestCaseTypeOtherV0_1_0
+)
+
+// TestCaseRunStartedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestCaseRunStartedSubjectContentTriggerType = api.TestCaseRunStartedSubjectContentTriggerTypeV0_1_0
+
+const (
+	TestCaseRunStartedSubjectContentTriggerTypeManual   = api.TestCaseRunStartedSubjectContentTriggerTypeManualV0_1_0
+	TestCaseRunStartedSubjectContentTriggerTypePipeline = api.TestCaseRunStartedSubjectContentTriggerTypePipelineV0_1_0
+	TestCaseRunStartedSubjectContentTriggerTypeEvent    = api.TestCaseRunStartedSubjectContentTriggerTypeEventV0_1_0
+	TestCaseRunStartedSubjectContentTriggerTypeSchedule = api.TestCaseRunStartedSubjectContentTriggerTypeScheduleV0_1_0
+	TestCaseRunStartedSubjectContentTriggerTypeOther    = api.TestCaseRunStartedSubjectContentTriggerTypeOtherV0_1_0
+)
+
 func NewTestCaseRunStartedEvent() (*TestCaseRunStartedEvent, error) {
 	return api.NewTestCaseRunStartedEventV0_1_0(SpecVersion)
 }
@@ -360,6 +447,17 @@ var TestCaseRunStartedEventType = api.TestCaseRunStartedEventTypeV0_1_0
 type TestOutputPublishedEvent = api.TestOutputPublishedEventV0_1_0
 type TestOutputPublishedSubject = api.TestOutputPublishedSubjectV0_1_0
 
+// TestOutputPublishedSubjectContentOutputType holds the values defined for an OutputType field in the content
+type TestOutputPublishedSubjectContentOutputType = api.TestOutputPublishedSubjectContentOutputTypeV0_1_0
+
+const (
+	TestOutputPublishedSubjectContentOutputTypeReport = api.TestOutputPublishedSubjectContentOutputTypeReportV0_1_0
+	TestOutputPublishedSubjectContentOutputTypeVideo  = api.TestOutputPublishedSubjectContentOutputTypeVideoV0_1_0
+	TestOutputPublishedSubjectContentOutputTypeImage  = api.TestOutputPublishedSubjectContentOutputTypeImageV0_1_0
+	TestOutputPublishedSubjectContentOutputTypeLog    = api.TestOutputPublishedSubjectContentOutputTypeLogV0_1_0
+	TestOutputPublishedSubjectContentOutputTypeOther  = api.TestOutputPublishedSubjectContentOutputTypeOtherV0_1_0
+)
+
 func NewTestOutputPublishedEvent() (*TestOutputPublishedEvent, error) {
 	return api.NewTestOutputPublishedEventV0_1_0(SpecVersion)
 }
@@ -372,6 +470,26 @@ type TestSuiteRunFinishedSubject = api.TestSuiteRunFinishedSubjectV0_1_0
 // TestSuiteRunTestSuiteRunSubjectContentTestSuiteV0_1_0 holds the content of a TestSuite field in the content
 type TestSuiteRunFinishedSubjectContentTestSuite = api.TestSuiteRunFinishedSubjectContentTestSuiteV0_1_0
 
+// TestSuiteRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type TestSuiteRunFinishedSubjectContentOutcome = api.TestSuiteRunFinishedSubjectContentOutcomeV0_1_0
+
+const (
+	TestSuiteRunFinishedSubjectContentOutcomePass   = api.TestSuiteRunFinishedSubjectContentOutcomePassV0_1_0
+	TestSuiteRunFinishedSubjectContentOutcomeFail   = api.TestSuiteRunFinishedSubjectContentOutcomeFailV0_1_0
+	TestSuiteRunFinishedSubjectContentOutcomeCancel = api.TestSuiteRunFinishedSubjectContentOutcomeCancelV0_1_0
+	TestSuiteRunFinishedSubjectContentOutcomeError  = api.TestSuiteRunFinishedSubjectContentOutcomeErrorV0_1_0
+)
+
+// TestSuiteRunFinishedSubjectContentSeverity holds the values defined for a Severity field in the content
+type TestSuiteRunFinishedSubjectContentSeverity = api.TestSuiteRunFinishedSubjectContentSeverityV0_1_0
+
+const (
+	TestSuiteRunFinishedSubjectContentSeverityLow      = api.TestSuiteRunFinishedSubjectContentSeverityLowV0_1_0
+	TestSuiteRunFinishedSubjectContentSeverityMedium   = api.TestSuiteRunFinishedSubjectContentSeverityMediumV0_1_0
+	TestSuiteRunFinishedSubjectContentSeverityHigh     = api.TestSuiteRunFinishedSubjectContentSeverityHighV0_1_0
+	TestSuiteRunFinishedSubjectContentSeverityCritical = api.TestSuiteRunFinishedSubjectContentSeverityCriticalV0_1_0
+)
+
 func NewTestSuiteRunFinishedEvent() (*TestSuiteRunFinishedEvent, error) {
 	return api.NewTestSuiteRunFinishedEventV0_1_0(SpecVersion)
 }
@@ -387,6 +505,17 @@ type TestSuiteRunQueuedSubjectContentTestSuite = api.TestSuiteRunQueuedSubjectCo
 // TestSuiteRunTestSuiteRunSubjectContentTriggerV0_1_0 holds the content of a Trigger field in the content
 type TestSuiteRunQueuedSubjectContentTrigger = api.TestSuiteRunQueuedSubjectContentTriggerV0_1_0
 
+// TestSuiteRunQueuedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestSuiteRunQueuedSubjectContentTriggerType = api.TestSuiteRunQueuedSubjectContentTriggerTypeV0_1_0
+
+const (
+	TestSuiteRunQueuedSubjectContentTriggerTypeManual   = api.TestSuiteRunQueuedSubjectContentTriggerTypeManualV0_1_0
+	TestSuiteRunQueuedSubjectContentTriggerTypePipeline = api.TestSuiteRunQueuedSubjectContentTriggerTypePipelineV0_1_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeEvent    = api.TestSuiteRunQueuedSubjectContentTriggerTypeEventV0_1_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeSchedule = api.TestSuiteRunQueuedSubjectContentTriggerTypeScheduleV0_1_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeOther    = api.TestSuiteRunQueuedSubjectContentTriggerTypeOtherV0_1_0
+)
+
 func NewTestSuiteRunQueuedEvent() (*TestSuiteRunQueuedEvent, error) {
 	return api.NewTestSuiteRunQueuedEventV0_1_0(SpecVersion)
 }
@@ -402,6 +531,17 @@ type TestSuiteRunStartedSubjectContentTestSuite = api.TestSuiteRunStartedSubject
 // TestSuiteRunTestSuiteRunSubjectContentTriggerV0_1_0 holds the content of a Trigger field in the content
 type TestSuiteRunStartedSubjectContentTrigger = api.TestSuiteRunStartedSubjectContentTriggerV0_1_0
 
+// TestSuiteRunStartedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestSuiteRunStartedSubjectContentTriggerType = api.TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0
+
+const (
+	TestSuiteRunStartedSubjectContentTriggerTypeManual   = api.TestSuiteRunStartedSubjectContentTriggerTypeManualV0_1_0
+	TestSuiteRunStartedSubjectContentTriggerTypePipeline = api.TestSuiteRunStartedSubjectContentTriggerTypePipelineV0_1_0
+	TestSuiteRunStartedSubjectContentTriggerTypeEvent    = api.TestSuiteRunStartedSubjectContentTriggerTypeEventV0_1_0
+	TestSuiteRunStartedSubjectContentTriggerTypeSchedule = api.TestSuiteRunStartedSubjectContentTriggerTypeScheduleV0_1_0
+	TestSuiteRunStartedSubjectContentTriggerTypeOther    = api.TestSuiteRunStartedSubjectContentTriggerTypeOtherV0_1_0
+)
+
 func NewTestSuiteRunStartedEvent() (*TestSuiteRunStartedEvent, error) {
 	return api.NewTestSuiteRunStartedEventV0_1_0(SpecVersion)
 }
@@ -420,3 +560,11 @@ func NewFromJsonBytes(event []byte) (api.CDEvent, error) {
 func NewFromJsonString(event string) (api.CDEvent, error) {
 	return NewFromJsonBytes([]byte(event))
 }
+
+// NewFromCloudEvent builds a new CDEventReader from the payload of a CloudEvent.
+// Context fields missing from the payload are restored from the CloudEvents
+// extension attributes set by api.WithContextExtensions, and the CloudEvent
+// attributes are checked against the payload.
+func NewFromCloudEvent(event cloudevents.Event) (api.CDEvent, error) {
+	return api.NewFromCloudEventContext[api.CDEvent](event, CDEventsByUnversionedTypes)
+}
@@ -72,11 +72,11 @@ var (
 		Type: "schedule"}
 	testTestTriggerStarted = &apiv03.TestCaseRunStartedSubjectContentTrigger{
 		Type: "schedule"}
-	testTestOutcome             = "pass"
+	testTestOutcome             = apiv03.TestCaseRunFinishedSubjectContentOutcomePass
 	testTestOutputSubjectId     = "testrunreport-12123"
 	testTestOutputSubjectSource = "/event/source/testrunreport-12123"
 	testTestOutputFormat        = "video/quicktime"
-	testTestOutputOutputType    = "video"
+	testTestOutputOutputType    = apiv03.TestOutputPublishedSubjectContentOutputTypeVideo
 	testTestCaseRun             = &api.Reference{Id: testTestRunId, Source: "testkube-dev-123"}
 	testTestSuiteRunId          = "myTestSuiteRun123"
 	testTestSuiteStarted        = &apiv03.TestSuiteRunStartedSubjectContentTestSuite{
@@ -85,9 +85,9 @@ var (
 		Id: "92834723894", Name: "Auth TestSuite", Version: "1.0"}
 	testTestSuiteFinished = &apiv03.TestSuiteRunFinishedSubjectContentTestSuite{
 		Id: "92834723894", Name: "Auth TestSuite", Version: "1.0"}
-	testTestSuiteOutcome        = "fail"
+	testTestSuiteOutcome        = apiv03.TestSuiteRunFinishedSubjectContentOutcomeFail
 	testTestSuiteReason         = "Host 123.34.23.32 not found"
-	testTestSuiteSeverity       = "critical"
+	testTestSuiteSeverity       = apiv03.TestSuiteRunFinishedSubjectContentSeverityCritical
 	testTestSuiteTriggerQueued  = &apiv03.TestSuiteRunQueuedSubjectContentTrigger{Type: "pipeline"}
 	testTestSuiteTriggerStarted = &apiv03.TestSuiteRunStartedSubjectContentTrigger{Type: "pipeline"}
 
@@ -78,11 +78,11 @@ var (
 		Type: "schedule"}
 	testTestTriggerStarted = &apiv04.TestCaseRunStartedSubjectContentTrigger{
 		Type: "schedule"}
-	testTestOutcome             = "pass"
+	testTestOutcome             = apiv04.TestCaseRunFinishedSubjectContentOutcomePass
 	testTestOutputSubjectId     = "testrunreport-12123"
 	testTestOutputSubjectSource = "/event/source/testrunreport-12123"
 	testTestOutputFormat        = "video/quicktime"
-	testTestOutputOutputType    = "video"
+	testTestOutputOutputType    = apiv04.TestOutputPublishedSubjectContentOutputTypeVideo
 	testTestCaseRun             = &api.Reference{Id: testTestRunId, Source: "testkube-dev-123"}
 	testTestSuiteRunId          = "myTestSuiteRun123"
 	testTestSuiteStarted        = &apiv04.TestSuiteRunStartedSubjectContentTestSuite{
@@ -91,9 +91,9 @@ var (
 		Id: "92834723894", Name: "Auth TestSuite", Version: "1.0"}
 	testTestSuiteFinished = &apiv04.TestSuiteRunFinishedSubjectContentTestSuite{
 		Id: "92834723894", Name: "Auth TestSuite", Version: "1.0"}
-	testTestSuiteOutcome        = "fail"
+	testTestSuiteOutcome        = apiv04.TestSuiteRunFinishedSubjectContentOutcomeFail
 	testTestSuiteReason         = "Host 123.34.23.32 not found"
-	testTestSuiteSeverity       = "critical"
+	testTestSuiteSeverity       = apiv04.TestSuiteRunFinishedSubjectContentSeverityCritical
 	testTestSuiteTriggerQueued  = &apiv04.TestSuiteRunQueuedSubjectContentTrigger{Type: "pipeline"}
 	testTestSuiteTriggerStarted = &apiv04.TestSuiteRunStartedSubjectContentTrigger{Type: "pipeline"}
 	testSubjectUser             = "mybot-myapp"
@@ -23,7 +23,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package v04
 
-import "github.com/cdevents/sdk-go/pkg/api"
+import (
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
 
 var SpecVersion = "0.4.1"
 
@@ -345,6 +348,40 @@ type TestCaseRunFinishedSubject = api.TestCaseRunFinishedSubjectV0_2_0
 // TestCaseRunTestCaseRunSubjectContentTestCaseV0_2_0 holds the content of a TestCase field in the content
 type TestCaseRunFinishedSubjectContentTestCase = api.TestCaseRunFinishedSubjectContentTestCaseV0_2_0
 
+// TestCaseRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type TestCaseRunFinishedSubjectContentOutcome = api.TestCaseRunFinishedSubjectContentOutcomeV0_2_0
+
+const (
+	TestCaseRunFinishedSubjectContentOutcomePass   = api.TestCaseRunFinishedSubjectContentOutcomePassV0_2_0
+	TestCaseRunFinishedSubjectContentOutcomeFail   = api.TestCaseRunFinishedSubjectContentOutcomeFailV0_2_0
+	TestCaseRunFinishedSubjectContentOutcomeCancel = api.TestCaseRunFinishedSubjectContentOutcomeCancelV0_2_0
+	TestCaseRunFinishedSubjectContentOutcomeError  = api.TestCaseRunFinishedSubjectContentOutcomeErrorV0_2_0
+)
+
+// TestCaseRunFinishedSubjectContentSeverity holds the values defined for a Severity field in the content
+type TestCaseRunFinishedSubjectContentSeverity = api.TestCaseRunFinishedSubjectContentSeverityV0_2_0
+
+const (
+	TestCaseRunFinishedSubjectContentSeverityLow      = api.TestCaseRunFinishedSubjectContentSeverityLowV0_2_0
+	TestCaseRunFinishedSubjectContentSeverityMedium   = api.TestCaseRunFinishedSubjectContentSeverityMediumV0_2_0
+	TestCaseRunFinishedSubjectContentSeverityHigh     = api.TestCaseRunFinishedSubjectContentSeverityHighV0_2_0
+	TestCaseRunFinishedSubjectContentSeverityCritical = api.TestCaseRunFinishedSubjectContentSeverityCriticalV0_2_0
+)
+
+// TestCaseRunFinishedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunFinishedSubjectContentTestCaseType = api.TestCaseRunFinishedSubjectContentTestCaseTypeV0_2_0
+
+const (
+	TestCaseRunFinishedSubjectContentTestCaseTypePerformance = api.TestCaseRunFinishedSubjectContentTestCaseTypePerformanceV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunFinishedSubjectContentTestCaseTypeFunctionalV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeUnit        = api.TestCaseRunFinishedSubjectContentTestCaseTypeUnitV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunFinishedSubjectContentTestCaseTypeSecurityV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunFinishedSubjectContentTestCaseTypeComplianceV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeIntegration = api.TestCaseRunFinishedSubjectContentTestCaseTypeIntegrationV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeE2e         = api.TestCaseRunFinishedSubjectContentTestCaseTypeE2eV0_2_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeOther       = api.TestCaseRunFinishedSubjectContentTestCaseTypeOtherV0_2_0
+)
+
 func NewTestCaseRunFinishedEvent() (*TestCaseRunFinishedEvent, error) {
 	return api.NewTestCaseRunFinishedEventV0_2_0(SpecVersion)
 }
@@ -360,6 +397,31 @@ type TestCaseRunQueuedSubjectContentTestCase = api.TestCaseRunQueuedSubjectConte
 // TestCaseRunTestCaseRunSubjectContentTriggerV0_2_0 holds the content of a Trigger field in the content
 type TestCaseRunQueuedSubjectContentTrigger = api.TestCaseRunQueuedSubjectContentTriggerV0_2_0
 
+// TestCaseRunQueuedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunQueuedSubjectContentTestCaseType = api.TestCaseRunQueuedSubjectContentTestCaseTypeV0_2_0
+
+const (
+	TestCaseRunQueuedSubjectContentTestCaseTypePerformance = api.TestCaseRunQueuedSubjectContentTestCaseTypePerformanceV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunQueuedSubjectContentTestCaseTypeFunctionalV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeUnit        = api.TestCaseRunQueuedSubjectContentTestCaseTypeUnitV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunQueuedSubjectContentTestCaseTypeSecurityV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunQueuedSubjectContentTestCaseTypeComplianceV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeIntegration = api.TestCaseRunQueuedSubjectContentTestCaseTypeIntegrationV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeE2e         = api.TestCaseRunQueuedSubjectContentTestCaseTypeE2eV0_2_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeOther       = api.TestCaseRunQueuedSubjectContentTestCaseTypeOtherV0_2_0
+)
+
+// TestCaseRunQueuedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestCaseRunQueuedSubjectContentTriggerType = api.TestCaseRunQueuedSubjectContentTriggerTypeV0_2_0
+
+const (
+	TestCaseRunQueuedSubjectContentTriggerTypeManual   = api.TestCaseRunQueuedSubjectContentTriggerTypeManualV0_2_0
+	TestCaseRunQueuedSubjectContentTriggerTypePipeline = api.TestCaseRunQueuedSubjectContentTriggerTypePipelineV0_2_0
+	TestCaseRunQueuedSubjectContentTriggerTypeEvent    = api.TestCaseRunQueuedSubjectContentTriggerTypeEventV0_2_0
+	TestCaseRunQueuedSubjectContentTriggerTypeSchedule = api.TestCaseRunQueuedSubjectContentTriggerTypeScheduleV0_2_0
+	TestCaseRunQueuedSubjectContentTriggerTypeOther    = api.TestCaseRunQueuedSubjectContentTriggerTypeOtherV0_2_0
+)
+
 func NewTestCaseRunQueuedEvent() (*TestCaseRunQueuedEvent, error) {
 	return api.NewTestCaseRunQueuedEventV0_2_0(SpecVersion)
 }
@@ -372,6 +434,20 @@ type TestCaseRunSkippedSubject = api.TestCaseRunSkippedSubjectV0_1_0
 // TestCaseRunTestCaseRunSubjectContentTestCaseV0_1_0 holds the content of a TestCase field in the content
 type TestCaseRunSkippedSubjectContentTestCase = api.TestCaseRunSkippedSubjectContentTestCaseV0_1_0
 
+// TestCaseRunSkippedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunSkippedSubjectContentTestCaseType = api.TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0
+
+const (
+	TestCaseRunSkippedSubjectContentTestCaseTypePerformance = api.TestCaseRunSkippedSubjectContentTestCaseTypePerformanceV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunSkippedSubjectContentTestCaseTypeFunctionalV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeUnit        = api.TestCaseRunSkippedSubjectContentTestCaseTypeUnitV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunSkippedSubjectContentTestCaseTypeSecurityV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunSkippedSubjectContentTestCaseTypeComplianceV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeIntegration = api.TestCaseRunSkippedSubjectContentTestCaseTypeIntegrationV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeE2e         = api.TestCaseRunSkippedSubjectContentTestCaseTypeE2eV0_1_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeOther       = api.TestCaseRunSkippedSubjectContentTestCaseTypeOtherV0_1_0
+)
+
 func NewTestCaseRunSkippedEvent() (*TestCaseRunSkippedEvent, error) {
 	return api.NewTestCaseRunSkippedEventV0_1_0(SpecVersion)
 }
@@ -387,6 +463,31 @@ type TestCaseRunStartedSubjectContentTestCase = api.TestCaseRunStartedSubjectCon
 // TestCaseRunTestCaseRunSubjectContentTriggerV0_2_0 holds the content of a Trigger field in the content
 type TestCaseRunStartedSubjectContentTrigger = api.TestCaseRunStartedSubjectContentTriggerV0_2_0
 
+// TestCaseRunStartedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunStartedSubjectContentTestCaseType = api.TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0
+
+const (
+	TestCaseRunStartedSubjectContentTestCaseTypePerformance = api.TestCaseRunStartedSubjectContentTestCaseTypePerformanceV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunStartedSubjectContentTestCaseTypeFunctionalV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeUnit        = api.TestCaseRunStartedSubjectContentTestCaseTypeUnitV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunStartedSubjectContentTestCaseTypeSecurityV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunStartedSubjectContentTestCaseTypeComplianceV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeIntegration = api.TestCaseRunStartedSubjectContentTestCaseTypeIntegrationV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeE2e         = api.TestCaseRunStartedSubjectContentTestCaseTypeE2eV0_2_0
+	TestCaseRunStartedSubjectContentTestCaseTypeOther       = api.TestCaseRunStartedSubjectContentTestCaseTypeOtherV0_2_0
+)
+
+// TestCaseRunStartedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestCaseRunStartedSubjectContentTriggerType = api.TestCaseRunStartedSubjectContentTriggerTypeV0_2_0
+
+const (
+	TestCaseRunStartedSubjectContentTriggerTypeManual   = api.TestCaseRunStartedSubjectContentTriggerTypeManualV0_2_0
+	TestCaseRunStartedSubjectContentTriggerTypePipeline = api.TestCaseRunStartedSubjectContentTriggerTypePipelineV0_2_0
+	TestCaseRunStartedSubjectContentTriggerTypeEvent    = api.TestCaseRunStartedSubjectContentTriggerTypeEventV0_2_0
+	TestCaseRunStartedSubjectContentTriggerTypeSchedule = api.TestCaseRunStartedSubjectContentTriggerTypeScheduleV0_2_0
+	TestCaseRunStartedSubjectContentTriggerTypeOther    = api.TestCaseRunStartedSubjectContentTriggerTypeOtherV0_2_0
+)
+
 func NewTestCaseRunStartedEvent() (*TestCaseRunStartedEvent, error) {
 	return api.NewTestCaseRunStartedEventV0_2_0(SpecVersion)
 }
@@ -396,6 +497,17 @@ var TestCaseRunStartedEventType = api.TestCaseRunStartedEventTypeV0_2_0
 type TestOutputPublishedEvent = api.TestOutputPublishedEventV0_2_0
 type TestOutputPublishedSubject = api.TestOutputPublishedSubjectV0_2_0
 
+// TestOutputPublishedSubjectContentOutputType holds the values defined for an OutputType field in the content
+type TestOutputPublishedSubjectContentOutputType = api.TestOutputPublishedSubjectContentOutputTypeV0_2_0
+
+const (
+	TestOutputPublishedSubjectContentOutputTypeReport = api.TestOutputPublishedSubjectContentOutputTypeReportV0_2_0
+	TestOutputPublishedSubjectContentOutputTypeVideo  = api.TestOutputPublishedSubjectContentOutputTypeVideoV0_2_0
+	TestOutputPublishedSubjectContentOutputTypeImage  = api.TestOutputPublishedSubjectContentOutputTypeImageV0_2_0
+	TestOutputPublishedSubjectContentOutputTypeLog    = api.TestOutputPublishedSubjectContentOutputTypeLogV0_2_0
+	TestOutputPublishedSubjectContentOutputTypeOther  = api.TestOutputPublishedSubjectContentOutputTypeOtherV0_2_0
+)
+
 func NewTestOutputPublishedEvent() (*TestOutputPublishedEvent, error) {
 	return api.NewTestOutputPublishedEventV0_2_0(SpecVersion)
 }
@@ -408,6 +520,26 @@ type TestSuiteRunFinishedSubject = api.TestSuiteRunFinishedSubjectV0_2_0
 // TestSuiteRunTestSuiteRunSubjectContentTestSuiteV0_2_0 holds the content of a TestSuite field in the content
 type TestSuiteRunFinishedSubjectContentTestSuite = api.TestSuiteRunFinishedSubjectContentTestSuiteV0_2_0
 
+// TestSuiteRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type TestSuiteRunFinishedSubjectContentOutcome = api.TestSuiteRunFinishedSubjectContentOutcomeV0_2_0
+
+const (
+	TestSuiteRunFinishedSubjectContentOutcomePass   = api.TestSuiteRunFinishedSubjectContentOutcomePassV0_2_0
+	TestSuiteRunFinishedSubjectContentOutcomeFail   = api.TestSuiteRunFinishedSubjectContentOutcomeFailV0_2_0
+	TestSuiteRunFinishedSubjectContentOutcomeCancel = api.TestSuiteRunFinishedSubjectContentOutcomeCancelV0_2_0
+	TestSuiteRunFinishedSubjectContentOutcomeError  = api.TestSuiteRunFinishedSubjectContentOutcomeErrorV0_2_0
+)
+
+// TestSuiteRunFinishedSubjectContentSeverity holds the values defined for a Severity field in the content
+type TestSuiteRunFinishedSubjectContentSeverity = api.TestSuiteRunFinishedSubjectContentSeverityV0_2_0
+
+const (
+	TestSuiteRunFinishedSubjectContentSeverityLow      = api.TestSuiteRunFinishedSubjectContentSeverityLowV0_2_0
+	TestSuiteRunFinishedSubjectContentSeverityMedium   = api.TestSuiteRunFinishedSubjectContentSeverityMediumV0_2_0
+	TestSuiteRunFinishedSubjectContentSeverityHigh     = api.TestSuiteRunFinishedSubjectContentSeverityHighV0_2_0
+	TestSuiteRunFinishedSubjectContentSeverityCritical = api.TestSuiteRunFinishedSubjectContentSeverityCriticalV0_2_0
+)
+
 func NewTestSuiteRunFinishedEvent() (*TestSuiteRunFinishedEvent, error) {
 	return api.NewTestSuiteRunFinishedEventV0_2_0(SpecVersion)
 }
@@ -423,6 +555,17 @@ type TestSuiteRunQueuedSubjectContentTestSuite = api.TestSuiteRunQueuedSubjectCo
 // TestSuiteRunTestSuiteRunSubjectContentTriggerV0_2_0 holds the content of a Trigger field in the content
 type TestSuiteRunQueuedSubjectContentTrigger = api.TestSuiteRunQueuedSubjectContentTriggerV0_2_0
 
+// TestSuiteRunQueuedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestSuiteRunQueuedSubjectContentTriggerType = api.TestSuiteRunQueuedSubjectContentTriggerTypeV0_2_0
+
+const (
+	TestSuiteRunQueuedSubjectContentTriggerTypeManual   = api.TestSuiteRunQueuedSubjectContentTriggerTypeManualV0_2_0
+	TestSuiteRunQueuedSubjectContentTriggerTypePipeline = api.TestSuiteRunQueuedSubjectContentTriggerTypePipelineV0_2_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeEvent    = api.TestSuiteRunQueuedSubjectContentTriggerTypeEventV0_2_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeSchedule = api.TestSuiteRunQueuedSubjectContentTriggerTypeScheduleV0_2_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeOther    = api.TestSuiteRunQueuedSubjectContentTriggerTypeOtherV0_2_0
+)
+
 func NewTestSuiteRunQueuedEvent() (*TestSuiteRunQueuedEvent, error) {
 	return api.NewTestSuiteRunQueuedEventV0_2_0(SpecVersion)
 }
@@ -438,6 +581,17 @@ type TestSuiteRunStartedSubjectContentTestSuite = api.TestSuiteRunStartedSubject
 // TestSuiteRunTestSuiteRunSubjectContentTriggerV0_2_0 holds the content of a Trigger field in the content
 type TestSuiteRunStartedSubjectContentTrigger = api.TestSuiteRunStartedSubjectContentTriggerV0_2_0
 
+// TestSuiteRunStartedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestSuiteRunStartedSubjectContentTriggerType = api.TestSuiteRunStartedSubjectContentTriggerTypeV0_2_0
+
+const (
+	TestSuiteRunStartedSubjectContentTriggerTypeManual   = api.TestSuiteRunStartedSubjectContentTriggerTypeManualV0_2_0
+	TestSuiteRunStartedSubjectContentTriggerTypePipeline = api.TestSuiteRunStartedSubjectContentTriggerTypePipelineV0_2_0
+	TestSuiteRunStartedSubjectContentTriggerTypeEvent    = api.TestSuiteRunStartedSubjectContentTriggerTypeEventV0_2_0
+	TestSuiteRunStartedSubjectContentTriggerTypeSchedule = api.TestSuiteRunStartedSubjectContentTriggerTypeScheduleV0_2_0
+	TestSuiteRunStartedSubjectContentTriggerTypeOther    = api.TestSuiteRunStartedSubjectContentTriggerTypeOtherV0_2_0
+)
+
 func NewTestSuiteRunStartedEvent() (*TestSuiteRunStartedEvent, error) {
 	return api.NewTestSuiteRunStartedEventV0_2_0(SpecVersion)
 }
@@ -447,6 +601,32 @@ var TestSuiteRunStartedEventType = api.TestSuiteRunStartedEventTypeV0_2_0
 type TicketClosedEvent = api.TicketClosedEventV0_1_0
 type TicketClosedSubject = api.TicketClosedSubjectV0_1_0
 
+// Values defined for a Priority field in the content, which also accepts free text
+
+const (
+	TicketClosedSubjectContentPriorityLow    = api.TicketClosedSubjectContentPriorityLowV0_1_0
+	TicketClosedSubjectContentPriorityMedium = api.TicketClosedSubjectContentPriorityMediumV0_1_0
+	TicketClosedSubjectContentPriorityHigh   = api.TicketClosedSubjectContentPriorityHighV0_1_0
+)
+
+// Values defined for a Resolution field in the content, which also accepts free text
+
+const (
+	TicketClosedSubjectContentResolutionCompleted = api.TicketClosedSubjectContentResolutionCompletedV0_1_0
+	TicketClosedSubjectContentResolutionWithdrawn = api.TicketClosedSubjectContentResolutionWithdrawnV0_1_0
+	TicketClosedSubjectContentResolutionDuplicate = api.TicketClosedSubjectContentResolutionDuplicateV0_1_0
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+
+const (
+	TicketClosedSubjectContentTicketTypeBug         = api.TicketClosedSubjectContentTicketTypeBugV0_1_0
+	TicketClosedSubjectContentTicketTypeEnhancement = api.TicketClosedSubjectContentTicketTypeEnhancementV0_1_0
+	TicketClosedSubjectContentTicketTypeIncident    = api.TicketClosedSubjectContentTicketTypeIncidentV0_1_0
+	TicketClosedSubjectContentTicketTypeTask        = api.TicketClosedSubjectContentTicketTypeTaskV0_1_0
+	TicketClosedSubjectContentTicketTypeQuestion    = api.TicketClosedSubjectContentTicketTypeQuestionV0_1_0
+)
+
 func NewTicketClosedEvent() (*TicketClosedEvent, error) {
 	return api.NewTicketClosedEventV0_1_0(SpecVersion)
 }
@@ -456,6 +636,24 @@ var TicketClosedEventType = api.TicketClosedEventTypeV0_1_0
 type TicketCreatedEvent = api.TicketCreatedEventV0_1_0
 type TicketCreatedSubject = api.TicketCreatedSubjectV0_1_0
 
+// Values defined for a Priority field in the content, which also accepts free text
+
+const (
+	TicketCreatedSubjectContentPriorityLow    = api.TicketCreatedSubjectContentPriorityLowV0_1_0
+	TicketCreatedSubjectContentPriorityMedium = api.TicketCreatedSubjectContentPriorityMediumV0_1_0
+	TicketCreatedSubjectContentPriorityHigh   = api.TicketCreatedSubjectContentPriorityHighV0_1_0
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+
+const (
+	TicketCreatedSubjectContentTicketTypeBug         = api.TicketCreatedSubjectContentTicketTypeBugV0_1_0
+	TicketCreatedSubjectContentTicketTypeEnhancement = api.TicketCreatedSubjectContentTicketTypeEnhancementV0_1_0
+	TicketCreatedSubjectContentTicketTypeIncident    = api.TicketCreatedSubjectContentTicketTypeIncidentV0_1_0
+	TicketCreatedSubjectContentTicketTypeTask        = api.TicketCreatedSubjectContentTicketTypeTaskV0_1_0
+	TicketCreatedSubjectContentTicketTypeQuestion    = api.TicketCreatedSubjectContentTicketTypeQuestionV0_1_0
+)
+
 func NewTicketCreatedEvent() (*TicketCreatedEvent, error) {
 	return api.NewTicketCreatedEventV0_1_0(SpecVersion)
 }
@@ -465,6 +663,24 @@ var TicketCreatedEventType = api.TicketCreatedEventTypeV0_1_0
 type TicketUpdatedEvent = api.TicketUpdatedEventV0_1_0
 type TicketUpdatedSubject = api.TicketUpdatedSubjectV0_1_0
 
+// Values defined for a Priority field in the content, which also accepts free text
+
+const (
+	TicketUpdatedSubjectContentPriorityLow    = api.TicketUpdatedSubjectContentPriorityLowV0_1_0
+	TicketUpdatedSubjectContentPriorityMedium = api.TicketUpdatedSubjectContentPriorityMediumV0_1_0
+	TicketUpdatedSubjectContentPriorityHigh   = api.TicketUpdatedSubjectContentPriorityHighV0_1_0
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+
+const (
+	TicketUpdatedSubjectContentTicketTypeBug         = api.TicketUpdatedSubjectContentTicketTypeBugV0_1_0
+	TicketUpdatedSubjectContentTicketTypeEnhancement = api.TicketUpdatedSubjectContentTicketTypeEnhancementV0_1_0
+	TicketUpdatedSubjectContentTicketTypeIncident    = api.TicketUpdatedSubjectContentTicketTypeIncidentV0_1_0
+	TicketUpdatedSubjectContentTicketTypeTask        = api.TicketUpdatedSubjectContentTicketTypeTaskV0_1_0
+	TicketUpdatedSubjectContentTicketTypeQuestion    = api.TicketUpdatedSubjectContentTicketTypeQuestionV0_1_0
+)
+
 func NewTicketUpdatedEvent() (*TicketUpdatedEvent, error) {
 	return api.NewTicketUpdatedEventV0_1_0(SpecVersion)
 }
@@ -492,3 +708,11 @@ func NewFromJsonBytes(event []byte) (api.CDEventV04, error) {
 func NewFromJsonString(event string) (api.CDEventV04, error) {
 	return NewFromJsonBytes([]byte(event))
 }
+
+// NewFromCloudEvent builds a new CDEventReader from the payload of a CloudEvent.
+// Context fields missing from the payload are restored from the CloudEvents
+// extension attributes set by api.WithContextExtensions, and the CloudEvent
+// attributes are checked against the payload.
+func NewFromCloudEvent(event cloudevents.Event) (api.CDEventV04, error) {
+	return api.NewFromCloudEventContext[api.CDEventV04](event, CDEventsByUnversionedTypes)
+}
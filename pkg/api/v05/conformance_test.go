@@ -44,7 +44,7 @@ var (
 	testChangeSource      = "my-git.example/an-org/a-repo"
 	testPipeline          = "myPipeline"
 	testSubjecturl        = "https://www.example.com/mySubject123"
-	testPipelineOutcome   = "failure"
+	testPipelineOutcome   = apiv05.PipelineRunFinishedSubjectContentOutcomeFailure
 	testPipelineErrors    = "Something went wrong\nWith some more details"
 	testTaskName          = "myTask"
 	testTaskOutcome       = "failure"
@@ -78,11 +78,11 @@ var (
 		Type: "schedule"}
 	testTestTriggerStarted = &apiv05.TestCaseRunStartedSubjectContentTrigger{
 		Type: "schedule"}
-	testTestOutcome             = "success"
+	testTestOutcome             = apiv05.TestCaseRunFinishedSubjectContentOutcomeSuccess
 	testTestOutputSubjectId     = "testrunreport-12123"
 	testTestOutputSubjectSource = "/event/source/testrunreport-12123"
 	testTestOutputFormat        = "video/quicktime"
-	testTestOutputOutputType    = "video"
+	testTestOutputOutputType    = apiv05.TestOutputPublishedSubjectContentOutputTypeVideo
 	testTestCaseRun             = &api.Reference{Id: testTestRunId, Source: "testkube-dev-123"}
 	testTestSuiteRunId          = "myTestSuiteRun123"
 	testTestSuiteStarted        = &apiv05.TestSuiteRunStartedSubjectContentTestSuite{
@@ -91,9 +91,9 @@ var (
 		Id: "92834723894", Name: "Auth TestSuite", Version: "1.0"}
 	testTestSuiteFinished = &apiv05.TestSuiteRunFinishedSubjectContentTestSuite{
 		Id: "92834723894", Name: "Auth TestSuite", Version: "1.0"}
-	testTestSuiteOutcome        = "failure"
+	testTestSuiteOutcome        = apiv05.TestSuiteRunFinishedSubjectContentOutcomeFailure
 	testTestSuiteReason         = "Host 123.34.23.32 not found"
-	testTestSuiteSeverity       = "critical"
+	testTestSuiteSeverity       = apiv05.TestSuiteRunFinishedSubjectContentSeverityCritical
 	testTestSuiteTriggerQueued  = &apiv05.TestSuiteRunQueuedSubjectContentTrigger{Type: "pipeline"}
 	testTestSuiteTriggerStarted = &apiv05.TestSuiteRunStartedSubjectContentTrigger{Type: "pipeline"}
 	testSubjectUser             = "mybot-myapp"
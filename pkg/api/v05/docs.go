@@ -23,7 +23,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package v05
 
-import "github.com/cdevents/sdk-go/pkg/api"
+import (
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
 
 var SpecVersion = "0.5.1"
 
@@ -225,6 +228,16 @@ var IncidentResolvedEventType = api.IncidentResolvedEventTypeV0_3_0
 type PipelineRunFinishedEvent = api.PipelineRunFinishedEventV0_3_0
 type PipelineRunFinishedSubject = api.PipelineRunFinishedSubjectV0_3_0
 
+// PipelineRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type PipelineRunFinishedSubjectContentOutcome = api.PipelineRunFinishedSubjectContentOutcomeV0_3_0
+
+const (
+	PipelineRunFinishedSubjectContentOutcomeSuccess = api.PipelineRunFinishedSubjectContentOutcomeSuccessV0_3_0
+	PipelineRunFinishedSubjectContentOutcomeFailure = api.PipelineRunFinishedSubjectContentOutcomeFailureV0_3_0
+	PipelineRunFinishedSubjectContentOutcomeCancel  = api.PipelineRunFinishedSubjectContentOutcomeCancelV0_3_0
+	PipelineRunFinishedSubjectContentOutcomeError   = api.PipelineRunFinishedSubjectContentOutcomeErrorV0_3_0
+)
+
 func NewPipelineRunFinishedEvent() (*PipelineRunFinishedEvent, error) {
 	return api.NewPipelineRunFinishedEventV0_3_0(SpecVersion)
 }
@@ -345,6 +358,40 @@ type TestCaseRunFinishedSubject = api.TestCaseRunFinishedSubjectV0_3_0
 // TestCaseRunTestCaseRunSubjectContentTestCaseV0_3_0 holds the content of a TestCase field in the content
 type TestCaseRunFinishedSubjectContentTestCase = api.TestCaseRunFinishedSubjectContentTestCaseV0_3_0
 
+// TestCaseRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type TestCaseRunFinishedSubjectContentOutcome = api.TestCaseRunFinishedSubjectContentOutcomeV0_3_0
+
+const (
+	TestCaseRunFinishedSubjectContentOutcomeSuccess = api.TestCaseRunFinishedSubjectContentOutcomeSuccessV0_3_0
+	TestCaseRunFinishedSubjectContentOutcomeFailure = api.TestCaseRunFinishedSubjectContentOutcomeFailureV0_3_0
+	TestCaseRunFinishedSubjectContentOutcomeCancel  = api.TestCaseRunFinishedSubjectContentOutcomeCancelV0_3_0
+	TestCaseRunFinishedSubjectContentOutcomeError   = api.TestCaseRunFinishedSubjectContentOutcomeErrorV0_3_0
+)
+
+// TestCaseRunFinishedSubjectContentSeverity holds the values defined for a Severity field in the content
+type TestCaseRunFinishedSubjectContentSeverity = api.TestCaseRunFinishedSubjectContentSeverityV0_3_0
+
+const (
+	TestCaseRunFinishedSubjectContentSeverityLow      = api.TestCaseRunFinishedSubjectContentSeverityLowV0_3_0
+	TestCaseRunFinishedSubjectContentSeverityMedium   = api.TestCaseRunFinishedSubjectContentSeverityMediumV0_3_0
+	TestCaseRunFinishedSubjectContentSeverityHigh     = api.TestCaseRunFinishedSubjectContentSeverityHighV0_3_0
+	TestCaseRunFinishedSubjectContentSeverityCritical = api.TestCaseRunFinishedSubjectContentSeverityCriticalV0_3_0
+)
+
+// TestCaseRunFinishedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunFinishedSubjectContentTestCaseType = api.TestCaseRunFinishedSubjectContentTestCaseTypeV0_3_0
+
+const (
+	TestCaseRunFinishedSubjectContentTestCaseTypePerformance = api.TestCaseRunFinishedSubjectContentTestCaseTypePerformanceV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunFinishedSubjectContentTestCaseTypeFunctionalV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeUnit        = api.TestCaseRunFinishedSubjectContentTestCaseTypeUnitV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunFinishedSubjectContentTestCaseTypeSecurityV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunFinishedSubjectContentTestCaseTypeComplianceV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeIntegration = api.TestCaseRunFinishedSubjectContentTestCaseTypeIntegrationV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeE2e         = api.TestCaseRunFinishedSubjectContentTestCaseTypeE2eV0_3_0
+	TestCaseRunFinishedSubjectContentTestCaseTypeOther       = api.TestCaseRunFinishedSubjectContentTestCaseTypeOtherV0_3_0
+)
+
 func NewTestCaseRunFinishedEvent() (*TestCaseRunFinishedEvent, error) {
 	return api.NewTestCaseRunFinishedEventV0_3_0(SpecVersion)
 }
@@ -360,6 +407,31 @@ type TestCaseRunQueuedSubjectContentTestCase = api.TestCaseRunQueuedSubjectConte
 // TestCaseRunTestCaseRunSubjectContentTriggerV0_3_0 holds the content of a Trigger field in the content
 type TestCaseRunQueuedSubjectContentTrigger = api.TestCaseRunQueuedSubjectContentTriggerV0_3_0
 
+// TestCaseRunQueuedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunQueuedSubjectContentTestCaseType = api.TestCaseRunQueuedSubjectContentTestCaseTypeV0_3_0
+
+const (
+	TestCaseRunQueuedSubjectContentTestCaseTypePerformance = api.TestCaseRunQueuedSubjectContentTestCaseTypePerformanceV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunQueuedSubjectContentTestCaseTypeFunctionalV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeUnit        = api.TestCaseRunQueuedSubjectContentTestCaseTypeUnitV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunQueuedSubjectContentTestCaseTypeSecurityV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunQueuedSubjectContentTestCaseTypeComplianceV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeIntegration = api.TestCaseRunQueuedSubjectContentTestCaseTypeIntegrationV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeE2e         = api.TestCaseRunQueuedSubjectContentTestCaseTypeE2eV0_3_0
+	TestCaseRunQueuedSubjectContentTestCaseTypeOther       = api.TestCaseRunQueuedSubjectContentTestCaseTypeOtherV0_3_0
+)
+
+// TestCaseRunQueuedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestCaseRunQueuedSubjectContentTriggerType = api.TestCaseRunQueuedSubjectContentTriggerTypeV0_3_0
+
+const (
+	TestCaseRunQueuedSubjectContentTriggerTypeManual   = api.TestCaseRunQueuedSubjectContentTriggerTypeManualV0_3_0
+	TestCaseRunQueuedSubjectContentTriggerTypePipeline = api.TestCaseRunQueuedSubjectContentTriggerTypePipelineV0_3_0
+	TestCaseRunQueuedSubjectContentTriggerTypeEvent    = api.TestCaseRunQueuedSubjectContentTriggerTypeEventV0_3_0
+	TestCaseRunQueuedSubjectContentTriggerTypeSchedule = api.TestCaseRunQueuedSubjectContentTriggerTypeScheduleV0_3_0
+	TestCaseRunQueuedSubjectContentTriggerTypeOther    = api.TestCaseRunQueuedSubjectContentTriggerTypeOtherV0_3_0
+)
+
 func NewTestCaseRunQueuedEvent() (*TestCaseRunQueuedEvent, error) {
 	return api.NewTestCaseRunQueuedEventV0_3_0(SpecVersion)
 }
@@ -372,6 +444,20 @@ type TestCaseRunSkippedSubject = api.TestCaseRunSkippedSubjectV0_2_0
 // TestCaseRunTestCaseRunSubjectContentTestCaseV0_2_0 holds the content of a TestCase field in the content
 type TestCaseRunSkippedSubjectContentTestCase = api.TestCaseRunSkippedSubjectContentTestCaseV0_2_0
 
+// TestCaseRunSkippedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunSkippedSubjectContentTestCaseType = api.TestCaseRunSkippedSubjectContentTestCaseTypeV0_2_0
+
+const (
+	TestCaseRunSkippedSubjectContentTestCaseTypePerformance = api.TestCaseRunSkippedSubjectContentTestCaseTypePerformanceV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunSkippedSubjectContentTestCaseTypeFunctionalV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeUnit        = api.TestCaseRunSkippedSubjectContentTestCaseTypeUnitV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunSkippedSubjectContentTestCaseTypeSecurityV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunSkippedSubjectContentTestCaseTypeComplianceV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeIntegration = api.TestCaseRunSkippedSubjectContentTestCaseTypeIntegrationV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeE2e         = api.TestCaseRunSkippedSubjectContentTestCaseTypeE2eV0_2_0
+	TestCaseRunSkippedSubjectContentTestCaseTypeOther       = api.TestCaseRunSkippedSubjectContentTestCaseTypeOtherV0_2_0
+)
+
 func NewTestCaseRunSkippedEvent() (*TestCaseRunSkippedEvent, error) {
 	return api.NewTestCaseRunSkippedEventV0_2_0(SpecVersion)
 }
@@ -387,6 +473,31 @@ type TestCaseRunStartedSubjectContentTestCase = api.TestCaseRunStartedSubjectCon
 // TestCaseRunTestCaseRunSubjectContentTriggerV0_3_0 holds the content of a Trigger field in the content
 type TestCaseRunStartedSubjectContentTrigger = api.TestCaseRunStartedSubjectContentTriggerV0_3_0
 
+// TestCaseRunStartedSubjectContentTestCaseType holds the values defined for a TestCaseType field in the content
+type TestCaseRunStartedSubjectContentTestCaseType = api.TestCaseRunStartedSubjectContentTestCaseTypeV0_3_0
+
+const (
+	TestCaseRunStartedSubjectContentTestCaseTypePerformance = api.TestCaseRunStartedSubjectContentTestCaseTypePerformanceV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeFunctional  = api.TestCaseRunStartedSubjectContentTestCaseTypeFunctionalV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeUnit        = api.TestCaseRunStartedSubjectContentTestCaseTypeUnitV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeSecurity    = api.TestCaseRunStartedSubjectContentTestCaseTypeSecurityV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeCompliance  = api.TestCaseRunStartedSubjectContentTestCaseTypeComplianceV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeIntegration = api.TestCaseRunStartedSubjectContentTestCaseTypeIntegrationV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeE2e         = api.TestCaseRunStartedSubjectContentTestCaseTypeE2eV0_3_0
+	TestCaseRunStartedSubjectContentTestCaseTypeOther       = api.TestCaseRunStartedSubjectContentTestCaseTypeOtherV0_3_0
+)
+
+// TestCaseRunStartedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestCaseRunStartedSubjectContentTriggerType = api.TestCaseRunStartedSubjectContentTriggerTypeV0_3_0
+
+const (
+	TestCaseRunStartedSubjectContentTriggerTypeManual   = api.TestCaseRunStartedSubjectContentTriggerTypeManualV0_3_0
+	TestCaseRunStartedSubjectContentTriggerTypePipeline = api.TestCaseRunStartedSubjectContentTriggerTypePipelineV0_3_0
+	TestCaseRunStartedSubjectContentTriggerTypeEvent    = api.TestCaseRunStartedSubjectContentTriggerTypeEventV0_3_0
+	TestCaseRunStartedSubjectContentTriggerTypeSchedule = api.TestCaseRunStartedSubjectContentTriggerTypeScheduleV0_3_0
+	TestCaseRunStartedSubjectContentTriggerTypeOther    = api.TestCaseRunStartedSubjectContentTriggerTypeOtherV0_3_0
+)
+
 func NewTestCaseRunStartedEvent() (*TestCaseRunStartedEvent, error) {
 	return api.NewTestCaseRunStartedEventV0_3_0(SpecVersion)
 }
@@ -396,6 +507,17 @@ var TestCaseRunStartedEventType = api.TestCaseRunStartedEventTypeV0_3_0
 type TestOutputPublishedEvent = api.TestOutputPublishedEventV0_3_0
 type TestOutputPublishedSubject = api.TestOutputPublishedSubjectV0_3_0
 
+// TestOutputPublishedSubjectContentOutputType holds the values defined for an OutputType field in the content
+type TestOutputPublishedSubjectContentOutputType = api.TestOutputPublishedSubjectContentOutputTypeV0_3_0
+
+const (
+	TestOutputPublishedSubjectContentOutputTypeReport = api.TestOutputPublishedSubjectContentOutputTypeReportV0_3_0
+	TestOutputPublishedSubjectContentOutputTypeVideo  = api.TestOutputPublishedSubjectContentOutputTypeVideoV0_3_0
+	TestOutputPublishedSubjectContentOutputTypeImage  = api.TestOutputPublishedSubjectContentOutputTypeImageV0_3_0
+	TestOutputPublishedSubjectContentOutputTypeLog    = api.TestOutputPublishedSubjectContentOutputTypeLogV0_3_0
+	TestOutputPublishedSubjectContentOutputTypeOther  = api.TestOutputPublishedSubjectContentOutputTypeOtherV0_3_0
+)
+
 func NewTestOutputPublishedEvent() (*TestOutputPublishedEvent, error) {
 	return api.NewTestOutputPublishedEventV0_3_0(SpecVersion)
 }
@@ -408,6 +530,26 @@ type TestSuiteRunFinishedSubject = api.TestSuiteRunFinishedSubjectV0_3_0
 // TestSuiteRunTestSuiteRunSubjectContentTestSuiteV0_3_0 holds the content of a TestSuite field in the content
 type TestSuiteRunFinishedSubjectContentTestSuite = api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0
 
+// TestSuiteRunFinishedSubjectContentOutcome holds the values defined for an Outcome field in the content
+type TestSuiteRunFinishedSubjectContentOutcome = api.TestSuiteRunFinishedSubjectContentOutcomeV0_3_0
+
+const (
+	TestSuiteRunFinishedSubjectContentOutcomeSuccess = api.TestSuiteRunFinishedSubjectContentOutcomeSuccessV0_3_0
+	TestSuiteRunFinishedSubjectContentOutcomeFailure = api.TestSuiteRunFinishedSubjectContentOutcomeFailureV0_3_0
+	TestSuiteRunFinishedSubjectContentOutcomeCancel  = api.TestSuiteRunFinishedSubjectContentOutcomeCancelV0_3_0
+	TestSuiteRunFinishedSubjectContentOutcomeError   = api.TestSuiteRunFinishedSubjectContentOutcomeErrorV0_3_0
+)
+
+// TestSuiteRunFinishedSubjectContentSeverity holds the values defined for a Severity field in the content
+type TestSuiteRunFinishedSubjectContentSeverity = api.TestSuiteRunFinishedSubjectContentSeverityV0_3_0
+
+const (
+	TestSuiteRunFinishedSubjectContentSeverityLow      = api.TestSuiteRunFinishedSubjectContentSeverityLowV0_3_0
+	TestSuiteRunFinishedSubjectContentSeverityMedium   = api.TestSuiteRunFinishedSubjectContentSeverityMediumV0_3_0
+	TestSuiteRunFinishedSubjectContentSeverityHigh     = api.TestSuiteRunFinishedSubjectContentSeverityHighV0_3_0
+	TestSuiteRunFinishedSubjectContentSeverityCritical = api.TestSuiteRunFinishedSubjectContentSeverityCriticalV0_3_0
+)
+
 func NewTestSuiteRunFinishedEvent() (*TestSuiteRunFinishedEvent, error) {
 	return api.NewTestSuiteRunFinishedEventV0_3_0(SpecVersion)
 }
@@ -423,6 +565,17 @@ type TestSuiteRunQueuedSubjectContentTestSuite = api.TestSuiteRunQueuedSubjectCo
 // TestSuiteRunTestSuiteRunSubjectContentTriggerV0_3_0 holds the content of a Trigger field in the content
 type TestSuiteRunQueuedSubjectContentTrigger = api.TestSuiteRunQueuedSubjectContentTriggerV0_3_0
 
+// TestSuiteRunQueuedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestSuiteRunQueuedSubjectContentTriggerType = api.TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0
+
+const (
+	TestSuiteRunQueuedSubjectContentTriggerTypeManual   = api.TestSuiteRunQueuedSubjectContentTriggerTypeManualV0_3_0
+	TestSuiteRunQueuedSubjectContentTriggerTypePipeline = api.TestSuiteRunQueuedSubjectContentTriggerTypePipelineV0_3_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeEvent    = api.TestSuiteRunQueuedSubjectContentTriggerTypeEventV0_3_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeSchedule = api.TestSuiteRunQueuedSubjectContentTriggerTypeScheduleV0_3_0
+	TestSuiteRunQueuedSubjectContentTriggerTypeOther    = api.TestSuiteRunQueuedSubjectContentTriggerTypeOtherV0_3_0
+)
+
 func NewTestSuiteRunQueuedEvent() (*TestSuiteRunQueuedEvent, error) {
 	return api.NewTestSuiteRunQueuedEventV0_3_0(SpecVersion)
 }
@@ -438,6 +591,17 @@ type TestSuiteRunStartedSubjectContentTestSuite = api.TestSuiteRunStartedSubject
 // TestSuiteRunTestSuiteRunSubjectContentTriggerV0_3_0 holds the content of a Trigger field in the content
 type TestSuiteRunStartedSubjectContentTrigger = api.TestSuiteRunStartedSubjectContentTriggerV0_3_0
 
+// TestSuiteRunStartedSubjectContentTriggerType holds the values defined for a TriggerType field in the content
+type TestSuiteRunStartedSubjectContentTriggerType = api.TestSuiteRunStartedSubjectContentTriggerTypeV0_3_0
+
+const (
+	TestSuiteRunStartedSubjectContentTriggerTypeManual   = api.TestSuiteRunStartedSubjectContentTriggerTypeManualV0_3_0
+	TestSuiteRunStartedSubjectContentTriggerTypePipeline = api.TestSuiteRunStartedSubjectContentTriggerTypePipelineV0_3_0
+	TestSuiteRunStartedSubjectContentTriggerTypeEvent    = api.TestSuiteRunStartedSubjectContentTriggerTypeEventV0_3_0
+	TestSuiteRunStartedSubjectContentTriggerTypeSchedule = api.TestSuiteRunStartedSubjectContentTriggerTypeScheduleV0_3_0
+	TestSuiteRunStartedSubjectContentTriggerTypeOther    = api.TestSuiteRunStartedSubjectContentTriggerTypeOtherV0_3_0
+)
+
 func NewTestSuiteRunStartedEvent() (*TestSuiteRunStartedEvent, error) {
 	return api.NewTestSuiteRunStartedEventV0_3_0(SpecVersion)
 }
@@ -447,6 +611,32 @@ var TestSuiteRunStartedEventType = api.TestSuiteRunStartedEventTypeV0_3_0
 type TicketClosedEvent = api.TicketClosedEventV0_2_0
 type TicketClosedSubject = api.TicketClosedSubjectV0_2_0
 
+// Values defined for a Priority field in the content, which also accepts free text
+
+const (
+	TicketClosedSubjectContentPriorityLow    = api.TicketClosedSubjectContentPriorityLowV0_2_0
+	TicketClosedSubjectContentPriorityMedium = api.TicketClosedSubjectContentPriorityMediumV0_2_0
+	TicketClosedSubjectContentPriorityHigh   = api.TicketClosedSubjectContentPriorityHighV0_2_0
+)
+
+// Values defined for a Resolution field in the content, which also accepts free text
+
+const (
+	TicketClosedSubjectContentResolutionCompleted = api.TicketClosedSubjectContentResolutionCompletedV0_2_0
+	TicketClosedSubjectContentResolutionWithdrawn = api.TicketClosedSubjectContentResolutionWithdrawnV0_2_0
+	TicketClosedSubjectContentResolutionDuplicate = api.TicketClosedSubjectContentResolutionDuplicateV0_2_0
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+
+const (
+	TicketClosedSubjectContentTicketTypeBug         = api.TicketClosedSubjectContentTicketTypeBugV0_2_0
+	TicketClosedSubjectContentTicketTypeEnhancement = api.TicketClosedSubjectContentTicketTypeEnhancementV0_2_0
+	TicketClosedSubjectContentTicketTypeIncident    = api.TicketClosedSubjectContentTicketTypeIncidentV0_2_0
+	TicketClosedSubjectContentTicketTypeTask        = api.TicketClosedSubjectContentTicketTypeTaskV0_2_0
+	TicketClosedSubjectContentTicketTypeQuestion    = api.TicketClosedSubjectContentTicketTypeQuestionV0_2_0
+)
+
 func NewTicketClosedEvent() (*TicketClosedEvent, error) {
 	return api.NewTicketClosedEventV0_2_0(SpecVersion)
 }
@@ -456,6 +646,24 @@ var TicketClosedEventType = api.TicketClosedEventTypeV0_2_0
 type TicketCreatedEvent = api.TicketCreatedEventV0_2_0
 type TicketCreatedSubject = api.TicketCreatedSubjectV0_2_0
 
+// Values defined for a Priority field in the content, which also accepts free text
+
+const (
+	TicketCreatedSubjectContentPriorityLow    = api.TicketCreatedSubjectContentPriorityLowV0_2_0
+	TicketCreatedSubjectContentPriorityMedium = api.TicketCreatedSubjectContentPriorityMediumV0_2_0
+	TicketCreatedSubjectContentPriorityHigh   = api.TicketCreatedSubjectContentPriorityHighV0_2_0
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+
+const (
+	TicketCreatedSubjectContentTicketTypeBug         = api.TicketCreatedSubjectContentTicketTypeBugV0_2_0
+	TicketCreatedSubjectContentTicketTypeEnhancement = api.TicketCreatedSubjectContentTicketTypeEnhancementV0_2_0
+	TicketCreatedSubjectContentTicketTypeIncident    = api.TicketCreatedSubjectContentTicketTypeIncidentV0_2_0
+	TicketCreatedSubjectContentTicketTypeTask        = api.TicketCreatedSubjectContentTicketTypeTaskV0_2_0
+	TicketCreatedSubjectContentTicketTypeQuestion    = api.TicketCreatedSubjectContentTicketTypeQuestionV0_2_0
+)
+
 func NewTicketCreatedEvent() (*TicketCreatedEvent, error) {
 	return api.NewTicketCreatedEventV0_2_0(SpecVersion)
 }
@@ -465,6 +673,24 @@ var TicketCreatedEventType = api.TicketCreatedEventTypeV0_2_0
 type TicketUpdatedEvent = api.TicketUpdatedEventV0_2_0
 type TicketUpdatedSubject = api.TicketUpdatedSubjectV0_2_0
 
+// Values defined for a Priority field in the content, which also accepts free text
+
+const (
+	TicketUpdatedSubjectContentPriorityLow    = api.TicketUpdatedSubjectContentPriorityLowV0_2_0
+	TicketUpdatedSubjectContentPriorityMedium = api.TicketUpdatedSubjectContentPriorityMediumV0_2_0
+	TicketUpdatedSubjectContentPriorityHigh   = api.TicketUpdatedSubjectContentPriorityHighV0_2_0
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+
+const (
+	TicketUpdatedSubjectContentTicketTypeBug         = api.TicketUpdatedSubjectContentTicketTypeBugV0_2_0
+	TicketUpdatedSubjectContentTicketTypeEnhancement = api.TicketUpdatedSubjectContentTicketTypeEnhancementV0_2_0
+	TicketUpdatedSubjectContentTicketTypeIncident    = api.TicketUpdatedSubjectContentTicketTypeIncidentV0_2_0
+	TicketUpdatedSubjectContentTicketTypeTask        = api.TicketUpdatedSubjectContentTicketTypeTaskV0_2_0
+	TicketUpdatedSubjectContentTicketTypeQuestion    = api.TicketUpdatedSubjectContentTicketTypeQuestionV0_2_0
+)
+
 func NewTicketUpdatedEvent() (*TicketUpdatedEvent, error) {
 	return api.NewTicketUpdatedEventV0_2_0(SpecVersion)
 }
@@ -492,3 +718,11 @@ func NewFromJsonBytes(event []byte) (api.CDEventV04, error) {
 func NewFromJsonString(event string) (api.CDEventV04, error) {
 	return NewFromJsonBytes([]byte(event))
 }
+
+// NewFromCloudEvent builds a new CDEventReader from the payload of a CloudEvent.
+// Context fields missing from the payload are restored from the CloudEvents
+// extension attributes set by api.WithContextExtensions, and the CloudEvent
+// attributes are checked against the payload.
+func NewFromCloudEvent(event cloudevents.Event) (api.CDEventV04, error) {
+	return api.NewFromCloudEventContext[api.CDEventV04](event, CDEventsByUnversionedTypes)
+}
@@ -25,7 +25,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package v990
 
-import "github.com/cdevents/sdk-go/pkg/api"
+import (
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
 
 var SpecVersion = "99.0.0"
 
@@ -53,3 +56,11 @@ func NewFromJsonBytes(event []byte) (api.CDEventV04, error) {
 func NewFromJsonString(event string) (api.CDEventV04, error) {
 	return NewFromJsonBytes([]byte(event))
 }
+
+// NewFromCloudEvent builds a new CDEventReader from the payload of a CloudEvent.
+// Context fields missing from the payload are restored from the CloudEvents
+// extension attributes set by api.WithContextExtensions, and the CloudEvent
+// attributes are checked against the payload.
+func NewFromCloudEvent(event cloudevents.Event) (api.CDEventV04, error) {
+	return api.NewFromCloudEventContext[api.CDEventV04](event, CDEventsByUnversionedTypes)
+}
@@ -25,7 +25,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package v991
 
-import "github.com/cdevents/sdk-go/pkg/api"
+import (
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
 
 var SpecVersion = "99.1.0"
 
@@ -35,6 +38,14 @@ type FooSubjectBarPredicateSubject = api.FooSubjectBarPredicateSubjectV2_2_3
 // FooSubjectFooSubjectSubjectContentObjectFieldV2_2_3 holds the content of a ObjectField field in the content
 type FooSubjectBarPredicateSubjectContentObjectField = api.FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3
 
+// FooSubjectBarPredicateSubjectContentMode holds the values defined for a Mode field in the content
+type FooSubjectBarPredicateSubjectContentMode = api.FooSubjectBarPredicateSubjectContentModeV2_2_3
+
+const (
+	FooSubjectBarPredicateSubjectContentModeDryRun = api.FooSubjectBarPredicateSubjectContentModeDryRunV2_2_3
+	FooSubjectBarPredicateSubjectContentModeApply  = api.FooSubjectBarPredicateSubjectContentModeApplyV2_2_3
+)
+
 func NewFooSubjectBarPredicateEvent() (*FooSubjectBarPredicateEvent, error) {
 	return api.NewFooSubjectBarPredicateEventV2_2_3(SpecVersion)
 }
@@ -53,3 +64,11 @@ func NewFromJsonBytes(event []byte) (api.CDEventV04, error) {
 func NewFromJsonString(event string) (api.CDEventV04, error) {
 	return NewFromJsonBytes([]byte(event))
 }
+
+// NewFromCloudEvent builds a new CDEventReader from the payload of a CloudEvent.
+// Context fields missing from the payload are restored from the CloudEvents
+// extension attributes set by api.WithContextExtensions, and the CloudEvent
+// attributes are checked against the payload.
+func NewFromCloudEvent(event cloudevents.Event) (api.CDEventV04, error) {
+	return api.NewFromCloudEventContext[api.CDEventV04](event, CDEventsByUnversionedTypes)
+}
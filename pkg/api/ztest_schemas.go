@@ -279,6 +279,13 @@ var (
 			  "artifactId": {
 				"type": "string"
 			  },
+			  "mode": {
+				"type": "string",
+				"enum": [
+				  "dry run",
+				  "apply"
+				]
+			  },
 			  "objectField": {
 				"properties": {
 				  "required": {
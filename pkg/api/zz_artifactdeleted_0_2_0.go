@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *ArtifactDeletedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactDeletedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,79 @@ func (e *ArtifactDeletedEventV0_2_0) SetSubjectUser(user string) {
 	e.Subject.Content.User = user
 }
 
+// Get subject custom fields
+
+func (e ArtifactDeletedEventV0_2_0) GetSubjectUser() string {
+	return e.Subject.Content.User
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactDeletedEventV0_2_0) DeepCopyInto(out *ArtifactDeletedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactDeletedEventV0_2_0.
+func (in *ArtifactDeletedEventV0_2_0) DeepCopy() *ArtifactDeletedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactDeletedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactDeletedEventV0_2_0) Equal(other *ArtifactDeletedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactDeletedSubjectV0_2_0) DeepCopyInto(out *ArtifactDeletedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactDeletedSubjectV0_2_0.
+func (in *ArtifactDeletedSubjectV0_2_0) DeepCopy() *ArtifactDeletedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactDeletedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactDeletedSubjectContentV0_2_0) DeepCopyInto(out *ArtifactDeletedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactDeletedSubjectContentV0_2_0.
+func (in *ArtifactDeletedSubjectContentV0_2_0) DeepCopy() *ArtifactDeletedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactDeletedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactDeletedSubjectContentV0_2_0) Equal(other *ArtifactDeletedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactDeletedEventV0_2_0
 func NewArtifactDeletedEventV0_2_0(specVersion string) (*ArtifactDeletedEventV0_2_0, error) {
 	e := &ArtifactDeletedEventV0_2_0{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *ArtifactDownloadedEventV0_1_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactDownloadedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,79 @@ func (e *ArtifactDownloadedEventV0_1_0) SetSubjectUser(user string) {
 	e.Subject.Content.User = user
 }
 
+// Get subject custom fields
+
+func (e ArtifactDownloadedEventV0_1_0) GetSubjectUser() string {
+	return e.Subject.Content.User
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactDownloadedEventV0_1_0) DeepCopyInto(out *ArtifactDownloadedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactDownloadedEventV0_1_0.
+func (in *ArtifactDownloadedEventV0_1_0) DeepCopy() *ArtifactDownloadedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactDownloadedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactDownloadedEventV0_1_0) Equal(other *ArtifactDownloadedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactDownloadedSubjectV0_1_0) DeepCopyInto(out *ArtifactDownloadedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactDownloadedSubjectV0_1_0.
+func (in *ArtifactDownloadedSubjectV0_1_0) DeepCopy() *ArtifactDownloadedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactDownloadedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactDownloadedSubjectContentV0_1_0) DeepCopyInto(out *ArtifactDownloadedSubjectContentV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactDownloadedSubjectContentV0_1_0.
+func (in *ArtifactDownloadedSubjectContentV0_1_0) DeepCopy() *ArtifactDownloadedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactDownloadedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactDownloadedSubjectContentV0_1_0) Equal(other *ArtifactDownloadedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactDownloadedEventV0_1_0
 func NewArtifactDownloadedEventV0_1_0(specVersion string) (*ArtifactDownloadedEventV0_1_0, error) {
 	e := &ArtifactDownloadedEventV0_1_0{
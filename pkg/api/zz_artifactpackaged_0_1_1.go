@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -131,11 +132,11 @@ func (e *ArtifactPackagedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactPackagedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -155,6 +156,84 @@ func (e *ArtifactPackagedEventV0_1_1) SetSubjectChange(change *Reference) {
 	e.Subject.Content.Change = change
 }
 
+// Get subject custom fields
+
+func (e ArtifactPackagedEventV0_1_1) GetSubjectChange() *Reference {
+	return e.Subject.Content.Change
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedEventV0_1_1) DeepCopyInto(out *ArtifactPackagedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedEventV0_1_1.
+func (in *ArtifactPackagedEventV0_1_1) DeepCopy() *ArtifactPackagedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactPackagedEventV0_1_1) Equal(other *ArtifactPackagedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedSubjectV0_1_1) DeepCopyInto(out *ArtifactPackagedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedSubjectV0_1_1.
+func (in *ArtifactPackagedSubjectV0_1_1) DeepCopy() *ArtifactPackagedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedSubjectContentV0_1_1) DeepCopyInto(out *ArtifactPackagedSubjectContentV0_1_1) {
+	*out = *in
+	if in.Change != nil {
+		in, out := &in.Change, &out.Change
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedSubjectContentV0_1_1.
+func (in *ArtifactPackagedSubjectContentV0_1_1) DeepCopy() *ArtifactPackagedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactPackagedSubjectContentV0_1_1) Equal(other *ArtifactPackagedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactPackagedEventV0_1_1
 func NewArtifactPackagedEventV0_1_1(specVersion string) (*ArtifactPackagedEventV0_1_1, error) {
 	e := &ArtifactPackagedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *ArtifactPackagedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactPackagedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,93 @@ func (e *ArtifactPackagedEventV0_2_0) SetSubjectSbom(sbom *ArtifactPackagedSubje
 	e.Subject.Content.Sbom = sbom
 }
 
+// Get subject custom fields
+
+func (e ArtifactPackagedEventV0_2_0) GetSubjectChange() *Reference {
+	return e.Subject.Content.Change
+}
+
+func (e ArtifactPackagedEventV0_2_0) GetSubjectSbom() *ArtifactPackagedSubjectContentSbomV0_2_0 {
+	return e.Subject.Content.Sbom
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedEventV0_2_0) DeepCopyInto(out *ArtifactPackagedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedEventV0_2_0.
+func (in *ArtifactPackagedEventV0_2_0) DeepCopy() *ArtifactPackagedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactPackagedEventV0_2_0) Equal(other *ArtifactPackagedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedSubjectV0_2_0) DeepCopyInto(out *ArtifactPackagedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedSubjectV0_2_0.
+func (in *ArtifactPackagedSubjectV0_2_0) DeepCopy() *ArtifactPackagedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedSubjectContentV0_2_0) DeepCopyInto(out *ArtifactPackagedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Change != nil {
+		in, out := &in.Change, &out.Change
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sbom != nil {
+		in, out := &in.Sbom, &out.Sbom
+		*out = new(ArtifactPackagedSubjectContentSbomV0_2_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedSubjectContentV0_2_0.
+func (in *ArtifactPackagedSubjectContentV0_2_0) DeepCopy() *ArtifactPackagedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactPackagedSubjectContentV0_2_0) Equal(other *ArtifactPackagedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactPackagedEventV0_2_0
 func NewArtifactPackagedEventV0_2_0(specVersion string) (*ArtifactPackagedEventV0_2_0, error) {
 	e := &ArtifactPackagedEventV0_2_0{
@@ -234,3 +322,23 @@ func NewArtifactPackagedEventV0_2_0(specVersion string) (*ArtifactPackagedEventV
 type ArtifactPackagedSubjectContentSbomV0_2_0 struct {
 	Uri string `json:"uri"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPackagedSubjectContentSbomV0_2_0) DeepCopyInto(out *ArtifactPackagedSubjectContentSbomV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPackagedSubjectContentSbomV0_2_0.
+func (in *ArtifactPackagedSubjectContentSbomV0_2_0) DeepCopy() *ArtifactPackagedSubjectContentSbomV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPackagedSubjectContentSbomV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactPackagedSubjectContentSbomV0_2_0) Equal(other *ArtifactPackagedSubjectContentSbomV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -130,11 +131,11 @@ func (e *ArtifactPublishedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactPublishedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -148,6 +149,73 @@ func (e ArtifactPublishedEventV0_1_1) GetSubjectContent() interface{} {
 	return e.Subject.Content
 }
 
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedEventV0_1_1) DeepCopyInto(out *ArtifactPublishedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedEventV0_1_1.
+func (in *ArtifactPublishedEventV0_1_1) DeepCopy() *ArtifactPublishedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactPublishedEventV0_1_1) Equal(other *ArtifactPublishedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedSubjectV0_1_1) DeepCopyInto(out *ArtifactPublishedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedSubjectV0_1_1.
+func (in *ArtifactPublishedSubjectV0_1_1) DeepCopy() *ArtifactPublishedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedSubjectContentV0_1_1) DeepCopyInto(out *ArtifactPublishedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedSubjectContentV0_1_1.
+func (in *ArtifactPublishedSubjectContentV0_1_1) DeepCopy() *ArtifactPublishedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactPublishedSubjectContentV0_1_1) Equal(other *ArtifactPublishedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactPublishedEventV0_1_1
 func NewArtifactPublishedEventV0_1_1(specVersion string) (*ArtifactPublishedEventV0_1_1, error) {
 	e := &ArtifactPublishedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *ArtifactPublishedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactPublishedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,88 @@ func (e *ArtifactPublishedEventV0_2_0) SetSubjectUser(user string) {
 	e.Subject.Content.User = user
 }
 
+// Get subject custom fields
+
+func (e ArtifactPublishedEventV0_2_0) GetSubjectSbom() *ArtifactPublishedSubjectContentSbomV0_2_0 {
+	return e.Subject.Content.Sbom
+}
+
+func (e ArtifactPublishedEventV0_2_0) GetSubjectUser() string {
+	return e.Subject.Content.User
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedEventV0_2_0) DeepCopyInto(out *ArtifactPublishedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedEventV0_2_0.
+func (in *ArtifactPublishedEventV0_2_0) DeepCopy() *ArtifactPublishedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactPublishedEventV0_2_0) Equal(other *ArtifactPublishedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedSubjectV0_2_0) DeepCopyInto(out *ArtifactPublishedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedSubjectV0_2_0.
+func (in *ArtifactPublishedSubjectV0_2_0) DeepCopy() *ArtifactPublishedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedSubjectContentV0_2_0) DeepCopyInto(out *ArtifactPublishedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Sbom != nil {
+		in, out := &in.Sbom, &out.Sbom
+		*out = new(ArtifactPublishedSubjectContentSbomV0_2_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedSubjectContentV0_2_0.
+func (in *ArtifactPublishedSubjectContentV0_2_0) DeepCopy() *ArtifactPublishedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactPublishedSubjectContentV0_2_0) Equal(other *ArtifactPublishedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactPublishedEventV0_2_0
 func NewArtifactPublishedEventV0_2_0(specVersion string) (*ArtifactPublishedEventV0_2_0, error) {
 	e := &ArtifactPublishedEventV0_2_0{
@@ -234,3 +317,23 @@ func NewArtifactPublishedEventV0_2_0(specVersion string) (*ArtifactPublishedEven
 type ArtifactPublishedSubjectContentSbomV0_2_0 struct {
 	Uri string `json:"uri"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactPublishedSubjectContentSbomV0_2_0) DeepCopyInto(out *ArtifactPublishedSubjectContentSbomV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactPublishedSubjectContentSbomV0_2_0.
+func (in *ArtifactPublishedSubjectContentSbomV0_2_0) DeepCopy() *ArtifactPublishedSubjectContentSbomV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPublishedSubjectContentSbomV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactPublishedSubjectContentSbomV0_2_0) Equal(other *ArtifactPublishedSubjectContentSbomV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
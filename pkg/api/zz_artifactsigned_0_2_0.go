@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *ArtifactSignedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ArtifactSignedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,79 @@ func (e *ArtifactSignedEventV0_2_0) SetSubjectSignature(signature string) {
 	e.Subject.Content.Signature = signature
 }
 
+// Get subject custom fields
+
+func (e ArtifactSignedEventV0_2_0) GetSubjectSignature() string {
+	return e.Subject.Content.Signature
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactSignedEventV0_2_0) DeepCopyInto(out *ArtifactSignedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactSignedEventV0_2_0.
+func (in *ArtifactSignedEventV0_2_0) DeepCopy() *ArtifactSignedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactSignedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ArtifactSignedEventV0_2_0) Equal(other *ArtifactSignedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactSignedSubjectV0_2_0) DeepCopyInto(out *ArtifactSignedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactSignedSubjectV0_2_0.
+func (in *ArtifactSignedSubjectV0_2_0) DeepCopy() *ArtifactSignedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactSignedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ArtifactSignedSubjectContentV0_2_0) DeepCopyInto(out *ArtifactSignedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new ArtifactSignedSubjectContentV0_2_0.
+func (in *ArtifactSignedSubjectContentV0_2_0) DeepCopy() *ArtifactSignedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactSignedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ArtifactSignedSubjectContentV0_2_0) Equal(other *ArtifactSignedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ArtifactSignedEventV0_2_0
 func NewArtifactSignedEventV0_2_0(specVersion string) (*ArtifactSignedEventV0_2_0, error) {
 	e := &ArtifactSignedEventV0_2_0{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *BranchCreatedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *BranchCreatedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,84 @@ func (e *BranchCreatedEventV0_3_0) SetSubjectRepository(repository *Reference) {
 	e.Subject.Content.Repository = repository
 }
 
+// Get subject custom fields
+
+func (e BranchCreatedEventV0_3_0) GetSubjectRepository() *Reference {
+	return e.Subject.Content.Repository
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BranchCreatedEventV0_3_0) DeepCopyInto(out *BranchCreatedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new BranchCreatedEventV0_3_0.
+func (in *BranchCreatedEventV0_3_0) DeepCopy() *BranchCreatedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchCreatedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *BranchCreatedEventV0_3_0) Equal(other *BranchCreatedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BranchCreatedSubjectV0_3_0) DeepCopyInto(out *BranchCreatedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new BranchCreatedSubjectV0_3_0.
+func (in *BranchCreatedSubjectV0_3_0) DeepCopy() *BranchCreatedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchCreatedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BranchCreatedSubjectContentV0_3_0) DeepCopyInto(out *BranchCreatedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new BranchCreatedSubjectContentV0_3_0.
+func (in *BranchCreatedSubjectContentV0_3_0) DeepCopy() *BranchCreatedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchCreatedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *BranchCreatedSubjectContentV0_3_0) Equal(other *BranchCreatedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new BranchCreatedEventV0_3_0
 func NewBranchCreatedEventV0_3_0(specVersion string) (*BranchCreatedEventV0_3_0, error) {
 	e := &BranchCreatedEventV0_3_0{
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -131,11 +132,11 @@ func (e *BranchDeletedEventV0_1_2) SetSubjectSource(subjectSource string) {
 }
 
 func (e *BranchDeletedEventV0_1_2) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -155,6 +156,84 @@ func (e *BranchDeletedEventV0_1_2) SetSubjectRepository(repository *Reference) {
 	e.Subject.Content.Repository = repository
 }
 
+// Get subject custom fields
+
+func (e BranchDeletedEventV0_1_2) GetSubjectRepository() *Reference {
+	return e.Subject.Content.Repository
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BranchDeletedEventV0_1_2) DeepCopyInto(out *BranchDeletedEventV0_1_2) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new BranchDeletedEventV0_1_2.
+func (in *BranchDeletedEventV0_1_2) DeepCopy() *BranchDeletedEventV0_1_2 {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchDeletedEventV0_1_2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *BranchDeletedEventV0_1_2) Equal(other *BranchDeletedEventV0_1_2, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BranchDeletedSubjectV0_1_2) DeepCopyInto(out *BranchDeletedSubjectV0_1_2) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new BranchDeletedSubjectV0_1_2.
+func (in *BranchDeletedSubjectV0_1_2) DeepCopy() *BranchDeletedSubjectV0_1_2 {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchDeletedSubjectV0_1_2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BranchDeletedSubjectContentV0_1_2) DeepCopyInto(out *BranchDeletedSubjectContentV0_1_2) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new BranchDeletedSubjectContentV0_1_2.
+func (in *BranchDeletedSubjectContentV0_1_2) DeepCopy() *BranchDeletedSubjectContentV0_1_2 {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchDeletedSubjectContentV0_1_2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *BranchDeletedSubjectContentV0_1_2) Equal(other *BranchDeletedSubjectContentV0_1_2) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new BranchDeletedEventV0_1_2
 func NewBranchDeletedEventV0_1_2(specVersion string) (*BranchDeletedEventV0_1_2, error) {
 	e := &BranchDeletedEventV0_1_2{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -37,7 +38,7 @@ var (
 )
 
 type BuildFinishedSubjectContentV0_2_0 struct {
-	ArtifactId string `json:"artifactId,omitempty" validate:"purl"`
+	ArtifactId string `json:"artifactId,omitempty" validate:"omitempty,purl"`
 }
 
 type BuildFinishedSubjectV0_2_0 struct {
@@ -160,11 +161,11 @@ func (e *BuildFinishedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *BuildFinishedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,79 @@ func (e *BuildFinishedEventV0_2_0) SetSubjectArtifactId(artifactId string) {
 	e.Subject.Content.ArtifactId = artifactId
 }
 
+// Get subject custom fields
+
+func (e BuildFinishedEventV0_2_0) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BuildFinishedEventV0_2_0) DeepCopyInto(out *BuildFinishedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new BuildFinishedEventV0_2_0.
+func (in *BuildFinishedEventV0_2_0) DeepCopy() *BuildFinishedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildFinishedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *BuildFinishedEventV0_2_0) Equal(other *BuildFinishedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BuildFinishedSubjectV0_2_0) DeepCopyInto(out *BuildFinishedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new BuildFinishedSubjectV0_2_0.
+func (in *BuildFinishedSubjectV0_2_0) DeepCopy() *BuildFinishedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildFinishedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BuildFinishedSubjectContentV0_2_0) DeepCopyInto(out *BuildFinishedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new BuildFinishedSubjectContentV0_2_0.
+func (in *BuildFinishedSubjectContentV0_2_0) DeepCopy() *BuildFinishedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildFinishedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *BuildFinishedSubjectContentV0_2_0) Equal(other *BuildFinishedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new BuildFinishedEventV0_2_0
 func NewBuildFinishedEventV0_2_0(specVersion string) (*BuildFinishedEventV0_2_0, error) {
 	e := &BuildFinishedEventV0_2_0{
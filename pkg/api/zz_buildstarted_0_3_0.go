@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -159,11 +160,11 @@ func (e *BuildStartedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *BuildStartedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -191,6 +192,73 @@ func (e BuildStartedEventV0_3_0) GetSubjectContent() interface{} {
 	return e.Subject.Content
 }
 
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BuildStartedEventV0_3_0) DeepCopyInto(out *BuildStartedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new BuildStartedEventV0_3_0.
+func (in *BuildStartedEventV0_3_0) DeepCopy() *BuildStartedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildStartedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *BuildStartedEventV0_3_0) Equal(other *BuildStartedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BuildStartedSubjectV0_3_0) DeepCopyInto(out *BuildStartedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new BuildStartedSubjectV0_3_0.
+func (in *BuildStartedSubjectV0_3_0) DeepCopy() *BuildStartedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildStartedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *BuildStartedSubjectContentV0_3_0) DeepCopyInto(out *BuildStartedSubjectContentV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new BuildStartedSubjectContentV0_3_0.
+func (in *BuildStartedSubjectContentV0_3_0) DeepCopy() *BuildStartedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildStartedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *BuildStartedSubjectContentV0_3_0) Equal(other *BuildStartedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new BuildStartedEventV0_3_0
 func NewBuildStartedEventV0_3_0(specVersion string) (*BuildStartedEventV0_3_0, error) {
 	e := &BuildStartedEventV0_3_0{
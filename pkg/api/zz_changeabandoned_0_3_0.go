@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *ChangeAbandonedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ChangeAbandonedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,84 @@ func (e *ChangeAbandonedEventV0_3_0) SetSubjectRepository(repository *Reference)
 	e.Subject.Content.Repository = repository
 }
 
+// Get subject custom fields
+
+func (e ChangeAbandonedEventV0_3_0) GetSubjectRepository() *Reference {
+	return e.Subject.Content.Repository
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeAbandonedEventV0_3_0) DeepCopyInto(out *ChangeAbandonedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ChangeAbandonedEventV0_3_0.
+func (in *ChangeAbandonedEventV0_3_0) DeepCopy() *ChangeAbandonedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeAbandonedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ChangeAbandonedEventV0_3_0) Equal(other *ChangeAbandonedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeAbandonedSubjectV0_3_0) DeepCopyInto(out *ChangeAbandonedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ChangeAbandonedSubjectV0_3_0.
+func (in *ChangeAbandonedSubjectV0_3_0) DeepCopy() *ChangeAbandonedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeAbandonedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeAbandonedSubjectContentV0_3_0) DeepCopyInto(out *ChangeAbandonedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ChangeAbandonedSubjectContentV0_3_0.
+func (in *ChangeAbandonedSubjectContentV0_3_0) DeepCopy() *ChangeAbandonedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeAbandonedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ChangeAbandonedSubjectContentV0_3_0) Equal(other *ChangeAbandonedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ChangeAbandonedEventV0_3_0
 func NewChangeAbandonedEventV0_3_0(specVersion string) (*ChangeAbandonedEventV0_3_0, error) {
 	e := &ChangeAbandonedEventV0_3_0{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *ChangeCreatedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ChangeCreatedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,88 @@ func (e *ChangeCreatedEventV0_3_0) SetSubjectRepository(repository *Reference) {
 	e.Subject.Content.Repository = repository
 }
 
+// Get subject custom fields
+
+func (e ChangeCreatedEventV0_3_0) GetSubjectDescription() string {
+	return e.Subject.Content.Description
+}
+
+func (e ChangeCreatedEventV0_3_0) GetSubjectRepository() *Reference {
+	return e.Subject.Content.Repository
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeCreatedEventV0_3_0) DeepCopyInto(out *ChangeCreatedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ChangeCreatedEventV0_3_0.
+func (in *ChangeCreatedEventV0_3_0) DeepCopy() *ChangeCreatedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeCreatedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ChangeCreatedEventV0_3_0) Equal(other *ChangeCreatedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeCreatedSubjectV0_3_0) DeepCopyInto(out *ChangeCreatedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ChangeCreatedSubjectV0_3_0.
+func (in *ChangeCreatedSubjectV0_3_0) DeepCopy() *ChangeCreatedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeCreatedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeCreatedSubjectContentV0_3_0) DeepCopyInto(out *ChangeCreatedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ChangeCreatedSubjectContentV0_3_0.
+func (in *ChangeCreatedSubjectContentV0_3_0) DeepCopy() *ChangeCreatedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeCreatedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ChangeCreatedSubjectContentV0_3_0) Equal(other *ChangeCreatedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ChangeCreatedEventV0_3_0
 func NewChangeCreatedEventV0_3_0(specVersion string) (*ChangeCreatedEventV0_3_0, error) {
 	e := &ChangeCreatedEventV0_3_0{
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -131,11 +132,11 @@ func (e *ChangeReviewedEventV0_1_2) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ChangeReviewedEventV0_1_2) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -155,6 +156,84 @@ func (e *ChangeReviewedEventV0_1_2) SetSubjectRepository(repository *Reference)
 	e.Subject.Content.Repository = repository
 }
 
+// Get subject custom fields
+
+func (e ChangeReviewedEventV0_1_2) GetSubjectRepository() *Reference {
+	return e.Subject.Content.Repository
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeReviewedEventV0_1_2) DeepCopyInto(out *ChangeReviewedEventV0_1_2) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ChangeReviewedEventV0_1_2.
+func (in *ChangeReviewedEventV0_1_2) DeepCopy() *ChangeReviewedEventV0_1_2 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeReviewedEventV0_1_2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ChangeReviewedEventV0_1_2) Equal(other *ChangeReviewedEventV0_1_2, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeReviewedSubjectV0_1_2) DeepCopyInto(out *ChangeReviewedSubjectV0_1_2) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ChangeReviewedSubjectV0_1_2.
+func (in *ChangeReviewedSubjectV0_1_2) DeepCopy() *ChangeReviewedSubjectV0_1_2 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeReviewedSubjectV0_1_2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ChangeReviewedSubjectContentV0_1_2) DeepCopyInto(out *ChangeReviewedSubjectContentV0_1_2) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ChangeReviewedSubjectContentV0_1_2.
+func (in *ChangeReviewedSubjectContentV0_1_2) DeepCopy() *ChangeReviewedSubjectContentV0_1_2 {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeReviewedSubjectContentV0_1_2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ChangeReviewedSubjectContentV0_1_2) Equal(other *ChangeReviewedSubjectContentV0_1_2) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ChangeReviewedEventV0_1_2
 func NewChangeReviewedEventV0_1_2(specVersion string) (*ChangeReviewedEventV0_1_2, error) {
 	e := &ChangeReviewedEventV0_1_2{
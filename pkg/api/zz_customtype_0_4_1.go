@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -152,11 +153,11 @@ func (e *CustomTypeEventV0_4_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *CustomTypeEventV0_4_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -196,6 +197,57 @@ func (e *CustomTypeEventV0_4_1) SetSubjectContent(subjectContent interface{}) {
 	e.Subject.Content = subjectContent
 }
 
+func init() {
+	registerCustomEventBase(reflect.TypeFor[CustomTypeEventV0_4_1]())
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *CustomTypeEventV0_4_1) DeepCopyInto(out *CustomTypeEventV0_4_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new CustomTypeEventV0_4_1.
+func (in *CustomTypeEventV0_4_1) DeepCopy() *CustomTypeEventV0_4_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTypeEventV0_4_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *CustomTypeEventV0_4_1) Equal(other *CustomTypeEventV0_4_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *CustomTypeSubjectV0_4_1) DeepCopyInto(out *CustomTypeSubjectV0_4_1) {
+	*out = *in
+	out.Content = deepCopyValue(in.Content)
+}
+
+// DeepCopy copies the receiver, creating a new CustomTypeSubjectV0_4_1.
+func (in *CustomTypeSubjectV0_4_1) DeepCopy() *CustomTypeSubjectV0_4_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTypeSubjectV0_4_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // New creates a new CustomTypeEventV0_4_1
 func NewCustomTypeEventV0_4_1(specVersion string) (*CustomTypeEventV0_4_1, error) {
 	e := &CustomTypeEventV0_4_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *EnvironmentCreatedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *EnvironmentCreatedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,83 @@ func (e *EnvironmentCreatedEventV0_2_0) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e EnvironmentCreatedEventV0_2_0) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e EnvironmentCreatedEventV0_2_0) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentCreatedEventV0_2_0) DeepCopyInto(out *EnvironmentCreatedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentCreatedEventV0_2_0.
+func (in *EnvironmentCreatedEventV0_2_0) DeepCopy() *EnvironmentCreatedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentCreatedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *EnvironmentCreatedEventV0_2_0) Equal(other *EnvironmentCreatedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentCreatedSubjectV0_2_0) DeepCopyInto(out *EnvironmentCreatedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentCreatedSubjectV0_2_0.
+func (in *EnvironmentCreatedSubjectV0_2_0) DeepCopy() *EnvironmentCreatedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentCreatedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentCreatedSubjectContentV0_2_0) DeepCopyInto(out *EnvironmentCreatedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentCreatedSubjectContentV0_2_0.
+func (in *EnvironmentCreatedSubjectContentV0_2_0) DeepCopy() *EnvironmentCreatedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentCreatedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *EnvironmentCreatedSubjectContentV0_2_0) Equal(other *EnvironmentCreatedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new EnvironmentCreatedEventV0_2_0
 func NewEnvironmentCreatedEventV0_2_0(specVersion string) (*EnvironmentCreatedEventV0_2_0, error) {
 	e := &EnvironmentCreatedEventV0_2_0{
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -131,11 +132,11 @@ func (e *EnvironmentDeletedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *EnvironmentDeletedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -155,6 +156,79 @@ func (e *EnvironmentDeletedEventV0_1_1) SetSubjectName(name string) {
 	e.Subject.Content.Name = name
 }
 
+// Get subject custom fields
+
+func (e EnvironmentDeletedEventV0_1_1) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentDeletedEventV0_1_1) DeepCopyInto(out *EnvironmentDeletedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentDeletedEventV0_1_1.
+func (in *EnvironmentDeletedEventV0_1_1) DeepCopy() *EnvironmentDeletedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentDeletedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *EnvironmentDeletedEventV0_1_1) Equal(other *EnvironmentDeletedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentDeletedSubjectV0_1_1) DeepCopyInto(out *EnvironmentDeletedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentDeletedSubjectV0_1_1.
+func (in *EnvironmentDeletedSubjectV0_1_1) DeepCopy() *EnvironmentDeletedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentDeletedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentDeletedSubjectContentV0_1_1) DeepCopyInto(out *EnvironmentDeletedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentDeletedSubjectContentV0_1_1.
+func (in *EnvironmentDeletedSubjectContentV0_1_1) DeepCopy() *EnvironmentDeletedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentDeletedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *EnvironmentDeletedSubjectContentV0_1_1) Equal(other *EnvironmentDeletedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new EnvironmentDeletedEventV0_1_1
 func NewEnvironmentDeletedEventV0_1_1(specVersion string) (*EnvironmentDeletedEventV0_1_1, error) {
 	e := &EnvironmentDeletedEventV0_1_1{
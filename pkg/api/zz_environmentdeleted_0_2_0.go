@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *EnvironmentDeletedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *EnvironmentDeletedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,79 @@ func (e *EnvironmentDeletedEventV0_2_0) SetSubjectName(name string) {
 	e.Subject.Content.Name = name
 }
 
+// Get subject custom fields
+
+func (e EnvironmentDeletedEventV0_2_0) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentDeletedEventV0_2_0) DeepCopyInto(out *EnvironmentDeletedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentDeletedEventV0_2_0.
+func (in *EnvironmentDeletedEventV0_2_0) DeepCopy() *EnvironmentDeletedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentDeletedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *EnvironmentDeletedEventV0_2_0) Equal(other *EnvironmentDeletedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentDeletedSubjectV0_2_0) DeepCopyInto(out *EnvironmentDeletedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentDeletedSubjectV0_2_0.
+func (in *EnvironmentDeletedSubjectV0_2_0) DeepCopy() *EnvironmentDeletedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentDeletedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentDeletedSubjectContentV0_2_0) DeepCopyInto(out *EnvironmentDeletedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentDeletedSubjectContentV0_2_0.
+func (in *EnvironmentDeletedSubjectContentV0_2_0) DeepCopy() *EnvironmentDeletedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentDeletedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *EnvironmentDeletedSubjectContentV0_2_0) Equal(other *EnvironmentDeletedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new EnvironmentDeletedEventV0_2_0
 func NewEnvironmentDeletedEventV0_2_0(specVersion string) (*EnvironmentDeletedEventV0_2_0, error) {
 	e := &EnvironmentDeletedEventV0_2_0{
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -133,11 +134,11 @@ func (e *EnvironmentModifiedEventV0_1_1) SetSubjectSource(subjectSource string)
 }
 
 func (e *EnvironmentModifiedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -161,6 +162,83 @@ func (e *EnvironmentModifiedEventV0_1_1) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e EnvironmentModifiedEventV0_1_1) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e EnvironmentModifiedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentModifiedEventV0_1_1) DeepCopyInto(out *EnvironmentModifiedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentModifiedEventV0_1_1.
+func (in *EnvironmentModifiedEventV0_1_1) DeepCopy() *EnvironmentModifiedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentModifiedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *EnvironmentModifiedEventV0_1_1) Equal(other *EnvironmentModifiedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentModifiedSubjectV0_1_1) DeepCopyInto(out *EnvironmentModifiedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentModifiedSubjectV0_1_1.
+func (in *EnvironmentModifiedSubjectV0_1_1) DeepCopy() *EnvironmentModifiedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentModifiedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentModifiedSubjectContentV0_1_1) DeepCopyInto(out *EnvironmentModifiedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentModifiedSubjectContentV0_1_1.
+func (in *EnvironmentModifiedSubjectContentV0_1_1) DeepCopy() *EnvironmentModifiedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentModifiedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *EnvironmentModifiedSubjectContentV0_1_1) Equal(other *EnvironmentModifiedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new EnvironmentModifiedEventV0_1_1
 func NewEnvironmentModifiedEventV0_1_1(specVersion string) (*EnvironmentModifiedEventV0_1_1, error) {
 	e := &EnvironmentModifiedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *EnvironmentModifiedEventV0_3_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *EnvironmentModifiedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,83 @@ func (e *EnvironmentModifiedEventV0_3_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e EnvironmentModifiedEventV0_3_0) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e EnvironmentModifiedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentModifiedEventV0_3_0) DeepCopyInto(out *EnvironmentModifiedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentModifiedEventV0_3_0.
+func (in *EnvironmentModifiedEventV0_3_0) DeepCopy() *EnvironmentModifiedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentModifiedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *EnvironmentModifiedEventV0_3_0) Equal(other *EnvironmentModifiedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentModifiedSubjectV0_3_0) DeepCopyInto(out *EnvironmentModifiedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentModifiedSubjectV0_3_0.
+func (in *EnvironmentModifiedSubjectV0_3_0) DeepCopy() *EnvironmentModifiedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentModifiedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *EnvironmentModifiedSubjectContentV0_3_0) DeepCopyInto(out *EnvironmentModifiedSubjectContentV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new EnvironmentModifiedSubjectContentV0_3_0.
+func (in *EnvironmentModifiedSubjectContentV0_3_0) DeepCopy() *EnvironmentModifiedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentModifiedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *EnvironmentModifiedSubjectContentV0_3_0) Equal(other *EnvironmentModifiedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new EnvironmentModifiedEventV0_3_0
 func NewEnvironmentModifiedEventV0_3_0(specVersion string) (*EnvironmentModifiedEventV0_3_0, error) {
 	e := &EnvironmentModifiedEventV0_3_0{
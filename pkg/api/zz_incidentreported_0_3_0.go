@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -37,7 +38,7 @@ var (
 )
 
 type IncidentReportedSubjectContentV0_3_0 struct {
-	ArtifactId string `json:"artifactId,omitempty" validate:"purl"`
+	ArtifactId string `json:"artifactId,omitempty" validate:"omitempty,purl"`
 
 	Description string `json:"description,omitempty"`
 
@@ -168,11 +169,11 @@ func (e *IncidentReportedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *IncidentReportedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -222,6 +223,105 @@ func (e *IncidentReportedEventV0_3_0) SetSubjectTicketURI(ticketURI string) {
 	e.Subject.Content.TicketURI = ticketURI
 }
 
+// Get subject custom fields
+
+func (e IncidentReportedEventV0_3_0) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e IncidentReportedEventV0_3_0) GetSubjectDescription() string {
+	return e.Subject.Content.Description
+}
+
+func (e IncidentReportedEventV0_3_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e IncidentReportedEventV0_3_0) GetSubjectService() *Reference {
+	return e.Subject.Content.Service
+}
+
+func (e IncidentReportedEventV0_3_0) GetSubjectTicketURI() string {
+	return e.Subject.Content.TicketURI
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *IncidentReportedEventV0_3_0) DeepCopyInto(out *IncidentReportedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new IncidentReportedEventV0_3_0.
+func (in *IncidentReportedEventV0_3_0) DeepCopy() *IncidentReportedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentReportedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *IncidentReportedEventV0_3_0) Equal(other *IncidentReportedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *IncidentReportedSubjectV0_3_0) DeepCopyInto(out *IncidentReportedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new IncidentReportedSubjectV0_3_0.
+func (in *IncidentReportedSubjectV0_3_0) DeepCopy() *IncidentReportedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentReportedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *IncidentReportedSubjectContentV0_3_0) DeepCopyInto(out *IncidentReportedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new IncidentReportedSubjectContentV0_3_0.
+func (in *IncidentReportedSubjectContentV0_3_0) DeepCopy() *IncidentReportedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentReportedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *IncidentReportedSubjectContentV0_3_0) Equal(other *IncidentReportedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new IncidentReportedEventV0_3_0
 func NewIncidentReportedEventV0_3_0(specVersion string) (*IncidentReportedEventV0_3_0, error) {
 	e := &IncidentReportedEventV0_3_0{
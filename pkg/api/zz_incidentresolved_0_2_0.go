@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -37,7 +38,7 @@ var (
 )
 
 type IncidentResolvedSubjectContentV0_2_0 struct {
-	ArtifactId string `json:"artifactId,omitempty" validate:"purl"`
+	ArtifactId string `json:"artifactId,omitempty" validate:"omitempty,purl"`
 
 	Description string `json:"description,omitempty"`
 
@@ -166,11 +167,11 @@ func (e *IncidentResolvedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *IncidentResolvedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -216,6 +217,101 @@ func (e *IncidentResolvedEventV0_2_0) SetSubjectService(service *Reference) {
 	e.Subject.Content.Service = service
 }
 
+// Get subject custom fields
+
+func (e IncidentResolvedEventV0_2_0) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e IncidentResolvedEventV0_2_0) GetSubjectDescription() string {
+	return e.Subject.Content.Description
+}
+
+func (e IncidentResolvedEventV0_2_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e IncidentResolvedEventV0_2_0) GetSubjectService() *Reference {
+	return e.Subject.Content.Service
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *IncidentResolvedEventV0_2_0) DeepCopyInto(out *IncidentResolvedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new IncidentResolvedEventV0_2_0.
+func (in *IncidentResolvedEventV0_2_0) DeepCopy() *IncidentResolvedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentResolvedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *IncidentResolvedEventV0_2_0) Equal(other *IncidentResolvedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *IncidentResolvedSubjectV0_2_0) DeepCopyInto(out *IncidentResolvedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new IncidentResolvedSubjectV0_2_0.
+func (in *IncidentResolvedSubjectV0_2_0) DeepCopy() *IncidentResolvedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentResolvedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *IncidentResolvedSubjectContentV0_2_0) DeepCopyInto(out *IncidentResolvedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new IncidentResolvedSubjectContentV0_2_0.
+func (in *IncidentResolvedSubjectContentV0_2_0) DeepCopy() *IncidentResolvedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentResolvedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *IncidentResolvedSubjectContentV0_2_0) Equal(other *IncidentResolvedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new IncidentResolvedEventV0_2_0
 func NewIncidentResolvedEventV0_2_0(specVersion string) (*IncidentResolvedEventV0_2_0, error) {
 	e := &IncidentResolvedEventV0_2_0{
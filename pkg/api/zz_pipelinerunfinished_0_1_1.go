@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -137,11 +138,11 @@ func (e *PipelineRunFinishedEventV0_1_1) SetSubjectSource(subjectSource string)
 }
 
 func (e *PipelineRunFinishedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -173,6 +174,91 @@ func (e *PipelineRunFinishedEventV0_1_1) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e PipelineRunFinishedEventV0_1_1) GetSubjectErrors() string {
+	return e.Subject.Content.Errors
+}
+
+func (e PipelineRunFinishedEventV0_1_1) GetSubjectOutcome() string {
+	return e.Subject.Content.Outcome
+}
+
+func (e PipelineRunFinishedEventV0_1_1) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunFinishedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedEventV0_1_1) DeepCopyInto(out *PipelineRunFinishedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedEventV0_1_1.
+func (in *PipelineRunFinishedEventV0_1_1) DeepCopy() *PipelineRunFinishedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunFinishedEventV0_1_1) Equal(other *PipelineRunFinishedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedSubjectV0_1_1) DeepCopyInto(out *PipelineRunFinishedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedSubjectV0_1_1.
+func (in *PipelineRunFinishedSubjectV0_1_1) DeepCopy() *PipelineRunFinishedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedSubjectContentV0_1_1) DeepCopyInto(out *PipelineRunFinishedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedSubjectContentV0_1_1.
+func (in *PipelineRunFinishedSubjectContentV0_1_1) DeepCopy() *PipelineRunFinishedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunFinishedSubjectContentV0_1_1) Equal(other *PipelineRunFinishedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunFinishedEventV0_1_1
 func NewPipelineRunFinishedEventV0_1_1(specVersion string) (*PipelineRunFinishedEventV0_1_1, error) {
 	e := &PipelineRunFinishedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -166,11 +167,11 @@ func (e *PipelineRunFinishedEventV0_2_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *PipelineRunFinishedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -216,6 +217,91 @@ func (e *PipelineRunFinishedEventV0_2_0) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e PipelineRunFinishedEventV0_2_0) GetSubjectErrors() string {
+	return e.Subject.Content.Errors
+}
+
+func (e PipelineRunFinishedEventV0_2_0) GetSubjectOutcome() string {
+	return e.Subject.Content.Outcome
+}
+
+func (e PipelineRunFinishedEventV0_2_0) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunFinishedEventV0_2_0) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedEventV0_2_0) DeepCopyInto(out *PipelineRunFinishedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedEventV0_2_0.
+func (in *PipelineRunFinishedEventV0_2_0) DeepCopy() *PipelineRunFinishedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunFinishedEventV0_2_0) Equal(other *PipelineRunFinishedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedSubjectV0_2_0) DeepCopyInto(out *PipelineRunFinishedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedSubjectV0_2_0.
+func (in *PipelineRunFinishedSubjectV0_2_0) DeepCopy() *PipelineRunFinishedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedSubjectContentV0_2_0) DeepCopyInto(out *PipelineRunFinishedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedSubjectContentV0_2_0.
+func (in *PipelineRunFinishedSubjectContentV0_2_0) DeepCopy() *PipelineRunFinishedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunFinishedSubjectContentV0_2_0) Equal(other *PipelineRunFinishedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunFinishedEventV0_2_0
 func NewPipelineRunFinishedEventV0_2_0(specVersion string) (*PipelineRunFinishedEventV0_2_0, error) {
 	e := &PipelineRunFinishedEventV0_2_0{
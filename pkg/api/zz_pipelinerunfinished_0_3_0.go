@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -39,7 +40,7 @@ var (
 type PipelineRunFinishedSubjectContentV0_3_0 struct {
 	Errors string `json:"errors,omitempty"`
 
-	Outcome string `json:"outcome,omitempty"`
+	Outcome PipelineRunFinishedSubjectContentOutcomeV0_3_0 `json:"outcome,omitempty" validate:"omitempty,oneof=success failure cancel error"`
 
 	PipelineName string `json:"pipelineName,omitempty"`
 
@@ -166,11 +167,11 @@ func (e *PipelineRunFinishedEventV0_3_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *PipelineRunFinishedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,7 +205,7 @@ func (e *PipelineRunFinishedEventV0_3_0) SetSubjectErrors(errors string) {
 	e.Subject.Content.Errors = errors
 }
 
-func (e *PipelineRunFinishedEventV0_3_0) SetSubjectOutcome(outcome string) {
+func (e *PipelineRunFinishedEventV0_3_0) SetSubjectOutcome(outcome PipelineRunFinishedSubjectContentOutcomeV0_3_0) {
 	e.Subject.Content.Outcome = outcome
 }
 
@@ -216,6 +217,91 @@ func (e *PipelineRunFinishedEventV0_3_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e PipelineRunFinishedEventV0_3_0) GetSubjectErrors() string {
+	return e.Subject.Content.Errors
+}
+
+func (e PipelineRunFinishedEventV0_3_0) GetSubjectOutcome() PipelineRunFinishedSubjectContentOutcomeV0_3_0 {
+	return e.Subject.Content.Outcome
+}
+
+func (e PipelineRunFinishedEventV0_3_0) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunFinishedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedEventV0_3_0) DeepCopyInto(out *PipelineRunFinishedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedEventV0_3_0.
+func (in *PipelineRunFinishedEventV0_3_0) DeepCopy() *PipelineRunFinishedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunFinishedEventV0_3_0) Equal(other *PipelineRunFinishedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedSubjectV0_3_0) DeepCopyInto(out *PipelineRunFinishedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedSubjectV0_3_0.
+func (in *PipelineRunFinishedSubjectV0_3_0) DeepCopy() *PipelineRunFinishedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunFinishedSubjectContentV0_3_0) DeepCopyInto(out *PipelineRunFinishedSubjectContentV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunFinishedSubjectContentV0_3_0.
+func (in *PipelineRunFinishedSubjectContentV0_3_0) DeepCopy() *PipelineRunFinishedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunFinishedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunFinishedSubjectContentV0_3_0) Equal(other *PipelineRunFinishedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunFinishedEventV0_3_0
 func NewPipelineRunFinishedEventV0_3_0(specVersion string) (*PipelineRunFinishedEventV0_3_0, error) {
 	e := &PipelineRunFinishedEventV0_3_0{
@@ -237,3 +323,22 @@ func NewPipelineRunFinishedEventV0_3_0(specVersion string) (*PipelineRunFinished
 	}
 	return e, nil
 }
+
+// PipelineRunFinishedSubjectContentOutcomeV0_3_0 holds the values defined for an Outcome field in the content
+type PipelineRunFinishedSubjectContentOutcomeV0_3_0 string
+
+const (
+	PipelineRunFinishedSubjectContentOutcomeSuccessV0_3_0 PipelineRunFinishedSubjectContentOutcomeV0_3_0 = "success"
+	PipelineRunFinishedSubjectContentOutcomeFailureV0_3_0 PipelineRunFinishedSubjectContentOutcomeV0_3_0 = "failure"
+	PipelineRunFinishedSubjectContentOutcomeCancelV0_3_0  PipelineRunFinishedSubjectContentOutcomeV0_3_0 = "cancel"
+	PipelineRunFinishedSubjectContentOutcomeErrorV0_3_0   PipelineRunFinishedSubjectContentOutcomeV0_3_0 = "error"
+)
+
+func init() {
+	registerEnumValues(
+		PipelineRunFinishedSubjectContentOutcomeSuccessV0_3_0,
+		PipelineRunFinishedSubjectContentOutcomeFailureV0_3_0,
+		PipelineRunFinishedSubjectContentOutcomeCancelV0_3_0,
+		PipelineRunFinishedSubjectContentOutcomeErrorV0_3_0,
+	)
+}
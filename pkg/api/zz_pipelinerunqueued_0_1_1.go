@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -133,11 +134,11 @@ func (e *PipelineRunQueuedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *PipelineRunQueuedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -161,6 +162,83 @@ func (e *PipelineRunQueuedEventV0_1_1) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e PipelineRunQueuedEventV0_1_1) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunQueuedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunQueuedEventV0_1_1) DeepCopyInto(out *PipelineRunQueuedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunQueuedEventV0_1_1.
+func (in *PipelineRunQueuedEventV0_1_1) DeepCopy() *PipelineRunQueuedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunQueuedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunQueuedEventV0_1_1) Equal(other *PipelineRunQueuedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunQueuedSubjectV0_1_1) DeepCopyInto(out *PipelineRunQueuedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunQueuedSubjectV0_1_1.
+func (in *PipelineRunQueuedSubjectV0_1_1) DeepCopy() *PipelineRunQueuedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunQueuedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunQueuedSubjectContentV0_1_1) DeepCopyInto(out *PipelineRunQueuedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunQueuedSubjectContentV0_1_1.
+func (in *PipelineRunQueuedSubjectContentV0_1_1) DeepCopy() *PipelineRunQueuedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunQueuedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunQueuedSubjectContentV0_1_1) Equal(other *PipelineRunQueuedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunQueuedEventV0_1_1
 func NewPipelineRunQueuedEventV0_1_1(specVersion string) (*PipelineRunQueuedEventV0_1_1, error) {
 	e := &PipelineRunQueuedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *PipelineRunQueuedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *PipelineRunQueuedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,83 @@ func (e *PipelineRunQueuedEventV0_3_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e PipelineRunQueuedEventV0_3_0) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunQueuedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunQueuedEventV0_3_0) DeepCopyInto(out *PipelineRunQueuedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunQueuedEventV0_3_0.
+func (in *PipelineRunQueuedEventV0_3_0) DeepCopy() *PipelineRunQueuedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunQueuedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunQueuedEventV0_3_0) Equal(other *PipelineRunQueuedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunQueuedSubjectV0_3_0) DeepCopyInto(out *PipelineRunQueuedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunQueuedSubjectV0_3_0.
+func (in *PipelineRunQueuedSubjectV0_3_0) DeepCopy() *PipelineRunQueuedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunQueuedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunQueuedSubjectContentV0_3_0) DeepCopyInto(out *PipelineRunQueuedSubjectContentV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunQueuedSubjectContentV0_3_0.
+func (in *PipelineRunQueuedSubjectContentV0_3_0) DeepCopy() *PipelineRunQueuedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunQueuedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunQueuedSubjectContentV0_3_0) Equal(other *PipelineRunQueuedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunQueuedEventV0_3_0
 func NewPipelineRunQueuedEventV0_3_0(specVersion string) (*PipelineRunQueuedEventV0_3_0, error) {
 	e := &PipelineRunQueuedEventV0_3_0{
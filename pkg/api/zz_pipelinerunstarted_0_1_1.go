@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -133,11 +134,11 @@ func (e *PipelineRunStartedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *PipelineRunStartedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -161,6 +162,83 @@ func (e *PipelineRunStartedEventV0_1_1) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e PipelineRunStartedEventV0_1_1) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunStartedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunStartedEventV0_1_1) DeepCopyInto(out *PipelineRunStartedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunStartedEventV0_1_1.
+func (in *PipelineRunStartedEventV0_1_1) DeepCopy() *PipelineRunStartedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunStartedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunStartedEventV0_1_1) Equal(other *PipelineRunStartedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunStartedSubjectV0_1_1) DeepCopyInto(out *PipelineRunStartedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunStartedSubjectV0_1_1.
+func (in *PipelineRunStartedSubjectV0_1_1) DeepCopy() *PipelineRunStartedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunStartedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunStartedSubjectContentV0_1_1) DeepCopyInto(out *PipelineRunStartedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunStartedSubjectContentV0_1_1.
+func (in *PipelineRunStartedSubjectContentV0_1_1) DeepCopy() *PipelineRunStartedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunStartedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunStartedSubjectContentV0_1_1) Equal(other *PipelineRunStartedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunStartedEventV0_1_1
 func NewPipelineRunStartedEventV0_1_1(specVersion string) (*PipelineRunStartedEventV0_1_1, error) {
 	e := &PipelineRunStartedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *PipelineRunStartedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *PipelineRunStartedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,83 @@ func (e *PipelineRunStartedEventV0_3_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e PipelineRunStartedEventV0_3_0) GetSubjectPipelineName() string {
+	return e.Subject.Content.PipelineName
+}
+
+func (e PipelineRunStartedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunStartedEventV0_3_0) DeepCopyInto(out *PipelineRunStartedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunStartedEventV0_3_0.
+func (in *PipelineRunStartedEventV0_3_0) DeepCopy() *PipelineRunStartedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunStartedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *PipelineRunStartedEventV0_3_0) Equal(other *PipelineRunStartedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunStartedSubjectV0_3_0) DeepCopyInto(out *PipelineRunStartedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunStartedSubjectV0_3_0.
+func (in *PipelineRunStartedSubjectV0_3_0) DeepCopy() *PipelineRunStartedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunStartedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *PipelineRunStartedSubjectContentV0_3_0) DeepCopyInto(out *PipelineRunStartedSubjectContentV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new PipelineRunStartedSubjectContentV0_3_0.
+func (in *PipelineRunStartedSubjectContentV0_3_0) DeepCopy() *PipelineRunStartedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunStartedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *PipelineRunStartedSubjectContentV0_3_0) Equal(other *PipelineRunStartedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new PipelineRunStartedEventV0_3_0
 func NewPipelineRunStartedEventV0_3_0(specVersion string) (*PipelineRunStartedEventV0_3_0, error) {
 	e := &PipelineRunStartedEventV0_3_0{
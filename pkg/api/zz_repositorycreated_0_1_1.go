@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -137,11 +138,11 @@ func (e *RepositoryCreatedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *RepositoryCreatedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -173,6 +174,91 @@ func (e *RepositoryCreatedEventV0_1_1) SetSubjectViewUrl(viewUrl string) {
 	e.Subject.Content.ViewUrl = viewUrl
 }
 
+// Get subject custom fields
+
+func (e RepositoryCreatedEventV0_1_1) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e RepositoryCreatedEventV0_1_1) GetSubjectOwner() string {
+	return e.Subject.Content.Owner
+}
+
+func (e RepositoryCreatedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+func (e RepositoryCreatedEventV0_1_1) GetSubjectViewUrl() string {
+	return e.Subject.Content.ViewUrl
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryCreatedEventV0_1_1) DeepCopyInto(out *RepositoryCreatedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryCreatedEventV0_1_1.
+func (in *RepositoryCreatedEventV0_1_1) DeepCopy() *RepositoryCreatedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCreatedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *RepositoryCreatedEventV0_1_1) Equal(other *RepositoryCreatedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryCreatedSubjectV0_1_1) DeepCopyInto(out *RepositoryCreatedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryCreatedSubjectV0_1_1.
+func (in *RepositoryCreatedSubjectV0_1_1) DeepCopy() *RepositoryCreatedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCreatedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryCreatedSubjectContentV0_1_1) DeepCopyInto(out *RepositoryCreatedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryCreatedSubjectContentV0_1_1.
+func (in *RepositoryCreatedSubjectContentV0_1_1) DeepCopy() *RepositoryCreatedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCreatedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *RepositoryCreatedSubjectContentV0_1_1) Equal(other *RepositoryCreatedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new RepositoryCreatedEventV0_1_1
 func NewRepositoryCreatedEventV0_1_1(specVersion string) (*RepositoryCreatedEventV0_1_1, error) {
 	e := &RepositoryCreatedEventV0_1_1{
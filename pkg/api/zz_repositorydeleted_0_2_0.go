@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -166,11 +167,11 @@ func (e *RepositoryDeletedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *RepositoryDeletedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -216,6 +217,91 @@ func (e *RepositoryDeletedEventV0_2_0) SetSubjectViewUrl(viewUrl string) {
 	e.Subject.Content.ViewUrl = viewUrl
 }
 
+// Get subject custom fields
+
+func (e RepositoryDeletedEventV0_2_0) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e RepositoryDeletedEventV0_2_0) GetSubjectOwner() string {
+	return e.Subject.Content.Owner
+}
+
+func (e RepositoryDeletedEventV0_2_0) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+func (e RepositoryDeletedEventV0_2_0) GetSubjectViewUrl() string {
+	return e.Subject.Content.ViewUrl
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryDeletedEventV0_2_0) DeepCopyInto(out *RepositoryDeletedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryDeletedEventV0_2_0.
+func (in *RepositoryDeletedEventV0_2_0) DeepCopy() *RepositoryDeletedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryDeletedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *RepositoryDeletedEventV0_2_0) Equal(other *RepositoryDeletedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryDeletedSubjectV0_2_0) DeepCopyInto(out *RepositoryDeletedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryDeletedSubjectV0_2_0.
+func (in *RepositoryDeletedSubjectV0_2_0) DeepCopy() *RepositoryDeletedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryDeletedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryDeletedSubjectContentV0_2_0) DeepCopyInto(out *RepositoryDeletedSubjectContentV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryDeletedSubjectContentV0_2_0.
+func (in *RepositoryDeletedSubjectContentV0_2_0) DeepCopy() *RepositoryDeletedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryDeletedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *RepositoryDeletedSubjectContentV0_2_0) Equal(other *RepositoryDeletedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new RepositoryDeletedEventV0_2_0
 func NewRepositoryDeletedEventV0_2_0(specVersion string) (*RepositoryDeletedEventV0_2_0, error) {
 	e := &RepositoryDeletedEventV0_2_0{
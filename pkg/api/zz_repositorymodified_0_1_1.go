@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -137,11 +138,11 @@ func (e *RepositoryModifiedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *RepositoryModifiedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -173,6 +174,91 @@ func (e *RepositoryModifiedEventV0_1_1) SetSubjectViewUrl(viewUrl string) {
 	e.Subject.Content.ViewUrl = viewUrl
 }
 
+// Get subject custom fields
+
+func (e RepositoryModifiedEventV0_1_1) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e RepositoryModifiedEventV0_1_1) GetSubjectOwner() string {
+	return e.Subject.Content.Owner
+}
+
+func (e RepositoryModifiedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+func (e RepositoryModifiedEventV0_1_1) GetSubjectViewUrl() string {
+	return e.Subject.Content.ViewUrl
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryModifiedEventV0_1_1) DeepCopyInto(out *RepositoryModifiedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryModifiedEventV0_1_1.
+func (in *RepositoryModifiedEventV0_1_1) DeepCopy() *RepositoryModifiedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryModifiedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *RepositoryModifiedEventV0_1_1) Equal(other *RepositoryModifiedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryModifiedSubjectV0_1_1) DeepCopyInto(out *RepositoryModifiedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryModifiedSubjectV0_1_1.
+func (in *RepositoryModifiedSubjectV0_1_1) DeepCopy() *RepositoryModifiedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryModifiedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryModifiedSubjectContentV0_1_1) DeepCopyInto(out *RepositoryModifiedSubjectContentV0_1_1) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryModifiedSubjectContentV0_1_1.
+func (in *RepositoryModifiedSubjectContentV0_1_1) DeepCopy() *RepositoryModifiedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryModifiedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *RepositoryModifiedSubjectContentV0_1_1) Equal(other *RepositoryModifiedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new RepositoryModifiedEventV0_1_1
 func NewRepositoryModifiedEventV0_1_1(specVersion string) (*RepositoryModifiedEventV0_1_1, error) {
 	e := &RepositoryModifiedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -166,11 +167,11 @@ func (e *RepositoryModifiedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *RepositoryModifiedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -216,6 +217,91 @@ func (e *RepositoryModifiedEventV0_3_0) SetSubjectViewUrl(viewUrl string) {
 	e.Subject.Content.ViewUrl = viewUrl
 }
 
+// Get subject custom fields
+
+func (e RepositoryModifiedEventV0_3_0) GetSubjectName() string {
+	return e.Subject.Content.Name
+}
+
+func (e RepositoryModifiedEventV0_3_0) GetSubjectOwner() string {
+	return e.Subject.Content.Owner
+}
+
+func (e RepositoryModifiedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+func (e RepositoryModifiedEventV0_3_0) GetSubjectViewUrl() string {
+	return e.Subject.Content.ViewUrl
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryModifiedEventV0_3_0) DeepCopyInto(out *RepositoryModifiedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryModifiedEventV0_3_0.
+func (in *RepositoryModifiedEventV0_3_0) DeepCopy() *RepositoryModifiedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryModifiedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *RepositoryModifiedEventV0_3_0) Equal(other *RepositoryModifiedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryModifiedSubjectV0_3_0) DeepCopyInto(out *RepositoryModifiedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryModifiedSubjectV0_3_0.
+func (in *RepositoryModifiedSubjectV0_3_0) DeepCopy() *RepositoryModifiedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryModifiedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *RepositoryModifiedSubjectContentV0_3_0) DeepCopyInto(out *RepositoryModifiedSubjectContentV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new RepositoryModifiedSubjectContentV0_3_0.
+func (in *RepositoryModifiedSubjectContentV0_3_0) DeepCopy() *RepositoryModifiedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryModifiedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *RepositoryModifiedSubjectContentV0_3_0) Equal(other *RepositoryModifiedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new RepositoryModifiedEventV0_3_0
 func NewRepositoryModifiedEventV0_3_0(specVersion string) (*RepositoryModifiedEventV0_3_0, error) {
 	e := &RepositoryModifiedEventV0_3_0{
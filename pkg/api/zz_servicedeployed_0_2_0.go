@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *ServiceDeployedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceDeployedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,88 @@ func (e *ServiceDeployedEventV0_2_0) SetSubjectEnvironment(environment *Referenc
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceDeployedEventV0_2_0) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e ServiceDeployedEventV0_2_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceDeployedEventV0_2_0) DeepCopyInto(out *ServiceDeployedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceDeployedEventV0_2_0.
+func (in *ServiceDeployedEventV0_2_0) DeepCopy() *ServiceDeployedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDeployedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceDeployedEventV0_2_0) Equal(other *ServiceDeployedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceDeployedSubjectV0_2_0) DeepCopyInto(out *ServiceDeployedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceDeployedSubjectV0_2_0.
+func (in *ServiceDeployedSubjectV0_2_0) DeepCopy() *ServiceDeployedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDeployedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceDeployedSubjectContentV0_2_0) DeepCopyInto(out *ServiceDeployedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceDeployedSubjectContentV0_2_0.
+func (in *ServiceDeployedSubjectContentV0_2_0) DeepCopy() *ServiceDeployedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDeployedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceDeployedSubjectContentV0_2_0) Equal(other *ServiceDeployedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceDeployedEventV0_2_0
 func NewServiceDeployedEventV0_2_0(specVersion string) (*ServiceDeployedEventV0_2_0, error) {
 	e := &ServiceDeployedEventV0_2_0{
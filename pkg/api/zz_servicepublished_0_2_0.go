@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *ServicePublishedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServicePublishedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,84 @@ func (e *ServicePublishedEventV0_2_0) SetSubjectEnvironment(environment *Referen
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServicePublishedEventV0_2_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServicePublishedEventV0_2_0) DeepCopyInto(out *ServicePublishedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServicePublishedEventV0_2_0.
+func (in *ServicePublishedEventV0_2_0) DeepCopy() *ServicePublishedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePublishedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServicePublishedEventV0_2_0) Equal(other *ServicePublishedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServicePublishedSubjectV0_2_0) DeepCopyInto(out *ServicePublishedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServicePublishedSubjectV0_2_0.
+func (in *ServicePublishedSubjectV0_2_0) DeepCopy() *ServicePublishedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePublishedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServicePublishedSubjectContentV0_2_0) DeepCopyInto(out *ServicePublishedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServicePublishedSubjectContentV0_2_0.
+func (in *ServicePublishedSubjectContentV0_2_0) DeepCopy() *ServicePublishedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePublishedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServicePublishedSubjectContentV0_2_0) Equal(other *ServicePublishedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServicePublishedEventV0_2_0
 func NewServicePublishedEventV0_2_0(specVersion string) (*ServicePublishedEventV0_2_0, error) {
 	e := &ServicePublishedEventV0_2_0{
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -131,11 +132,11 @@ func (e *ServiceRemovedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceRemovedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -155,6 +156,84 @@ func (e *ServiceRemovedEventV0_1_1) SetSubjectEnvironment(environment *Reference
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceRemovedEventV0_1_1) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRemovedEventV0_1_1) DeepCopyInto(out *ServiceRemovedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRemovedEventV0_1_1.
+func (in *ServiceRemovedEventV0_1_1) DeepCopy() *ServiceRemovedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRemovedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceRemovedEventV0_1_1) Equal(other *ServiceRemovedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRemovedSubjectV0_1_1) DeepCopyInto(out *ServiceRemovedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRemovedSubjectV0_1_1.
+func (in *ServiceRemovedSubjectV0_1_1) DeepCopy() *ServiceRemovedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRemovedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRemovedSubjectContentV0_1_1) DeepCopyInto(out *ServiceRemovedSubjectContentV0_1_1) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRemovedSubjectContentV0_1_1.
+func (in *ServiceRemovedSubjectContentV0_1_1) DeepCopy() *ServiceRemovedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRemovedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceRemovedSubjectContentV0_1_1) Equal(other *ServiceRemovedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceRemovedEventV0_1_1
 func NewServiceRemovedEventV0_1_1(specVersion string) (*ServiceRemovedEventV0_1_1, error) {
 	e := &ServiceRemovedEventV0_1_1{
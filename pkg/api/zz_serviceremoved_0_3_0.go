@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -160,11 +161,11 @@ func (e *ServiceRemovedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceRemovedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -198,6 +199,84 @@ func (e *ServiceRemovedEventV0_3_0) SetSubjectEnvironment(environment *Reference
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceRemovedEventV0_3_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRemovedEventV0_3_0) DeepCopyInto(out *ServiceRemovedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRemovedEventV0_3_0.
+func (in *ServiceRemovedEventV0_3_0) DeepCopy() *ServiceRemovedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRemovedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceRemovedEventV0_3_0) Equal(other *ServiceRemovedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRemovedSubjectV0_3_0) DeepCopyInto(out *ServiceRemovedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRemovedSubjectV0_3_0.
+func (in *ServiceRemovedSubjectV0_3_0) DeepCopy() *ServiceRemovedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRemovedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRemovedSubjectContentV0_3_0) DeepCopyInto(out *ServiceRemovedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRemovedSubjectContentV0_3_0.
+func (in *ServiceRemovedSubjectContentV0_3_0) DeepCopy() *ServiceRemovedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRemovedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceRemovedSubjectContentV0_3_0) Equal(other *ServiceRemovedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceRemovedEventV0_3_0
 func NewServiceRemovedEventV0_3_0(specVersion string) (*ServiceRemovedEventV0_3_0, error) {
 	e := &ServiceRemovedEventV0_3_0{
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -133,11 +134,11 @@ func (e *ServiceRolledbackEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceRolledbackEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -161,6 +162,88 @@ func (e *ServiceRolledbackEventV0_1_1) SetSubjectEnvironment(environment *Refere
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceRolledbackEventV0_1_1) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e ServiceRolledbackEventV0_1_1) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRolledbackEventV0_1_1) DeepCopyInto(out *ServiceRolledbackEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRolledbackEventV0_1_1.
+func (in *ServiceRolledbackEventV0_1_1) DeepCopy() *ServiceRolledbackEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRolledbackEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceRolledbackEventV0_1_1) Equal(other *ServiceRolledbackEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRolledbackSubjectV0_1_1) DeepCopyInto(out *ServiceRolledbackSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRolledbackSubjectV0_1_1.
+func (in *ServiceRolledbackSubjectV0_1_1) DeepCopy() *ServiceRolledbackSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRolledbackSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRolledbackSubjectContentV0_1_1) DeepCopyInto(out *ServiceRolledbackSubjectContentV0_1_1) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRolledbackSubjectContentV0_1_1.
+func (in *ServiceRolledbackSubjectContentV0_1_1) DeepCopy() *ServiceRolledbackSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRolledbackSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceRolledbackSubjectContentV0_1_1) Equal(other *ServiceRolledbackSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceRolledbackEventV0_1_1
 func NewServiceRolledbackEventV0_1_1(specVersion string) (*ServiceRolledbackEventV0_1_1, error) {
 	e := &ServiceRolledbackEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *ServiceRolledbackEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceRolledbackEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,88 @@ func (e *ServiceRolledbackEventV0_3_0) SetSubjectEnvironment(environment *Refere
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceRolledbackEventV0_3_0) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e ServiceRolledbackEventV0_3_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRolledbackEventV0_3_0) DeepCopyInto(out *ServiceRolledbackEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRolledbackEventV0_3_0.
+func (in *ServiceRolledbackEventV0_3_0) DeepCopy() *ServiceRolledbackEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRolledbackEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceRolledbackEventV0_3_0) Equal(other *ServiceRolledbackEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRolledbackSubjectV0_3_0) DeepCopyInto(out *ServiceRolledbackSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRolledbackSubjectV0_3_0.
+func (in *ServiceRolledbackSubjectV0_3_0) DeepCopy() *ServiceRolledbackSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRolledbackSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceRolledbackSubjectContentV0_3_0) DeepCopyInto(out *ServiceRolledbackSubjectContentV0_3_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceRolledbackSubjectContentV0_3_0.
+func (in *ServiceRolledbackSubjectContentV0_3_0) DeepCopy() *ServiceRolledbackSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRolledbackSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceRolledbackSubjectContentV0_3_0) Equal(other *ServiceRolledbackSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceRolledbackEventV0_3_0
 func NewServiceRolledbackEventV0_3_0(specVersion string) (*ServiceRolledbackEventV0_3_0, error) {
 	e := &ServiceRolledbackEventV0_3_0{
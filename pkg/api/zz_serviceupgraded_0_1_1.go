@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -133,11 +134,11 @@ func (e *ServiceUpgradedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceUpgradedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -161,6 +162,88 @@ func (e *ServiceUpgradedEventV0_1_1) SetSubjectEnvironment(environment *Referenc
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceUpgradedEventV0_1_1) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e ServiceUpgradedEventV0_1_1) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceUpgradedEventV0_1_1) DeepCopyInto(out *ServiceUpgradedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceUpgradedEventV0_1_1.
+func (in *ServiceUpgradedEventV0_1_1) DeepCopy() *ServiceUpgradedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUpgradedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceUpgradedEventV0_1_1) Equal(other *ServiceUpgradedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceUpgradedSubjectV0_1_1) DeepCopyInto(out *ServiceUpgradedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceUpgradedSubjectV0_1_1.
+func (in *ServiceUpgradedSubjectV0_1_1) DeepCopy() *ServiceUpgradedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUpgradedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceUpgradedSubjectContentV0_1_1) DeepCopyInto(out *ServiceUpgradedSubjectContentV0_1_1) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceUpgradedSubjectContentV0_1_1.
+func (in *ServiceUpgradedSubjectContentV0_1_1) DeepCopy() *ServiceUpgradedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUpgradedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceUpgradedSubjectContentV0_1_1) Equal(other *ServiceUpgradedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceUpgradedEventV0_1_1
 func NewServiceUpgradedEventV0_1_1(specVersion string) (*ServiceUpgradedEventV0_1_1, error) {
 	e := &ServiceUpgradedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -162,11 +163,11 @@ func (e *ServiceUpgradedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *ServiceUpgradedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,6 +205,88 @@ func (e *ServiceUpgradedEventV0_2_0) SetSubjectEnvironment(environment *Referenc
 	e.Subject.Content.Environment = environment
 }
 
+// Get subject custom fields
+
+func (e ServiceUpgradedEventV0_2_0) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e ServiceUpgradedEventV0_2_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceUpgradedEventV0_2_0) DeepCopyInto(out *ServiceUpgradedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceUpgradedEventV0_2_0.
+func (in *ServiceUpgradedEventV0_2_0) DeepCopy() *ServiceUpgradedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUpgradedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *ServiceUpgradedEventV0_2_0) Equal(other *ServiceUpgradedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceUpgradedSubjectV0_2_0) DeepCopyInto(out *ServiceUpgradedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new ServiceUpgradedSubjectV0_2_0.
+func (in *ServiceUpgradedSubjectV0_2_0) DeepCopy() *ServiceUpgradedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUpgradedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ServiceUpgradedSubjectContentV0_2_0) DeepCopyInto(out *ServiceUpgradedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new ServiceUpgradedSubjectContentV0_2_0.
+func (in *ServiceUpgradedSubjectContentV0_2_0) DeepCopy() *ServiceUpgradedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUpgradedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *ServiceUpgradedSubjectContentV0_2_0) Equal(other *ServiceUpgradedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new ServiceUpgradedEventV0_2_0
 func NewServiceUpgradedEventV0_2_0(specVersion string) (*ServiceUpgradedEventV0_2_0, error) {
 	e := &ServiceUpgradedEventV0_2_0{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -168,11 +169,11 @@ func (e *TaskRunFinishedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TaskRunFinishedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -222,6 +223,100 @@ func (e *TaskRunFinishedEventV0_2_0) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e TaskRunFinishedEventV0_2_0) GetSubjectErrors() string {
+	return e.Subject.Content.Errors
+}
+
+func (e TaskRunFinishedEventV0_2_0) GetSubjectOutcome() string {
+	return e.Subject.Content.Outcome
+}
+
+func (e TaskRunFinishedEventV0_2_0) GetSubjectPipelineRun() *Reference {
+	return e.Subject.Content.PipelineRun
+}
+
+func (e TaskRunFinishedEventV0_2_0) GetSubjectTaskName() string {
+	return e.Subject.Content.TaskName
+}
+
+func (e TaskRunFinishedEventV0_2_0) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunFinishedEventV0_2_0) DeepCopyInto(out *TaskRunFinishedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunFinishedEventV0_2_0.
+func (in *TaskRunFinishedEventV0_2_0) DeepCopy() *TaskRunFinishedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunFinishedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TaskRunFinishedEventV0_2_0) Equal(other *TaskRunFinishedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunFinishedSubjectV0_2_0) DeepCopyInto(out *TaskRunFinishedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunFinishedSubjectV0_2_0.
+func (in *TaskRunFinishedSubjectV0_2_0) DeepCopy() *TaskRunFinishedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunFinishedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunFinishedSubjectContentV0_2_0) DeepCopyInto(out *TaskRunFinishedSubjectContentV0_2_0) {
+	*out = *in
+	if in.PipelineRun != nil {
+		in, out := &in.PipelineRun, &out.PipelineRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunFinishedSubjectContentV0_2_0.
+func (in *TaskRunFinishedSubjectContentV0_2_0) DeepCopy() *TaskRunFinishedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunFinishedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TaskRunFinishedSubjectContentV0_2_0) Equal(other *TaskRunFinishedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TaskRunFinishedEventV0_2_0
 func NewTaskRunFinishedEventV0_2_0(specVersion string) (*TaskRunFinishedEventV0_2_0, error) {
 	e := &TaskRunFinishedEventV0_2_0{
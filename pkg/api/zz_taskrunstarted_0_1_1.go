@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -135,11 +136,11 @@ func (e *TaskRunStartedEventV0_1_1) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TaskRunStartedEventV0_1_1) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -167,6 +168,92 @@ func (e *TaskRunStartedEventV0_1_1) SetSubjectUrl(url string) {
 	e.Subject.Content.Url = url
 }
 
+// Get subject custom fields
+
+func (e TaskRunStartedEventV0_1_1) GetSubjectPipelineRun() *Reference {
+	return e.Subject.Content.PipelineRun
+}
+
+func (e TaskRunStartedEventV0_1_1) GetSubjectTaskName() string {
+	return e.Subject.Content.TaskName
+}
+
+func (e TaskRunStartedEventV0_1_1) GetSubjectUrl() string {
+	return e.Subject.Content.Url
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunStartedEventV0_1_1) DeepCopyInto(out *TaskRunStartedEventV0_1_1) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunStartedEventV0_1_1.
+func (in *TaskRunStartedEventV0_1_1) DeepCopy() *TaskRunStartedEventV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStartedEventV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TaskRunStartedEventV0_1_1) Equal(other *TaskRunStartedEventV0_1_1, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunStartedSubjectV0_1_1) DeepCopyInto(out *TaskRunStartedSubjectV0_1_1) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunStartedSubjectV0_1_1.
+func (in *TaskRunStartedSubjectV0_1_1) DeepCopy() *TaskRunStartedSubjectV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStartedSubjectV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunStartedSubjectContentV0_1_1) DeepCopyInto(out *TaskRunStartedSubjectContentV0_1_1) {
+	*out = *in
+	if in.PipelineRun != nil {
+		in, out := &in.PipelineRun, &out.PipelineRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunStartedSubjectContentV0_1_1.
+func (in *TaskRunStartedSubjectContentV0_1_1) DeepCopy() *TaskRunStartedSubjectContentV0_1_1 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStartedSubjectContentV0_1_1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TaskRunStartedSubjectContentV0_1_1) Equal(other *TaskRunStartedSubjectContentV0_1_1) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TaskRunStartedEventV0_1_1
 func NewTaskRunStartedEventV0_1_1(specVersion string) (*TaskRunStartedEventV0_1_1, error) {
 	e := &TaskRunStartedEventV0_1_1{
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -164,11 +165,11 @@ func (e *TaskRunStartedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TaskRunStartedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -210,6 +211,92 @@ func (e *TaskRunStartedEventV0_3_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e TaskRunStartedEventV0_3_0) GetSubjectPipelineRun() *Reference {
+	return e.Subject.Content.PipelineRun
+}
+
+func (e TaskRunStartedEventV0_3_0) GetSubjectTaskName() string {
+	return e.Subject.Content.TaskName
+}
+
+func (e TaskRunStartedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunStartedEventV0_3_0) DeepCopyInto(out *TaskRunStartedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunStartedEventV0_3_0.
+func (in *TaskRunStartedEventV0_3_0) DeepCopy() *TaskRunStartedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStartedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TaskRunStartedEventV0_3_0) Equal(other *TaskRunStartedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunStartedSubjectV0_3_0) DeepCopyInto(out *TaskRunStartedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunStartedSubjectV0_3_0.
+func (in *TaskRunStartedSubjectV0_3_0) DeepCopy() *TaskRunStartedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStartedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TaskRunStartedSubjectContentV0_3_0) DeepCopyInto(out *TaskRunStartedSubjectContentV0_3_0) {
+	*out = *in
+	if in.PipelineRun != nil {
+		in, out := &in.PipelineRun, &out.PipelineRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TaskRunStartedSubjectContentV0_3_0.
+func (in *TaskRunStartedSubjectContentV0_3_0) DeepCopy() *TaskRunStartedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStartedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TaskRunStartedSubjectContentV0_3_0) Equal(other *TaskRunStartedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TaskRunStartedEventV0_3_0
 func NewTaskRunStartedEventV0_3_0(specVersion string) (*TaskRunStartedEventV0_3_0, error) {
 	e := &TaskRunStartedEventV0_3_0{
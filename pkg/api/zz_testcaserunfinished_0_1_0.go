@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -38,11 +39,11 @@ var (
 type TestCaseRunFinishedSubjectContentV0_1_0 struct {
 	Environment *Reference `json:"environment"`
 
-	Outcome string `json:"outcome"`
+	Outcome TestCaseRunFinishedSubjectContentOutcomeV0_1_0 `json:"outcome" validate:"oneof=pass fail cancel error"`
 
 	Reason string `json:"reason,omitempty"`
 
-	Severity string `json:"severity,omitempty"`
+	Severity TestCaseRunFinishedSubjectContentSeverityV0_1_0 `json:"severity,omitempty" validate:"omitempty,oneof=low medium high critical"`
 
 	TestCase *TestCaseRunFinishedSubjectContentTestCaseV0_1_0 `json:"testCase,omitempty"`
 
@@ -141,11 +142,11 @@ func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *TestCaseRunFinishedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -165,7 +166,7 @@ func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectEnvironment(environment *Refe
 	e.Subject.Content.Environment = environment
 }
 
-func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectOutcome(outcome string) {
+func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectOutcome(outcome TestCaseRunFinishedSubjectContentOutcomeV0_1_0) {
 	e.Subject.Content.Outcome = outcome
 }
 
@@ -173,7 +174,7 @@ func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectReason(reason string) {
 	e.Subject.Content.Reason = reason
 }
 
-func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectSeverity(severity string) {
+func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectSeverity(severity TestCaseRunFinishedSubjectContentSeverityV0_1_0) {
 	e.Subject.Content.Severity = severity
 }
 
@@ -185,6 +186,114 @@ func (e *TestCaseRunFinishedEventV0_1_0) SetSubjectTestSuiteRun(testSuiteRun *Re
 	e.Subject.Content.TestSuiteRun = testSuiteRun
 }
 
+// Get subject custom fields
+
+func (e TestCaseRunFinishedEventV0_1_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestCaseRunFinishedEventV0_1_0) GetSubjectOutcome() TestCaseRunFinishedSubjectContentOutcomeV0_1_0 {
+	return e.Subject.Content.Outcome
+}
+
+func (e TestCaseRunFinishedEventV0_1_0) GetSubjectReason() string {
+	return e.Subject.Content.Reason
+}
+
+func (e TestCaseRunFinishedEventV0_1_0) GetSubjectSeverity() TestCaseRunFinishedSubjectContentSeverityV0_1_0 {
+	return e.Subject.Content.Severity
+}
+
+func (e TestCaseRunFinishedEventV0_1_0) GetSubjectTestCase() *TestCaseRunFinishedSubjectContentTestCaseV0_1_0 {
+	return e.Subject.Content.TestCase
+}
+
+func (e TestCaseRunFinishedEventV0_1_0) GetSubjectTestSuiteRun() *Reference {
+	return e.Subject.Content.TestSuiteRun
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunFinishedEventV0_1_0) DeepCopyInto(out *TestCaseRunFinishedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunFinishedEventV0_1_0.
+func (in *TestCaseRunFinishedEventV0_1_0) DeepCopy() *TestCaseRunFinishedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunFinishedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestCaseRunFinishedEventV0_1_0) Equal(other *TestCaseRunFinishedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunFinishedSubjectV0_1_0) DeepCopyInto(out *TestCaseRunFinishedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunFinishedSubjectV0_1_0.
+func (in *TestCaseRunFinishedSubjectV0_1_0) DeepCopy() *TestCaseRunFinishedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunFinishedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunFinishedSubjectContentV0_1_0) DeepCopyInto(out *TestCaseRunFinishedSubjectContentV0_1_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestCase != nil {
+		in, out := &in.TestCase, &out.TestCase
+		*out = new(TestCaseRunFinishedSubjectContentTestCaseV0_1_0)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuiteRun != nil {
+		in, out := &in.TestSuiteRun, &out.TestSuiteRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunFinishedSubjectContentV0_1_0.
+func (in *TestCaseRunFinishedSubjectContentV0_1_0) DeepCopy() *TestCaseRunFinishedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunFinishedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunFinishedSubjectContentV0_1_0) Equal(other *TestCaseRunFinishedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestCaseRunFinishedEventV0_1_0
 func NewTestCaseRunFinishedEventV0_1_0(specVersion string) (*TestCaseRunFinishedEventV0_1_0, error) {
 	e := &TestCaseRunFinishedEventV0_1_0{
@@ -213,9 +322,94 @@ type TestCaseRunFinishedSubjectContentTestCaseV0_1_0 struct {
 
 	Name string `json:"name,omitempty"`
 
-	Type string `json:"type,omitempty"`
+	Type TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 `json:"type,omitempty" validate:"omitempty,oneof=performance functional unit security compliance integration e2e other"`
 
 	Uri string `json:"uri,omitempty"`
 
 	Version string `json:"version,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunFinishedSubjectContentTestCaseV0_1_0) DeepCopyInto(out *TestCaseRunFinishedSubjectContentTestCaseV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunFinishedSubjectContentTestCaseV0_1_0.
+func (in *TestCaseRunFinishedSubjectContentTestCaseV0_1_0) DeepCopy() *TestCaseRunFinishedSubjectContentTestCaseV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunFinishedSubjectContentTestCaseV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunFinishedSubjectContentTestCaseV0_1_0) Equal(other *TestCaseRunFinishedSubjectContentTestCaseV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestCaseRunFinishedSubjectContentOutcomeV0_1_0 holds the values defined for an Outcome field in the content
+type TestCaseRunFinishedSubjectContentOutcomeV0_1_0 string
+
+const (
+	TestCaseRunFinishedSubjectContentOutcomePassV0_1_0   TestCaseRunFinishedSubjectContentOutcomeV0_1_0 = "pass"
+	TestCaseRunFinishedSubjectContentOutcomeFailV0_1_0   TestCaseRunFinishedSubjectContentOutcomeV0_1_0 = "fail"
+	TestCaseRunFinishedSubjectContentOutcomeCancelV0_1_0 TestCaseRunFinishedSubjectContentOutcomeV0_1_0 = "cancel"
+	TestCaseRunFinishedSubjectContentOutcomeErrorV0_1_0  TestCaseRunFinishedSubjectContentOutcomeV0_1_0 = "error"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunFinishedSubjectContentOutcomePassV0_1_0,
+		TestCaseRunFinishedSubjectContentOutcomeFailV0_1_0,
+		TestCaseRunFinishedSubjectContentOutcomeCancelV0_1_0,
+		TestCaseRunFinishedSubjectContentOutcomeErrorV0_1_0,
+	)
+}
+
+// TestCaseRunFinishedSubjectContentSeverityV0_1_0 holds the values defined for a Severity field in the content
+type TestCaseRunFinishedSubjectContentSeverityV0_1_0 string
+
+const (
+	TestCaseRunFinishedSubjectContentSeverityLowV0_1_0      TestCaseRunFinishedSubjectContentSeverityV0_1_0 = "low"
+	TestCaseRunFinishedSubjectContentSeverityMediumV0_1_0   TestCaseRunFinishedSubjectContentSeverityV0_1_0 = "medium"
+	TestCaseRunFinishedSubjectContentSeverityHighV0_1_0     TestCaseRunFinishedSubjectContentSeverityV0_1_0 = "high"
+	TestCaseRunFinishedSubjectContentSeverityCriticalV0_1_0 TestCaseRunFinishedSubjectContentSeverityV0_1_0 = "critical"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunFinishedSubjectContentSeverityLowV0_1_0,
+		TestCaseRunFinishedSubjectContentSeverityMediumV0_1_0,
+		TestCaseRunFinishedSubjectContentSeverityHighV0_1_0,
+		TestCaseRunFinishedSubjectContentSeverityCriticalV0_1_0,
+	)
+}
+
+// TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 holds the values defined for a TestCaseType field in the content
+type TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 string
+
+const (
+	TestCaseRunFinishedSubjectContentTestCaseTypePerformanceV0_1_0 TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "performance"
+	TestCaseRunFinishedSubjectContentTestCaseTypeFunctionalV0_1_0  TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "functional"
+	TestCaseRunFinishedSubjectContentTestCaseTypeUnitV0_1_0        TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "unit"
+	TestCaseRunFinishedSubjectContentTestCaseTypeSecurityV0_1_0    TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "security"
+	TestCaseRunFinishedSubjectContentTestCaseTypeComplianceV0_1_0  TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "compliance"
+	TestCaseRunFinishedSubjectContentTestCaseTypeIntegrationV0_1_0 TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "integration"
+	TestCaseRunFinishedSubjectContentTestCaseTypeE2eV0_1_0         TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "e2e"
+	TestCaseRunFinishedSubjectContentTestCaseTypeOtherV0_1_0       TestCaseRunFinishedSubjectContentTestCaseTypeV0_1_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunFinishedSubjectContentTestCaseTypePerformanceV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeFunctionalV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeUnitV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeSecurityV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeComplianceV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeIntegrationV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeE2eV0_1_0,
+		TestCaseRunFinishedSubjectContentTestCaseTypeOtherV0_1_0,
+	)
+}
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -137,11 +138,11 @@ func (e *TestCaseRunQueuedEventV0_1_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TestCaseRunQueuedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -173,6 +174,111 @@ func (e *TestCaseRunQueuedEventV0_1_0) SetSubjectTrigger(trigger *TestCaseRunQue
 	e.Subject.Content.Trigger = trigger
 }
 
+// Get subject custom fields
+
+func (e TestCaseRunQueuedEventV0_1_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestCaseRunQueuedEventV0_1_0) GetSubjectTestCase() *TestCaseRunQueuedSubjectContentTestCaseV0_1_0 {
+	return e.Subject.Content.TestCase
+}
+
+func (e TestCaseRunQueuedEventV0_1_0) GetSubjectTestSuiteRun() *Reference {
+	return e.Subject.Content.TestSuiteRun
+}
+
+func (e TestCaseRunQueuedEventV0_1_0) GetSubjectTrigger() *TestCaseRunQueuedSubjectContentTriggerV0_1_0 {
+	return e.Subject.Content.Trigger
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunQueuedEventV0_1_0) DeepCopyInto(out *TestCaseRunQueuedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunQueuedEventV0_1_0.
+func (in *TestCaseRunQueuedEventV0_1_0) DeepCopy() *TestCaseRunQueuedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunQueuedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestCaseRunQueuedEventV0_1_0) Equal(other *TestCaseRunQueuedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunQueuedSubjectV0_1_0) DeepCopyInto(out *TestCaseRunQueuedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunQueuedSubjectV0_1_0.
+func (in *TestCaseRunQueuedSubjectV0_1_0) DeepCopy() *TestCaseRunQueuedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunQueuedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunQueuedSubjectContentV0_1_0) DeepCopyInto(out *TestCaseRunQueuedSubjectContentV0_1_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestCase != nil {
+		in, out := &in.TestCase, &out.TestCase
+		*out = new(TestCaseRunQueuedSubjectContentTestCaseV0_1_0)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuiteRun != nil {
+		in, out := &in.TestSuiteRun, &out.TestSuiteRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(TestCaseRunQueuedSubjectContentTriggerV0_1_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunQueuedSubjectContentV0_1_0.
+func (in *TestCaseRunQueuedSubjectContentV0_1_0) DeepCopy() *TestCaseRunQueuedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunQueuedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunQueuedSubjectContentV0_1_0) Equal(other *TestCaseRunQueuedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestCaseRunQueuedEventV0_1_0
 func NewTestCaseRunQueuedEventV0_1_0(specVersion string) (*TestCaseRunQueuedEventV0_1_0, error) {
 	e := &TestCaseRunQueuedEventV0_1_0{
@@ -201,16 +307,104 @@ type TestCaseRunQueuedSubjectContentTestCaseV0_1_0 struct {
 
 	Name string `json:"name,omitempty"`
 
-	Type string `json:"type,omitempty"`
+	Type TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 `json:"type,omitempty" validate:"omitempty,oneof=performance functional unit security compliance integration e2e other"`
 
 	Uri string `json:"uri,omitempty"`
 
 	Version string `json:"version,omitempty"`
 }
 
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunQueuedSubjectContentTestCaseV0_1_0) DeepCopyInto(out *TestCaseRunQueuedSubjectContentTestCaseV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunQueuedSubjectContentTestCaseV0_1_0.
+func (in *TestCaseRunQueuedSubjectContentTestCaseV0_1_0) DeepCopy() *TestCaseRunQueuedSubjectContentTestCaseV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunQueuedSubjectContentTestCaseV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunQueuedSubjectContentTestCaseV0_1_0) Equal(other *TestCaseRunQueuedSubjectContentTestCaseV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // TestCaseRunQueuedSubjectContentTriggerV0_1_0 holds the content of a Trigger field in the content
 type TestCaseRunQueuedSubjectContentTriggerV0_1_0 struct {
-	Type string `json:"type,omitempty"`
+	Type TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 `json:"type,omitempty" validate:"omitempty,oneof=manual pipeline event schedule other"`
 
 	Uri string `json:"uri,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunQueuedSubjectContentTriggerV0_1_0) DeepCopyInto(out *TestCaseRunQueuedSubjectContentTriggerV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunQueuedSubjectContentTriggerV0_1_0.
+func (in *TestCaseRunQueuedSubjectContentTriggerV0_1_0) DeepCopy() *TestCaseRunQueuedSubjectContentTriggerV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunQueuedSubjectContentTriggerV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunQueuedSubjectContentTriggerV0_1_0) Equal(other *TestCaseRunQueuedSubjectContentTriggerV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 holds the values defined for a TestCaseType field in the content
+type TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 string
+
+const (
+	TestCaseRunQueuedSubjectContentTestCaseTypePerformanceV0_1_0 TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "performance"
+	TestCaseRunQueuedSubjectContentTestCaseTypeFunctionalV0_1_0  TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "functional"
+	TestCaseRunQueuedSubjectContentTestCaseTypeUnitV0_1_0        TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "unit"
+	TestCaseRunQueuedSubjectContentTestCaseTypeSecurityV0_1_0    TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "security"
+	TestCaseRunQueuedSubjectContentTestCaseTypeComplianceV0_1_0  TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "compliance"
+	TestCaseRunQueuedSubjectContentTestCaseTypeIntegrationV0_1_0 TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "integration"
+	TestCaseRunQueuedSubjectContentTestCaseTypeE2eV0_1_0         TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "e2e"
+	TestCaseRunQueuedSubjectContentTestCaseTypeOtherV0_1_0       TestCaseRunQueuedSubjectContentTestCaseTypeV0_1_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunQueuedSubjectContentTestCaseTypePerformanceV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeFunctionalV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeUnitV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeSecurityV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeComplianceV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeIntegrationV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeE2eV0_1_0,
+		TestCaseRunQueuedSubjectContentTestCaseTypeOtherV0_1_0,
+	)
+}
+
+// TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 holds the values defined for a TriggerType field in the content
+type TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 string
+
+const (
+	TestCaseRunQueuedSubjectContentTriggerTypeManualV0_1_0   TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 = "manual"
+	TestCaseRunQueuedSubjectContentTriggerTypePipelineV0_1_0 TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 = "pipeline"
+	TestCaseRunQueuedSubjectContentTriggerTypeEventV0_1_0    TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 = "event"
+	TestCaseRunQueuedSubjectContentTriggerTypeScheduleV0_1_0 TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 = "schedule"
+	TestCaseRunQueuedSubjectContentTriggerTypeOtherV0_1_0    TestCaseRunQueuedSubjectContentTriggerTypeV0_1_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunQueuedSubjectContentTriggerTypeManualV0_1_0,
+		TestCaseRunQueuedSubjectContentTriggerTypePipelineV0_1_0,
+		TestCaseRunQueuedSubjectContentTriggerTypeEventV0_1_0,
+		TestCaseRunQueuedSubjectContentTriggerTypeScheduleV0_1_0,
+		TestCaseRunQueuedSubjectContentTriggerTypeOtherV0_1_0,
+	)
+}
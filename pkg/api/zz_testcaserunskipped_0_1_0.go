@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -166,11 +167,11 @@ func (e *TestCaseRunSkippedEventV0_1_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TestCaseRunSkippedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -216,6 +217,106 @@ func (e *TestCaseRunSkippedEventV0_1_0) SetSubjectTestSuiteRun(testSuiteRun *Ref
 	e.Subject.Content.TestSuiteRun = testSuiteRun
 }
 
+// Get subject custom fields
+
+func (e TestCaseRunSkippedEventV0_1_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestCaseRunSkippedEventV0_1_0) GetSubjectReason() string {
+	return e.Subject.Content.Reason
+}
+
+func (e TestCaseRunSkippedEventV0_1_0) GetSubjectTestCase() *TestCaseRunSkippedSubjectContentTestCaseV0_1_0 {
+	return e.Subject.Content.TestCase
+}
+
+func (e TestCaseRunSkippedEventV0_1_0) GetSubjectTestSuiteRun() *Reference {
+	return e.Subject.Content.TestSuiteRun
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunSkippedEventV0_1_0) DeepCopyInto(out *TestCaseRunSkippedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunSkippedEventV0_1_0.
+func (in *TestCaseRunSkippedEventV0_1_0) DeepCopy() *TestCaseRunSkippedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunSkippedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestCaseRunSkippedEventV0_1_0) Equal(other *TestCaseRunSkippedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunSkippedSubjectV0_1_0) DeepCopyInto(out *TestCaseRunSkippedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunSkippedSubjectV0_1_0.
+func (in *TestCaseRunSkippedSubjectV0_1_0) DeepCopy() *TestCaseRunSkippedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunSkippedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunSkippedSubjectContentV0_1_0) DeepCopyInto(out *TestCaseRunSkippedSubjectContentV0_1_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestCase != nil {
+		in, out := &in.TestCase, &out.TestCase
+		*out = new(TestCaseRunSkippedSubjectContentTestCaseV0_1_0)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuiteRun != nil {
+		in, out := &in.TestSuiteRun, &out.TestSuiteRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunSkippedSubjectContentV0_1_0.
+func (in *TestCaseRunSkippedSubjectContentV0_1_0) DeepCopy() *TestCaseRunSkippedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunSkippedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunSkippedSubjectContentV0_1_0) Equal(other *TestCaseRunSkippedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestCaseRunSkippedEventV0_1_0
 func NewTestCaseRunSkippedEventV0_1_0(specVersion string) (*TestCaseRunSkippedEventV0_1_0, error) {
 	e := &TestCaseRunSkippedEventV0_1_0{
@@ -248,9 +349,56 @@ type TestCaseRunSkippedSubjectContentTestCaseV0_1_0 struct {
 
 	Name string `json:"name,omitempty"`
 
-	Type string `json:"type,omitempty"`
+	Type TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 `json:"type,omitempty" validate:"omitempty,oneof=performance functional unit security compliance integration e2e other"`
 
 	Uri string `json:"uri,omitempty"`
 
 	Version string `json:"version,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunSkippedSubjectContentTestCaseV0_1_0) DeepCopyInto(out *TestCaseRunSkippedSubjectContentTestCaseV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunSkippedSubjectContentTestCaseV0_1_0.
+func (in *TestCaseRunSkippedSubjectContentTestCaseV0_1_0) DeepCopy() *TestCaseRunSkippedSubjectContentTestCaseV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunSkippedSubjectContentTestCaseV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunSkippedSubjectContentTestCaseV0_1_0) Equal(other *TestCaseRunSkippedSubjectContentTestCaseV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 holds the values defined for a TestCaseType field in the content
+type TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 string
+
+const (
+	TestCaseRunSkippedSubjectContentTestCaseTypePerformanceV0_1_0 TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "performance"
+	TestCaseRunSkippedSubjectContentTestCaseTypeFunctionalV0_1_0  TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "functional"
+	TestCaseRunSkippedSubjectContentTestCaseTypeUnitV0_1_0        TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "unit"
+	TestCaseRunSkippedSubjectContentTestCaseTypeSecurityV0_1_0    TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "security"
+	TestCaseRunSkippedSubjectContentTestCaseTypeComplianceV0_1_0  TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "compliance"
+	TestCaseRunSkippedSubjectContentTestCaseTypeIntegrationV0_1_0 TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "integration"
+	TestCaseRunSkippedSubjectContentTestCaseTypeE2eV0_1_0         TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "e2e"
+	TestCaseRunSkippedSubjectContentTestCaseTypeOtherV0_1_0       TestCaseRunSkippedSubjectContentTestCaseTypeV0_1_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunSkippedSubjectContentTestCaseTypePerformanceV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeFunctionalV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeUnitV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeSecurityV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeComplianceV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeIntegrationV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeE2eV0_1_0,
+		TestCaseRunSkippedSubjectContentTestCaseTypeOtherV0_1_0,
+	)
+}
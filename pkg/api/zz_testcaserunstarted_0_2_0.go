@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -166,11 +167,11 @@ func (e *TestCaseRunStartedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TestCaseRunStartedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -216,6 +217,111 @@ func (e *TestCaseRunStartedEventV0_2_0) SetSubjectTrigger(trigger *TestCaseRunSt
 	e.Subject.Content.Trigger = trigger
 }
 
+// Get subject custom fields
+
+func (e TestCaseRunStartedEventV0_2_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestCaseRunStartedEventV0_2_0) GetSubjectTestCase() *TestCaseRunStartedSubjectContentTestCaseV0_2_0 {
+	return e.Subject.Content.TestCase
+}
+
+func (e TestCaseRunStartedEventV0_2_0) GetSubjectTestSuiteRun() *Reference {
+	return e.Subject.Content.TestSuiteRun
+}
+
+func (e TestCaseRunStartedEventV0_2_0) GetSubjectTrigger() *TestCaseRunStartedSubjectContentTriggerV0_2_0 {
+	return e.Subject.Content.Trigger
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunStartedEventV0_2_0) DeepCopyInto(out *TestCaseRunStartedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunStartedEventV0_2_0.
+func (in *TestCaseRunStartedEventV0_2_0) DeepCopy() *TestCaseRunStartedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunStartedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestCaseRunStartedEventV0_2_0) Equal(other *TestCaseRunStartedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunStartedSubjectV0_2_0) DeepCopyInto(out *TestCaseRunStartedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunStartedSubjectV0_2_0.
+func (in *TestCaseRunStartedSubjectV0_2_0) DeepCopy() *TestCaseRunStartedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunStartedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunStartedSubjectContentV0_2_0) DeepCopyInto(out *TestCaseRunStartedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestCase != nil {
+		in, out := &in.TestCase, &out.TestCase
+		*out = new(TestCaseRunStartedSubjectContentTestCaseV0_2_0)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuiteRun != nil {
+		in, out := &in.TestSuiteRun, &out.TestSuiteRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(TestCaseRunStartedSubjectContentTriggerV0_2_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunStartedSubjectContentV0_2_0.
+func (in *TestCaseRunStartedSubjectContentV0_2_0) DeepCopy() *TestCaseRunStartedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunStartedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunStartedSubjectContentV0_2_0) Equal(other *TestCaseRunStartedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestCaseRunStartedEventV0_2_0
 func NewTestCaseRunStartedEventV0_2_0(specVersion string) (*TestCaseRunStartedEventV0_2_0, error) {
 	e := &TestCaseRunStartedEventV0_2_0{
@@ -248,16 +354,104 @@ type TestCaseRunStartedSubjectContentTestCaseV0_2_0 struct {
 
 	Name string `json:"name,omitempty"`
 
-	Type string `json:"type,omitempty"`
+	Type TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 `json:"type,omitempty" validate:"omitempty,oneof=performance functional unit security compliance integration e2e other"`
 
 	Uri string `json:"uri,omitempty"`
 
 	Version string `json:"version,omitempty"`
 }
 
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunStartedSubjectContentTestCaseV0_2_0) DeepCopyInto(out *TestCaseRunStartedSubjectContentTestCaseV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunStartedSubjectContentTestCaseV0_2_0.
+func (in *TestCaseRunStartedSubjectContentTestCaseV0_2_0) DeepCopy() *TestCaseRunStartedSubjectContentTestCaseV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunStartedSubjectContentTestCaseV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunStartedSubjectContentTestCaseV0_2_0) Equal(other *TestCaseRunStartedSubjectContentTestCaseV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // TestCaseRunStartedSubjectContentTriggerV0_2_0 holds the content of a Trigger field in the content
 type TestCaseRunStartedSubjectContentTriggerV0_2_0 struct {
-	Type string `json:"type,omitempty"`
+	Type TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 `json:"type,omitempty" validate:"omitempty,oneof=manual pipeline event schedule other"`
 
 	Uri string `json:"uri,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestCaseRunStartedSubjectContentTriggerV0_2_0) DeepCopyInto(out *TestCaseRunStartedSubjectContentTriggerV0_2_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestCaseRunStartedSubjectContentTriggerV0_2_0.
+func (in *TestCaseRunStartedSubjectContentTriggerV0_2_0) DeepCopy() *TestCaseRunStartedSubjectContentTriggerV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestCaseRunStartedSubjectContentTriggerV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestCaseRunStartedSubjectContentTriggerV0_2_0) Equal(other *TestCaseRunStartedSubjectContentTriggerV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 holds the values defined for a TestCaseType field in the content
+type TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 string
+
+const (
+	TestCaseRunStartedSubjectContentTestCaseTypePerformanceV0_2_0 TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "performance"
+	TestCaseRunStartedSubjectContentTestCaseTypeFunctionalV0_2_0  TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "functional"
+	TestCaseRunStartedSubjectContentTestCaseTypeUnitV0_2_0        TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "unit"
+	TestCaseRunStartedSubjectContentTestCaseTypeSecurityV0_2_0    TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "security"
+	TestCaseRunStartedSubjectContentTestCaseTypeComplianceV0_2_0  TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "compliance"
+	TestCaseRunStartedSubjectContentTestCaseTypeIntegrationV0_2_0 TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "integration"
+	TestCaseRunStartedSubjectContentTestCaseTypeE2eV0_2_0         TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "e2e"
+	TestCaseRunStartedSubjectContentTestCaseTypeOtherV0_2_0       TestCaseRunStartedSubjectContentTestCaseTypeV0_2_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunStartedSubjectContentTestCaseTypePerformanceV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeFunctionalV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeUnitV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeSecurityV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeComplianceV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeIntegrationV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeE2eV0_2_0,
+		TestCaseRunStartedSubjectContentTestCaseTypeOtherV0_2_0,
+	)
+}
+
+// TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 holds the values defined for a TriggerType field in the content
+type TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 string
+
+const (
+	TestCaseRunStartedSubjectContentTriggerTypeManualV0_2_0   TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 = "manual"
+	TestCaseRunStartedSubjectContentTriggerTypePipelineV0_2_0 TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 = "pipeline"
+	TestCaseRunStartedSubjectContentTriggerTypeEventV0_2_0    TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 = "event"
+	TestCaseRunStartedSubjectContentTriggerTypeScheduleV0_2_0 TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 = "schedule"
+	TestCaseRunStartedSubjectContentTriggerTypeOtherV0_2_0    TestCaseRunStartedSubjectContentTriggerTypeV0_2_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestCaseRunStartedSubjectContentTriggerTypeManualV0_2_0,
+		TestCaseRunStartedSubjectContentTriggerTypePipelineV0_2_0,
+		TestCaseRunStartedSubjectContentTriggerTypeEventV0_2_0,
+		TestCaseRunStartedSubjectContentTriggerTypeScheduleV0_2_0,
+		TestCaseRunStartedSubjectContentTriggerTypeOtherV0_2_0,
+	)
+}
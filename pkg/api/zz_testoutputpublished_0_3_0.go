@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -39,7 +40,7 @@ var (
 type TestOutputPublishedSubjectContentV0_3_0 struct {
 	Format string `json:"format"`
 
-	OutputType string `json:"outputType"`
+	OutputType TestOutputPublishedSubjectContentOutputTypeV0_3_0 `json:"outputType" validate:"oneof=report video image log other"`
 
 	TestCaseRun *Reference `json:"testCaseRun,omitempty"`
 
@@ -166,11 +167,11 @@ func (e *TestOutputPublishedEventV0_3_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *TestOutputPublishedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -204,7 +205,7 @@ func (e *TestOutputPublishedEventV0_3_0) SetSubjectFormat(format string) {
 	e.Subject.Content.Format = format
 }
 
-func (e *TestOutputPublishedEventV0_3_0) SetSubjectOutputType(outputType string) {
+func (e *TestOutputPublishedEventV0_3_0) SetSubjectOutputType(outputType TestOutputPublishedSubjectContentOutputTypeV0_3_0) {
 	e.Subject.Content.OutputType = outputType
 }
 
@@ -216,6 +217,96 @@ func (e *TestOutputPublishedEventV0_3_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e TestOutputPublishedEventV0_3_0) GetSubjectFormat() string {
+	return e.Subject.Content.Format
+}
+
+func (e TestOutputPublishedEventV0_3_0) GetSubjectOutputType() TestOutputPublishedSubjectContentOutputTypeV0_3_0 {
+	return e.Subject.Content.OutputType
+}
+
+func (e TestOutputPublishedEventV0_3_0) GetSubjectTestCaseRun() *Reference {
+	return e.Subject.Content.TestCaseRun
+}
+
+func (e TestOutputPublishedEventV0_3_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestOutputPublishedEventV0_3_0) DeepCopyInto(out *TestOutputPublishedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestOutputPublishedEventV0_3_0.
+func (in *TestOutputPublishedEventV0_3_0) DeepCopy() *TestOutputPublishedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestOutputPublishedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestOutputPublishedEventV0_3_0) Equal(other *TestOutputPublishedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestOutputPublishedSubjectV0_3_0) DeepCopyInto(out *TestOutputPublishedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestOutputPublishedSubjectV0_3_0.
+func (in *TestOutputPublishedSubjectV0_3_0) DeepCopy() *TestOutputPublishedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestOutputPublishedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestOutputPublishedSubjectContentV0_3_0) DeepCopyInto(out *TestOutputPublishedSubjectContentV0_3_0) {
+	*out = *in
+	if in.TestCaseRun != nil {
+		in, out := &in.TestCaseRun, &out.TestCaseRun
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestOutputPublishedSubjectContentV0_3_0.
+func (in *TestOutputPublishedSubjectContentV0_3_0) DeepCopy() *TestOutputPublishedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestOutputPublishedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestOutputPublishedSubjectContentV0_3_0) Equal(other *TestOutputPublishedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestOutputPublishedEventV0_3_0
 func NewTestOutputPublishedEventV0_3_0(specVersion string) (*TestOutputPublishedEventV0_3_0, error) {
 	e := &TestOutputPublishedEventV0_3_0{
@@ -237,3 +328,24 @@ func NewTestOutputPublishedEventV0_3_0(specVersion string) (*TestOutputPublished
 	}
 	return e, nil
 }
+
+// TestOutputPublishedSubjectContentOutputTypeV0_3_0 holds the values defined for an OutputType field in the content
+type TestOutputPublishedSubjectContentOutputTypeV0_3_0 string
+
+const (
+	TestOutputPublishedSubjectContentOutputTypeReportV0_3_0 TestOutputPublishedSubjectContentOutputTypeV0_3_0 = "report"
+	TestOutputPublishedSubjectContentOutputTypeVideoV0_3_0  TestOutputPublishedSubjectContentOutputTypeV0_3_0 = "video"
+	TestOutputPublishedSubjectContentOutputTypeImageV0_3_0  TestOutputPublishedSubjectContentOutputTypeV0_3_0 = "image"
+	TestOutputPublishedSubjectContentOutputTypeLogV0_3_0    TestOutputPublishedSubjectContentOutputTypeV0_3_0 = "log"
+	TestOutputPublishedSubjectContentOutputTypeOtherV0_3_0  TestOutputPublishedSubjectContentOutputTypeV0_3_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestOutputPublishedSubjectContentOutputTypeReportV0_3_0,
+		TestOutputPublishedSubjectContentOutputTypeVideoV0_3_0,
+		TestOutputPublishedSubjectContentOutputTypeImageV0_3_0,
+		TestOutputPublishedSubjectContentOutputTypeLogV0_3_0,
+		TestOutputPublishedSubjectContentOutputTypeOtherV0_3_0,
+	)
+}
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -39,11 +40,11 @@ var (
 type TestSuiteRunFinishedSubjectContentV0_3_0 struct {
 	Environment *Reference `json:"environment"`
 
-	Outcome string `json:"outcome"`
+	Outcome TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 `json:"outcome" validate:"oneof=success failure cancel error"`
 
 	Reason string `json:"reason,omitempty"`
 
-	Severity string `json:"severity,omitempty"`
+	Severity TestSuiteRunFinishedSubjectContentSeverityV0_3_0 `json:"severity,omitempty" validate:"omitempty,oneof=low medium high critical"`
 
 	TestSuite *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0 `json:"testSuite,omitempty"`
 }
@@ -168,11 +169,11 @@ func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *TestSuiteRunFinishedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -206,7 +207,7 @@ func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectEnvironment(environment *Ref
 	e.Subject.Content.Environment = environment
 }
 
-func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectOutcome(outcome string) {
+func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectOutcome(outcome TestSuiteRunFinishedSubjectContentOutcomeV0_3_0) {
 	e.Subject.Content.Outcome = outcome
 }
 
@@ -214,7 +215,7 @@ func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectReason(reason string) {
 	e.Subject.Content.Reason = reason
 }
 
-func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectSeverity(severity string) {
+func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectSeverity(severity TestSuiteRunFinishedSubjectContentSeverityV0_3_0) {
 	e.Subject.Content.Severity = severity
 }
 
@@ -222,6 +223,105 @@ func (e *TestSuiteRunFinishedEventV0_3_0) SetSubjectTestSuite(testSuite *TestSui
 	e.Subject.Content.TestSuite = testSuite
 }
 
+// Get subject custom fields
+
+func (e TestSuiteRunFinishedEventV0_3_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestSuiteRunFinishedEventV0_3_0) GetSubjectOutcome() TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 {
+	return e.Subject.Content.Outcome
+}
+
+func (e TestSuiteRunFinishedEventV0_3_0) GetSubjectReason() string {
+	return e.Subject.Content.Reason
+}
+
+func (e TestSuiteRunFinishedEventV0_3_0) GetSubjectSeverity() TestSuiteRunFinishedSubjectContentSeverityV0_3_0 {
+	return e.Subject.Content.Severity
+}
+
+func (e TestSuiteRunFinishedEventV0_3_0) GetSubjectTestSuite() *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0 {
+	return e.Subject.Content.TestSuite
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunFinishedEventV0_3_0) DeepCopyInto(out *TestSuiteRunFinishedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunFinishedEventV0_3_0.
+func (in *TestSuiteRunFinishedEventV0_3_0) DeepCopy() *TestSuiteRunFinishedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunFinishedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestSuiteRunFinishedEventV0_3_0) Equal(other *TestSuiteRunFinishedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunFinishedSubjectV0_3_0) DeepCopyInto(out *TestSuiteRunFinishedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunFinishedSubjectV0_3_0.
+func (in *TestSuiteRunFinishedSubjectV0_3_0) DeepCopy() *TestSuiteRunFinishedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunFinishedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunFinishedSubjectContentV0_3_0) DeepCopyInto(out *TestSuiteRunFinishedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuite != nil {
+		in, out := &in.TestSuite, &out.TestSuite
+		*out = new(TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunFinishedSubjectContentV0_3_0.
+func (in *TestSuiteRunFinishedSubjectContentV0_3_0) DeepCopy() *TestSuiteRunFinishedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunFinishedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunFinishedSubjectContentV0_3_0) Equal(other *TestSuiteRunFinishedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestSuiteRunFinishedEventV0_3_0
 func NewTestSuiteRunFinishedEventV0_3_0(specVersion string) (*TestSuiteRunFinishedEventV0_3_0, error) {
 	e := &TestSuiteRunFinishedEventV0_3_0{
@@ -254,3 +354,61 @@ type TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0 struct {
 
 	Version string `json:"version,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0) DeepCopyInto(out *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0.
+func (in *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0) DeepCopy() *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0) Equal(other *TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 holds the values defined for an Outcome field in the content
+type TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 string
+
+const (
+	TestSuiteRunFinishedSubjectContentOutcomeSuccessV0_3_0 TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 = "success"
+	TestSuiteRunFinishedSubjectContentOutcomeFailureV0_3_0 TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 = "failure"
+	TestSuiteRunFinishedSubjectContentOutcomeCancelV0_3_0  TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 = "cancel"
+	TestSuiteRunFinishedSubjectContentOutcomeErrorV0_3_0   TestSuiteRunFinishedSubjectContentOutcomeV0_3_0 = "error"
+)
+
+func init() {
+	registerEnumValues(
+		TestSuiteRunFinishedSubjectContentOutcomeSuccessV0_3_0,
+		TestSuiteRunFinishedSubjectContentOutcomeFailureV0_3_0,
+		TestSuiteRunFinishedSubjectContentOutcomeCancelV0_3_0,
+		TestSuiteRunFinishedSubjectContentOutcomeErrorV0_3_0,
+	)
+}
+
+// TestSuiteRunFinishedSubjectContentSeverityV0_3_0 holds the values defined for a Severity field in the content
+type TestSuiteRunFinishedSubjectContentSeverityV0_3_0 string
+
+const (
+	TestSuiteRunFinishedSubjectContentSeverityLowV0_3_0      TestSuiteRunFinishedSubjectContentSeverityV0_3_0 = "low"
+	TestSuiteRunFinishedSubjectContentSeverityMediumV0_3_0   TestSuiteRunFinishedSubjectContentSeverityV0_3_0 = "medium"
+	TestSuiteRunFinishedSubjectContentSeverityHighV0_3_0     TestSuiteRunFinishedSubjectContentSeverityV0_3_0 = "high"
+	TestSuiteRunFinishedSubjectContentSeverityCriticalV0_3_0 TestSuiteRunFinishedSubjectContentSeverityV0_3_0 = "critical"
+)
+
+func init() {
+	registerEnumValues(
+		TestSuiteRunFinishedSubjectContentSeverityLowV0_3_0,
+		TestSuiteRunFinishedSubjectContentSeverityMediumV0_3_0,
+		TestSuiteRunFinishedSubjectContentSeverityHighV0_3_0,
+		TestSuiteRunFinishedSubjectContentSeverityCriticalV0_3_0,
+	)
+}
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -164,11 +165,11 @@ func (e *TestSuiteRunQueuedEventV0_3_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TestSuiteRunQueuedEventV0_3_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -210,6 +211,102 @@ func (e *TestSuiteRunQueuedEventV0_3_0) SetSubjectTrigger(trigger *TestSuiteRunQ
 	e.Subject.Content.Trigger = trigger
 }
 
+// Get subject custom fields
+
+func (e TestSuiteRunQueuedEventV0_3_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestSuiteRunQueuedEventV0_3_0) GetSubjectTestSuite() *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0 {
+	return e.Subject.Content.TestSuite
+}
+
+func (e TestSuiteRunQueuedEventV0_3_0) GetSubjectTrigger() *TestSuiteRunQueuedSubjectContentTriggerV0_3_0 {
+	return e.Subject.Content.Trigger
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunQueuedEventV0_3_0) DeepCopyInto(out *TestSuiteRunQueuedEventV0_3_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunQueuedEventV0_3_0.
+func (in *TestSuiteRunQueuedEventV0_3_0) DeepCopy() *TestSuiteRunQueuedEventV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunQueuedEventV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestSuiteRunQueuedEventV0_3_0) Equal(other *TestSuiteRunQueuedEventV0_3_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunQueuedSubjectV0_3_0) DeepCopyInto(out *TestSuiteRunQueuedSubjectV0_3_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunQueuedSubjectV0_3_0.
+func (in *TestSuiteRunQueuedSubjectV0_3_0) DeepCopy() *TestSuiteRunQueuedSubjectV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunQueuedSubjectV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunQueuedSubjectContentV0_3_0) DeepCopyInto(out *TestSuiteRunQueuedSubjectContentV0_3_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuite != nil {
+		in, out := &in.TestSuite, &out.TestSuite
+		*out = new(TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(TestSuiteRunQueuedSubjectContentTriggerV0_3_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunQueuedSubjectContentV0_3_0.
+func (in *TestSuiteRunQueuedSubjectContentV0_3_0) DeepCopy() *TestSuiteRunQueuedSubjectContentV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunQueuedSubjectContentV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunQueuedSubjectContentV0_3_0) Equal(other *TestSuiteRunQueuedSubjectContentV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestSuiteRunQueuedEventV0_3_0
 func NewTestSuiteRunQueuedEventV0_3_0(specVersion string) (*TestSuiteRunQueuedEventV0_3_0, error) {
 	e := &TestSuiteRunQueuedEventV0_3_0{
@@ -243,9 +340,70 @@ type TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0 struct {
 	Version string `json:"version,omitempty"`
 }
 
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0) DeepCopyInto(out *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0.
+func (in *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0) DeepCopy() *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0) Equal(other *TestSuiteRunQueuedSubjectContentTestSuiteV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // TestSuiteRunQueuedSubjectContentTriggerV0_3_0 holds the content of a Trigger field in the content
 type TestSuiteRunQueuedSubjectContentTriggerV0_3_0 struct {
-	Type string `json:"type,omitempty"`
+	Type TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 `json:"type,omitempty" validate:"omitempty,oneof=manual pipeline event schedule other"`
 
 	Uri string `json:"uri,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunQueuedSubjectContentTriggerV0_3_0) DeepCopyInto(out *TestSuiteRunQueuedSubjectContentTriggerV0_3_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunQueuedSubjectContentTriggerV0_3_0.
+func (in *TestSuiteRunQueuedSubjectContentTriggerV0_3_0) DeepCopy() *TestSuiteRunQueuedSubjectContentTriggerV0_3_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunQueuedSubjectContentTriggerV0_3_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunQueuedSubjectContentTriggerV0_3_0) Equal(other *TestSuiteRunQueuedSubjectContentTriggerV0_3_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 holds the values defined for a TriggerType field in the content
+type TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 string
+
+const (
+	TestSuiteRunQueuedSubjectContentTriggerTypeManualV0_3_0   TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 = "manual"
+	TestSuiteRunQueuedSubjectContentTriggerTypePipelineV0_3_0 TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 = "pipeline"
+	TestSuiteRunQueuedSubjectContentTriggerTypeEventV0_3_0    TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 = "event"
+	TestSuiteRunQueuedSubjectContentTriggerTypeScheduleV0_3_0 TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 = "schedule"
+	TestSuiteRunQueuedSubjectContentTriggerTypeOtherV0_3_0    TestSuiteRunQueuedSubjectContentTriggerTypeV0_3_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestSuiteRunQueuedSubjectContentTriggerTypeManualV0_3_0,
+		TestSuiteRunQueuedSubjectContentTriggerTypePipelineV0_3_0,
+		TestSuiteRunQueuedSubjectContentTriggerTypeEventV0_3_0,
+		TestSuiteRunQueuedSubjectContentTriggerTypeScheduleV0_3_0,
+		TestSuiteRunQueuedSubjectContentTriggerTypeOtherV0_3_0,
+	)
+}
@@ -21,6 +21,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -135,11 +136,11 @@ func (e *TestSuiteRunStartedEventV0_1_0) SetSubjectSource(subjectSource string)
 }
 
 func (e *TestSuiteRunStartedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -167,6 +168,102 @@ func (e *TestSuiteRunStartedEventV0_1_0) SetSubjectTrigger(trigger *TestSuiteRun
 	e.Subject.Content.Trigger = trigger
 }
 
+// Get subject custom fields
+
+func (e TestSuiteRunStartedEventV0_1_0) GetSubjectEnvironment() *Reference {
+	return e.Subject.Content.Environment
+}
+
+func (e TestSuiteRunStartedEventV0_1_0) GetSubjectTestSuite() *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0 {
+	return e.Subject.Content.TestSuite
+}
+
+func (e TestSuiteRunStartedEventV0_1_0) GetSubjectTrigger() *TestSuiteRunStartedSubjectContentTriggerV0_1_0 {
+	return e.Subject.Content.Trigger
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunStartedEventV0_1_0) DeepCopyInto(out *TestSuiteRunStartedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunStartedEventV0_1_0.
+func (in *TestSuiteRunStartedEventV0_1_0) DeepCopy() *TestSuiteRunStartedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunStartedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TestSuiteRunStartedEventV0_1_0) Equal(other *TestSuiteRunStartedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunStartedSubjectV0_1_0) DeepCopyInto(out *TestSuiteRunStartedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunStartedSubjectV0_1_0.
+func (in *TestSuiteRunStartedSubjectV0_1_0) DeepCopy() *TestSuiteRunStartedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunStartedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunStartedSubjectContentV0_1_0) DeepCopyInto(out *TestSuiteRunStartedSubjectContentV0_1_0) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestSuite != nil {
+		in, out := &in.TestSuite, &out.TestSuite
+		*out = new(TestSuiteRunStartedSubjectContentTestSuiteV0_1_0)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(TestSuiteRunStartedSubjectContentTriggerV0_1_0)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunStartedSubjectContentV0_1_0.
+func (in *TestSuiteRunStartedSubjectContentV0_1_0) DeepCopy() *TestSuiteRunStartedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunStartedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunStartedSubjectContentV0_1_0) Equal(other *TestSuiteRunStartedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TestSuiteRunStartedEventV0_1_0
 func NewTestSuiteRunStartedEventV0_1_0(specVersion string) (*TestSuiteRunStartedEventV0_1_0, error) {
 	e := &TestSuiteRunStartedEventV0_1_0{
@@ -200,9 +297,70 @@ type TestSuiteRunStartedSubjectContentTestSuiteV0_1_0 struct {
 	Version string `json:"version,omitempty"`
 }
 
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0) DeepCopyInto(out *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunStartedSubjectContentTestSuiteV0_1_0.
+func (in *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0) DeepCopy() *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunStartedSubjectContentTestSuiteV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0) Equal(other *TestSuiteRunStartedSubjectContentTestSuiteV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // TestSuiteRunStartedSubjectContentTriggerV0_1_0 holds the content of a Trigger field in the content
 type TestSuiteRunStartedSubjectContentTriggerV0_1_0 struct {
-	Type string `json:"type,omitempty"`
+	Type TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 `json:"type,omitempty" validate:"omitempty,oneof=manual pipeline event schedule other"`
 
 	Uri string `json:"uri,omitempty"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TestSuiteRunStartedSubjectContentTriggerV0_1_0) DeepCopyInto(out *TestSuiteRunStartedSubjectContentTriggerV0_1_0) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new TestSuiteRunStartedSubjectContentTriggerV0_1_0.
+func (in *TestSuiteRunStartedSubjectContentTriggerV0_1_0) DeepCopy() *TestSuiteRunStartedSubjectContentTriggerV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteRunStartedSubjectContentTriggerV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TestSuiteRunStartedSubjectContentTriggerV0_1_0) Equal(other *TestSuiteRunStartedSubjectContentTriggerV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 holds the values defined for a TriggerType field in the content
+type TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 string
+
+const (
+	TestSuiteRunStartedSubjectContentTriggerTypeManualV0_1_0   TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 = "manual"
+	TestSuiteRunStartedSubjectContentTriggerTypePipelineV0_1_0 TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 = "pipeline"
+	TestSuiteRunStartedSubjectContentTriggerTypeEventV0_1_0    TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 = "event"
+	TestSuiteRunStartedSubjectContentTriggerTypeScheduleV0_1_0 TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 = "schedule"
+	TestSuiteRunStartedSubjectContentTriggerTypeOtherV0_1_0    TestSuiteRunStartedSubjectContentTriggerTypeV0_1_0 = "other"
+)
+
+func init() {
+	registerEnumValues(
+		TestSuiteRunStartedSubjectContentTriggerTypeManualV0_1_0,
+		TestSuiteRunStartedSubjectContentTriggerTypePipelineV0_1_0,
+		TestSuiteRunStartedSubjectContentTriggerTypeEventV0_1_0,
+		TestSuiteRunStartedSubjectContentTriggerTypeScheduleV0_1_0,
+		TestSuiteRunStartedSubjectContentTriggerTypeOtherV0_1_0,
+	)
+}
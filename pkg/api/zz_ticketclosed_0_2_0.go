@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -180,11 +181,11 @@ func (e *TicketClosedEventV0_2_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TicketClosedEventV0_2_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -258,6 +259,129 @@ func (e *TicketClosedEventV0_2_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e TicketClosedEventV0_2_0) GetSubjectAssignees() []string {
+	return e.Subject.Content.Assignees
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectCreator() string {
+	return e.Subject.Content.Creator
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectGroup() string {
+	return e.Subject.Content.Group
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectLabels() []string {
+	return e.Subject.Content.Labels
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectMilestone() string {
+	return e.Subject.Content.Milestone
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectPriority() string {
+	return e.Subject.Content.Priority
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectResolution() string {
+	return e.Subject.Content.Resolution
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectSummary() string {
+	return e.Subject.Content.Summary
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectTicketType() string {
+	return e.Subject.Content.TicketType
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectUpdatedBy() string {
+	return e.Subject.Content.UpdatedBy
+}
+
+func (e TicketClosedEventV0_2_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketClosedEventV0_2_0) DeepCopyInto(out *TicketClosedEventV0_2_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TicketClosedEventV0_2_0.
+func (in *TicketClosedEventV0_2_0) DeepCopy() *TicketClosedEventV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketClosedEventV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TicketClosedEventV0_2_0) Equal(other *TicketClosedEventV0_2_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketClosedSubjectV0_2_0) DeepCopyInto(out *TicketClosedSubjectV0_2_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TicketClosedSubjectV0_2_0.
+func (in *TicketClosedSubjectV0_2_0) DeepCopy() *TicketClosedSubjectV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketClosedSubjectV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketClosedSubjectContentV0_2_0) DeepCopyInto(out *TicketClosedSubjectContentV0_2_0) {
+	*out = *in
+	if in.Assignees != nil {
+		in, out := &in.Assignees, &out.Assignees
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TicketClosedSubjectContentV0_2_0.
+func (in *TicketClosedSubjectContentV0_2_0) DeepCopy() *TicketClosedSubjectContentV0_2_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketClosedSubjectContentV0_2_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TicketClosedSubjectContentV0_2_0) Equal(other *TicketClosedSubjectContentV0_2_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TicketClosedEventV0_2_0
 func NewTicketClosedEventV0_2_0(specVersion string) (*TicketClosedEventV0_2_0, error) {
 	e := &TicketClosedEventV0_2_0{
@@ -279,3 +403,26 @@ func NewTicketClosedEventV0_2_0(specVersion string) (*TicketClosedEventV0_2_0, e
 	}
 	return e, nil
 }
+
+// Values defined for a Priority field in the content, which also accepts free text
+const (
+	TicketClosedSubjectContentPriorityLowV0_2_0    = "low"
+	TicketClosedSubjectContentPriorityMediumV0_2_0 = "medium"
+	TicketClosedSubjectContentPriorityHighV0_2_0   = "high"
+)
+
+// Values defined for a Resolution field in the content, which also accepts free text
+const (
+	TicketClosedSubjectContentResolutionCompletedV0_2_0 = "completed"
+	TicketClosedSubjectContentResolutionWithdrawnV0_2_0 = "withdrawn"
+	TicketClosedSubjectContentResolutionDuplicateV0_2_0 = "duplicate"
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+const (
+	TicketClosedSubjectContentTicketTypeBugV0_2_0         = "bug"
+	TicketClosedSubjectContentTicketTypeEnhancementV0_2_0 = "enhancement"
+	TicketClosedSubjectContentTicketTypeIncidentV0_2_0    = "incident"
+	TicketClosedSubjectContentTicketTypeTaskV0_2_0        = "task"
+	TicketClosedSubjectContentTicketTypeQuestionV0_2_0    = "question"
+)
@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -176,11 +177,11 @@ func (e *TicketCreatedEventV0_1_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TicketCreatedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -246,6 +247,121 @@ func (e *TicketCreatedEventV0_1_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e TicketCreatedEventV0_1_0) GetSubjectAssignees() []string {
+	return e.Subject.Content.Assignees
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectCreator() string {
+	return e.Subject.Content.Creator
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectGroup() string {
+	return e.Subject.Content.Group
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectLabels() []string {
+	return e.Subject.Content.Labels
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectMilestone() string {
+	return e.Subject.Content.Milestone
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectPriority() string {
+	return e.Subject.Content.Priority
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectSummary() string {
+	return e.Subject.Content.Summary
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectTicketType() string {
+	return e.Subject.Content.TicketType
+}
+
+func (e TicketCreatedEventV0_1_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketCreatedEventV0_1_0) DeepCopyInto(out *TicketCreatedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TicketCreatedEventV0_1_0.
+func (in *TicketCreatedEventV0_1_0) DeepCopy() *TicketCreatedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketCreatedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TicketCreatedEventV0_1_0) Equal(other *TicketCreatedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketCreatedSubjectV0_1_0) DeepCopyInto(out *TicketCreatedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TicketCreatedSubjectV0_1_0.
+func (in *TicketCreatedSubjectV0_1_0) DeepCopy() *TicketCreatedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketCreatedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketCreatedSubjectContentV0_1_0) DeepCopyInto(out *TicketCreatedSubjectContentV0_1_0) {
+	*out = *in
+	if in.Assignees != nil {
+		in, out := &in.Assignees, &out.Assignees
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TicketCreatedSubjectContentV0_1_0.
+func (in *TicketCreatedSubjectContentV0_1_0) DeepCopy() *TicketCreatedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketCreatedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TicketCreatedSubjectContentV0_1_0) Equal(other *TicketCreatedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TicketCreatedEventV0_1_0
 func NewTicketCreatedEventV0_1_0(specVersion string) (*TicketCreatedEventV0_1_0, error) {
 	e := &TicketCreatedEventV0_1_0{
@@ -271,3 +387,19 @@ func NewTicketCreatedEventV0_1_0(specVersion string) (*TicketCreatedEventV0_1_0,
 	}
 	return e, nil
 }
+
+// Values defined for a Priority field in the content, which also accepts free text
+const (
+	TicketCreatedSubjectContentPriorityLowV0_1_0    = "low"
+	TicketCreatedSubjectContentPriorityMediumV0_1_0 = "medium"
+	TicketCreatedSubjectContentPriorityHighV0_1_0   = "high"
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+const (
+	TicketCreatedSubjectContentTicketTypeBugV0_1_0         = "bug"
+	TicketCreatedSubjectContentTicketTypeEnhancementV0_1_0 = "enhancement"
+	TicketCreatedSubjectContentTicketTypeIncidentV0_1_0    = "incident"
+	TicketCreatedSubjectContentTicketTypeTaskV0_1_0        = "task"
+	TicketCreatedSubjectContentTicketTypeQuestionV0_1_0    = "question"
+)
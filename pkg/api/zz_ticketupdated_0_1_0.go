@@ -22,6 +22,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -178,11 +179,11 @@ func (e *TicketUpdatedEventV0_1_0) SetSubjectSource(subjectSource string) {
 }
 
 func (e *TicketUpdatedEventV0_1_0) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -252,6 +253,125 @@ func (e *TicketUpdatedEventV0_1_0) SetSubjectUri(uri string) {
 	e.Subject.Content.Uri = uri
 }
 
+// Get subject custom fields
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectAssignees() []string {
+	return e.Subject.Content.Assignees
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectCreator() string {
+	return e.Subject.Content.Creator
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectGroup() string {
+	return e.Subject.Content.Group
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectLabels() []string {
+	return e.Subject.Content.Labels
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectMilestone() string {
+	return e.Subject.Content.Milestone
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectPriority() string {
+	return e.Subject.Content.Priority
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectSummary() string {
+	return e.Subject.Content.Summary
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectTicketType() string {
+	return e.Subject.Content.TicketType
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectUpdatedBy() string {
+	return e.Subject.Content.UpdatedBy
+}
+
+func (e TicketUpdatedEventV0_1_0) GetSubjectUri() string {
+	return e.Subject.Content.Uri
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketUpdatedEventV0_1_0) DeepCopyInto(out *TicketUpdatedEventV0_1_0) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new TicketUpdatedEventV0_1_0.
+func (in *TicketUpdatedEventV0_1_0) DeepCopy() *TicketUpdatedEventV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketUpdatedEventV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *TicketUpdatedEventV0_1_0) Equal(other *TicketUpdatedEventV0_1_0, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketUpdatedSubjectV0_1_0) DeepCopyInto(out *TicketUpdatedSubjectV0_1_0) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new TicketUpdatedSubjectV0_1_0.
+func (in *TicketUpdatedSubjectV0_1_0) DeepCopy() *TicketUpdatedSubjectV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketUpdatedSubjectV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TicketUpdatedSubjectContentV0_1_0) DeepCopyInto(out *TicketUpdatedSubjectContentV0_1_0) {
+	*out = *in
+	if in.Assignees != nil {
+		in, out := &in.Assignees, &out.Assignees
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TicketUpdatedSubjectContentV0_1_0.
+func (in *TicketUpdatedSubjectContentV0_1_0) DeepCopy() *TicketUpdatedSubjectContentV0_1_0 {
+	if in == nil {
+		return nil
+	}
+	out := new(TicketUpdatedSubjectContentV0_1_0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *TicketUpdatedSubjectContentV0_1_0) Equal(other *TicketUpdatedSubjectContentV0_1_0) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new TicketUpdatedEventV0_1_0
 func NewTicketUpdatedEventV0_1_0(specVersion string) (*TicketUpdatedEventV0_1_0, error) {
 	e := &TicketUpdatedEventV0_1_0{
@@ -277,3 +397,19 @@ func NewTicketUpdatedEventV0_1_0(specVersion string) (*TicketUpdatedEventV0_1_0,
 	}
 	return e, nil
 }
+
+// Values defined for a Priority field in the content, which also accepts free text
+const (
+	TicketUpdatedSubjectContentPriorityLowV0_1_0    = "low"
+	TicketUpdatedSubjectContentPriorityMediumV0_1_0 = "medium"
+	TicketUpdatedSubjectContentPriorityHighV0_1_0   = "high"
+)
+
+// Values defined for a TicketType field in the content, which also accepts free text
+const (
+	TicketUpdatedSubjectContentTicketTypeBugV0_1_0         = "bug"
+	TicketUpdatedSubjectContentTicketTypeEnhancementV0_1_0 = "enhancement"
+	TicketUpdatedSubjectContentTicketTypeIncidentV0_1_0    = "incident"
+	TicketUpdatedSubjectContentTicketTypeTaskV0_1_0        = "task"
+	TicketUpdatedSubjectContentTicketTypeQuestionV0_1_0    = "question"
+)
@@ -24,6 +24,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -39,7 +40,7 @@ var (
 )
 
 type FooSubjectBarPredicateSubjectContentV1_2_3 struct {
-	ArtifactId string `json:"artifactId,omitempty" validate:"purl"`
+	ArtifactId string `json:"artifactId,omitempty" validate:"omitempty,purl"`
 
 	ObjectField *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3 `json:"objectField,omitempty"`
 
@@ -168,11 +169,11 @@ func (e *FooSubjectBarPredicateEventV1_2_3) SetSubjectSource(subjectSource strin
 }
 
 func (e *FooSubjectBarPredicateEventV1_2_3) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -218,6 +219,101 @@ func (e *FooSubjectBarPredicateEventV1_2_3) SetSubjectReferenceField(referenceFi
 	e.Subject.Content.ReferenceField = referenceField
 }
 
+// Get subject custom fields
+
+func (e FooSubjectBarPredicateEventV1_2_3) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e FooSubjectBarPredicateEventV1_2_3) GetSubjectObjectField() *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3 {
+	return e.Subject.Content.ObjectField
+}
+
+func (e FooSubjectBarPredicateEventV1_2_3) GetSubjectPlainField() string {
+	return e.Subject.Content.PlainField
+}
+
+func (e FooSubjectBarPredicateEventV1_2_3) GetSubjectReferenceField() *Reference {
+	return e.Subject.Content.ReferenceField
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateEventV1_2_3) DeepCopyInto(out *FooSubjectBarPredicateEventV1_2_3) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateEventV1_2_3.
+func (in *FooSubjectBarPredicateEventV1_2_3) DeepCopy() *FooSubjectBarPredicateEventV1_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateEventV1_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *FooSubjectBarPredicateEventV1_2_3) Equal(other *FooSubjectBarPredicateEventV1_2_3, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateSubjectV1_2_3) DeepCopyInto(out *FooSubjectBarPredicateSubjectV1_2_3) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateSubjectV1_2_3.
+func (in *FooSubjectBarPredicateSubjectV1_2_3) DeepCopy() *FooSubjectBarPredicateSubjectV1_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateSubjectV1_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateSubjectContentV1_2_3) DeepCopyInto(out *FooSubjectBarPredicateSubjectContentV1_2_3) {
+	*out = *in
+	if in.ObjectField != nil {
+		in, out := &in.ObjectField, &out.ObjectField
+		*out = new(FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReferenceField != nil {
+		in, out := &in.ReferenceField, &out.ReferenceField
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateSubjectContentV1_2_3.
+func (in *FooSubjectBarPredicateSubjectContentV1_2_3) DeepCopy() *FooSubjectBarPredicateSubjectContentV1_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateSubjectContentV1_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *FooSubjectBarPredicateSubjectContentV1_2_3) Equal(other *FooSubjectBarPredicateSubjectContentV1_2_3) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new FooSubjectBarPredicateEventV1_2_3
 func NewFooSubjectBarPredicateEventV1_2_3(specVersion string) (*FooSubjectBarPredicateEventV1_2_3, error) {
 	e := &FooSubjectBarPredicateEventV1_2_3{
@@ -250,3 +346,23 @@ type FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3 struct {
 
 	Required string `json:"required"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3) DeepCopyInto(out *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3.
+func (in *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3) DeepCopy() *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3) Equal(other *FooSubjectBarPredicateSubjectContentObjectFieldV1_2_3) bool {
+	return reflect.DeepEqual(c, other)
+}
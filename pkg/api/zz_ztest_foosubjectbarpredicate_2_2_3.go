@@ -24,6 +24,7 @@ package api
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
@@ -39,7 +40,9 @@ var (
 )
 
 type FooSubjectBarPredicateSubjectContentV2_2_3 struct {
-	ArtifactId string `json:"artifactId,omitempty" validate:"purl"`
+	ArtifactId string `json:"artifactId,omitempty" validate:"omitempty,purl"`
+
+	Mode FooSubjectBarPredicateSubjectContentModeV2_2_3 `json:"mode,omitempty" validate:"omitempty,oneof='dry run' apply"`
 
 	ObjectField *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3 `json:"objectField,omitempty"`
 
@@ -168,11 +171,11 @@ func (e *FooSubjectBarPredicateEventV2_2_3) SetSubjectSource(subjectSource strin
 }
 
 func (e *FooSubjectBarPredicateEventV2_2_3) SetCustomData(contentType string, data interface{}) error {
-	err := CheckCustomData(contentType, data)
+	customData, err := EncodeCustomData(contentType, data)
 	if err != nil {
 		return err
 	}
-	e.CustomData = data
+	e.CustomData = customData
 	e.CustomDataContentType = contentType
 	return nil
 }
@@ -206,6 +209,10 @@ func (e *FooSubjectBarPredicateEventV2_2_3) SetSubjectArtifactId(artifactId stri
 	e.Subject.Content.ArtifactId = artifactId
 }
 
+func (e *FooSubjectBarPredicateEventV2_2_3) SetSubjectMode(mode FooSubjectBarPredicateSubjectContentModeV2_2_3) {
+	e.Subject.Content.Mode = mode
+}
+
 func (e *FooSubjectBarPredicateEventV2_2_3) SetSubjectObjectField(objectField *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3) {
 	e.Subject.Content.ObjectField = objectField
 }
@@ -218,6 +225,105 @@ func (e *FooSubjectBarPredicateEventV2_2_3) SetSubjectReferenceField(referenceFi
 	e.Subject.Content.ReferenceField = referenceField
 }
 
+// Get subject custom fields
+
+func (e FooSubjectBarPredicateEventV2_2_3) GetSubjectArtifactId() string {
+	return e.Subject.Content.ArtifactId
+}
+
+func (e FooSubjectBarPredicateEventV2_2_3) GetSubjectMode() FooSubjectBarPredicateSubjectContentModeV2_2_3 {
+	return e.Subject.Content.Mode
+}
+
+func (e FooSubjectBarPredicateEventV2_2_3) GetSubjectObjectField() *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3 {
+	return e.Subject.Content.ObjectField
+}
+
+func (e FooSubjectBarPredicateEventV2_2_3) GetSubjectPlainField() string {
+	return e.Subject.Content.PlainField
+}
+
+func (e FooSubjectBarPredicateEventV2_2_3) GetSubjectReferenceField() *Reference {
+	return e.Subject.Content.ReferenceField
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateEventV2_2_3) DeepCopyInto(out *FooSubjectBarPredicateEventV2_2_3) {
+	*out = *in
+	in.Context.DeepCopyInto(&out.Context)
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.CDEventCustomData.DeepCopyInto(&out.CDEventCustomData)
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateEventV2_2_3.
+func (in *FooSubjectBarPredicateEventV2_2_3) DeepCopy() *FooSubjectBarPredicateEventV2_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateEventV2_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if e and other are deeply equal. With IgnoreId and
+// IgnoreTimestamp, events produced again for the same occurrence are equal.
+func (e *FooSubjectBarPredicateEventV2_2_3) Equal(other *FooSubjectBarPredicateEventV2_2_3, opts ...EqualOption) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	a, b := e.DeepCopy(), other.DeepCopy()
+	if !compareAndClearSharedContext(&a.Context.SharedContext, &b.Context.SharedContext, opts) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateSubjectV2_2_3) DeepCopyInto(out *FooSubjectBarPredicateSubjectV2_2_3) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateSubjectV2_2_3.
+func (in *FooSubjectBarPredicateSubjectV2_2_3) DeepCopy() *FooSubjectBarPredicateSubjectV2_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateSubjectV2_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateSubjectContentV2_2_3) DeepCopyInto(out *FooSubjectBarPredicateSubjectContentV2_2_3) {
+	*out = *in
+	if in.ObjectField != nil {
+		in, out := &in.ObjectField, &out.ObjectField
+		*out = new(FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReferenceField != nil {
+		in, out := &in.ReferenceField, &out.ReferenceField
+		*out = new(Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateSubjectContentV2_2_3.
+func (in *FooSubjectBarPredicateSubjectContentV2_2_3) DeepCopy() *FooSubjectBarPredicateSubjectContentV2_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateSubjectContentV2_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *FooSubjectBarPredicateSubjectContentV2_2_3) Equal(other *FooSubjectBarPredicateSubjectContentV2_2_3) bool {
+	return reflect.DeepEqual(c, other)
+}
+
 // New creates a new FooSubjectBarPredicateEventV2_2_3
 func NewFooSubjectBarPredicateEventV2_2_3(specVersion string) (*FooSubjectBarPredicateEventV2_2_3, error) {
 	e := &FooSubjectBarPredicateEventV2_2_3{
@@ -250,3 +356,38 @@ type FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3 struct {
 
 	Required string `json:"required"`
 }
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3) DeepCopyInto(out *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3) {
+	*out = *in
+}
+
+// DeepCopy copies the receiver, creating a new FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3.
+func (in *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3) DeepCopy() *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// Equal returns true if c and other are deeply equal
+func (c *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3) Equal(other *FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// FooSubjectBarPredicateSubjectContentModeV2_2_3 holds the values defined for a Mode field in the content
+type FooSubjectBarPredicateSubjectContentModeV2_2_3 string
+
+const (
+	FooSubjectBarPredicateSubjectContentModeDryRunV2_2_3 FooSubjectBarPredicateSubjectContentModeV2_2_3 = "dry run"
+	FooSubjectBarPredicateSubjectContentModeApplyV2_2_3  FooSubjectBarPredicateSubjectContentModeV2_2_3 = "apply"
+)
+
+func init() {
+	registerEnumValues(
+		FooSubjectBarPredicateSubjectContentModeDryRunV2_2_3,
+		FooSubjectBarPredicateSubjectContentModeApplyV2_2_3,
+	)
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package bus delivers CDEvents between the components of a process.
+//
+// Each subscription has a bounded queue and a goroutine that calls its
+// handler, so slow subscribers do not delay the others. When the queue of a
+// subscription is full, publishing blocks or drops an event, depending on
+// the Policy of the subscription.
+package bus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/cdevents/sdk-go/pkg/filter"
+)
+
+// DefaultQueueSize is the size of the queue of subscriptions by default
+const DefaultQueueSize = 64
+
+// ErrClosed is returned when publishing to or subscribing on a bus that is
+// shut down
+var ErrClosed = errors.New("bus is closed")
+
+// Policy defines what happens when an event is published to a subscription
+// whose queue is full
+type Policy int
+
+const (
+	// Block blocks the publisher until there is room in the queue
+	Block Policy = iota
+	// DropNewest drops the event being published
+	DropNewest
+	// DropOldest drops the oldest event in the queue to make room
+	DropOldest
+)
+
+// Handler processes the events of a subscription. The context is canceled
+// when the bus is shut down and the shutdown deadline expires.
+type Handler func(ctx context.Context, event api.CDEventReader)
+
+// Option configures subscriptions. Options passed to New set the defaults
+// for all the subscriptions of the bus.
+type Option func(*config)
+
+type config struct {
+	queueSize int
+	policy    Policy
+}
+
+// WithQueueSize sets the size of the queue of subscriptions
+func WithQueueSize(size int) Option {
+	return func(c *config) {
+		if size > 0 {
+			c.queueSize = size
+		}
+	}
+}
+
+// WithPolicy sets what happens when the queue of a subscription is full
+func WithPolicy(policy Policy) Option {
+	return func(c *config) {
+		c.policy = policy
+	}
+}
+
+// Bus delivers published events to the matching subscriptions. A Bus is
+// safe for concurrent use.
+type Bus struct {
+	defaults []Option
+
+	// ctx is passed to handlers, and canceled when shutdown times out
+	ctx    context.Context
+	cancel context.CancelFunc
+	// done is closed when shutdown starts, to release blocked publishers
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu            sync.RWMutex
+	closed        bool
+	subscriptions map[*Subscription]struct{}
+	workers       sync.WaitGroup
+}
+
+// New creates a Bus. The options set the defaults of its subscriptions.
+func New(opts ...Option) *Bus {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Bus{
+		defaults:      opts,
+		ctx:           ctx,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+		subscriptions: map[*Subscription]struct{}{},
+	}
+}
+
+// Publish delivers an event to the matching subscriptions, see Send
+func (b *Bus) Publish(event api.CDEventReader) error {
+	return b.Send(context.Background(), event)
+}
+
+// Send delivers an event to the matching subscriptions. With the Block
+// policy, it waits until there is room in their queues, ctx is done or the
+// bus is shut down. Events are not validated. Send implements sender.Sender,
+// so that producers can publish to the bus.
+//
+// Handlers must not publish to a subscription with the Block policy that
+// they are consuming from, as that may deadlock when its queue is full.
+func (b *Bus) Send(ctx context.Context, event api.CDEventReader) error {
+	// The lock of the bus is not held while blocking on a queue, so that
+	// handlers can publish and subscribe meanwhile
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return ErrClosed
+	}
+	matching := make([]*Subscription, 0, len(b.subscriptions))
+	for s := range b.subscriptions {
+		if !s.unsubscribed.Load() && s.match(event) {
+			matching = append(matching, s)
+		}
+	}
+	b.mu.RUnlock()
+	for _, s := range matching {
+		if err := s.enqueue(ctx, event, b.done); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe delivers the events whose type is compatible with eventType to
+// handler, i.e. events with the same subject, predicate and major version
+func (b *Bus) Subscribe(eventType api.CDEventType, handler Handler, opts ...Option) (*Subscription, error) {
+	return b.SubscribeFilter(filter.ForType(eventType), handler, opts...)
+}
+
+// SubscribeFilter delivers the events that match f to handler
+func (b *Bus) SubscribeFilter(f *filter.Filter, handler Handler, opts ...Option) (*Subscription, error) {
+	return b.subscribe(f.Match, handler, opts)
+}
+
+// SubscribeTo delivers the events of Go type E to handler, e.g.
+//
+//	bus.SubscribeTo(b, func(ctx context.Context, event *cdeventsv05.ServiceDeployedEvent) {
+//		...
+//	})
+func SubscribeTo[E api.CDEventReader](b *Bus, handler func(ctx context.Context, event E), opts ...Option) (*Subscription, error) {
+	match := func(event api.CDEventReader) bool {
+		_, ok := event.(E)
+		return ok
+	}
+	return b.subscribe(match, func(ctx context.Context, event api.CDEventReader) {
+		handler(ctx, event.(E))
+	}, opts)
+}
+
+func (b *Bus) subscribe(match func(api.CDEventReader) bool, handler Handler, opts []Option) (*Subscription, error) {
+	c := config{queueSize: DefaultQueueSize, policy: Block}
+	for _, opt := range append(b.defaults, opts...) {
+		opt(&c)
+	}
+	s := &Subscription{
+		bus:     b,
+		match:   match,
+		handler: handler,
+		policy:  c.policy,
+		queue:   make(chan api.CDEventReader, c.queueSize),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+	b.subscriptions[s] = struct{}{}
+	b.workers.Add(1)
+	go s.run(b.ctx, &b.workers)
+	return s, nil
+}
+
+func (b *Bus) remove(s *Subscription) {
+	b.mu.Lock()
+	_, ok := b.subscriptions[s]
+	delete(b.subscriptions, s)
+	b.mu.Unlock()
+	if ok {
+		s.close()
+	}
+}
+
+// Shutdown stops accepting events and subscriptions, and waits until the
+// subscriptions have processed the events in their queues. When ctx is
+// done first, the context of the handlers is canceled, the events left in
+// the queues are dropped and the error of ctx is returned.
+func (b *Bus) Shutdown(ctx context.Context) error {
+	b.closeOnce.Do(func() {
+		// Closing done first releases the publishers blocked on queues,
+		// so that the subscriptions can be closed
+		close(b.done)
+		b.mu.Lock()
+		b.closed = true
+		subscriptions := b.subscriptions
+		b.subscriptions = map[*Subscription]struct{}{}
+		b.mu.Unlock()
+		for s := range subscriptions {
+			s.close()
+		}
+	})
+	drained := make(chan struct{})
+	go func() {
+		b.workers.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		b.cancel()
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// Subscription is the subscription of a handler to the events of a bus
+type Subscription struct {
+	bus     *Bus
+	match   func(api.CDEventReader) bool
+	handler Handler
+	policy  Policy
+	queue   chan api.CDEventReader
+	// mu serializes publishers, so that dropping the oldest event and
+	// queueing the new one happen together, and guards closed so that
+	// events are not queued once the queue is closed
+	mu           sync.Mutex
+	closed       bool
+	dropped      atomic.Uint64
+	unsubscribed atomic.Bool
+}
+
+// Dropped returns the number of events dropped because the queue of the
+// subscription was full
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe stops the delivery of new events to the subscription. Events
+// already in its queue are still processed. It may be called from the
+// handler of the subscription.
+func (s *Subscription) Unsubscribe() {
+	if !s.unsubscribed.CompareAndSwap(false, true) {
+		return
+	}
+	// Publishers blocked on the queue hold the lock of the subscription
+	// until the handler makes room, so the subscription is removed
+	// asynchronously
+	go s.bus.remove(s)
+}
+
+func (s *Subscription) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	close(s.queue)
+}
+
+func (s *Subscription) enqueue(ctx context.Context, event api.CDEventReader, done <-chan struct{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		// The subscription was removed after the event was matched
+		select {
+		case <-done:
+			return ErrClosed
+		default:
+			return nil
+		}
+	}
+	select {
+	case s.queue <- event:
+		return nil
+	default:
+	}
+	switch s.policy {
+	case DropNewest:
+		s.dropped.Add(1)
+		return nil
+	case DropOldest:
+		// The handler may have taken the oldest event meanwhile
+		select {
+		case <-s.queue:
+			s.dropped.Add(1)
+		default:
+		}
+		s.queue <- event
+		return nil
+	}
+	select {
+	case s.queue <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return ErrClosed
+	}
+}
+
+func (s *Subscription) run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for event := range s.queue {
+		if ctx.Err() != nil {
+			continue
+		}
+		s.handler(ctx, event)
+	}
+}
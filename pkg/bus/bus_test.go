@@ -0,0 +1,423 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bus_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/bus"
+	"github.com/cdevents/sdk-go/pkg/filter"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testSource = "/monolith/deployer"
+
+// Producers can publish to the bus through the Sender interface
+var _ sender.Sender = &bus.Bus{}
+
+func serviceDeployed(t *testing.T, id, environment string) api.CDEventReader {
+	t.Helper()
+	event, err := cdeventsv05.NewServiceDeployedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetSubjectId(id)
+	event.SetSubjectEnvironment(&api.Reference{Id: environment})
+	return event
+}
+
+func pipelineRunStarted(t *testing.T, id string) api.CDEventReader {
+	t.Helper()
+	event, err := cdeventsv05.NewPipelineRunStartedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetSubjectId(id)
+	event.SetSubjectPipelineName("release")
+	return event
+}
+
+// recorder collects the subject ids of the events it handles
+type recorder struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (r *recorder) handle(_ context.Context, event api.CDEventReader) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, event.GetSubjectId())
+}
+
+func (r *recorder) got() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.ids...)
+}
+
+func shutdown(t *testing.T, b *bus.Bus) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := b.Shutdown(ctx); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	b := bus.New()
+	var byType, byFilter, typed recorder
+	if _, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, byType.handle); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	prod := filter.MustCompile(`has(subject.content.environment) && subject.content.environment.id == "prod"`)
+	if _, err := b.SubscribeFilter(prod, byFilter.handle); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	_, err := bus.SubscribeTo(b, func(ctx context.Context, event *cdeventsv05.PipelineRunStartedEvent) {
+		typed.handle(ctx, event)
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	events := []api.CDEventReader{
+		serviceDeployed(t, "app-1", "prod"),
+		pipelineRunStarted(t, "run-1"),
+		serviceDeployed(t, "app-2", "staging"),
+	}
+	for _, event := range events {
+		if err := b.Publish(event); err != nil {
+			t.Fatalf("didn't expect an error, got %v", err)
+		}
+	}
+	shutdown(t, b)
+	if d := cmp.Diff([]string{"app-1", "app-2"}, byType.got()); d != "" {
+		t.Errorf("by type diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff([]string{"app-1"}, byFilter.got()); d != "" {
+		t.Errorf("by filter diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff([]string{"run-1"}, typed.got()); d != "" {
+		t.Errorf("typed diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      bus.Policy
+		wantIds     []string
+		wantDropped uint64
+	}{{
+		name:        "drop newest",
+		policy:      bus.DropNewest,
+		wantIds:     []string{"app-1", "app-2"},
+		wantDropped: 1,
+	}, {
+		name:        "drop oldest",
+		policy:      bus.DropOldest,
+		wantIds:     []string{"app-1", "app-3"},
+		wantDropped: 1,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b := bus.New(bus.WithQueueSize(1), bus.WithPolicy(tc.policy))
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var r recorder
+			s, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(ctx context.Context, event api.CDEventReader) {
+				if event.GetSubjectId() == "app-1" {
+					close(started)
+					<-release
+				}
+				r.handle(ctx, event)
+			})
+			if err != nil {
+				t.Fatalf("didn't expect an error, got %v", err)
+			}
+			// app-1 blocks the handler, app-2 fills the queue, app-3 overflows
+			if err := b.Publish(serviceDeployed(t, "app-1", "prod")); err != nil {
+				t.Fatalf("didn't expect an error, got %v", err)
+			}
+			<-started
+			for _, id := range []string{"app-2", "app-3"} {
+				if err := b.Publish(serviceDeployed(t, id, "prod")); err != nil {
+					t.Fatalf("didn't expect an error, got %v", err)
+				}
+			}
+			close(release)
+			shutdown(t, b)
+			if d := cmp.Diff(tc.wantIds, r.got()); d != "" {
+				t.Errorf("handled diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantDropped, s.Dropped()); d != "" {
+				t.Errorf("dropped diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestBlockPolicy(t *testing.T) {
+	b := bus.New(bus.WithQueueSize(1))
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var r recorder
+	_, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(ctx context.Context, event api.CDEventReader) {
+		if event.GetSubjectId() == "app-1" {
+			close(started)
+			<-release
+		}
+		r.handle(ctx, event)
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	if err := b.Publish(serviceDeployed(t, "app-1", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	<-started
+	if err := b.Publish(serviceDeployed(t, "app-2", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	// The queue is full, so publishing blocks until ctx is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := b.Send(ctx, serviceDeployed(t, "app-3", "prod")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	// Once the handler makes room, a blocked publisher goes through
+	published := make(chan error)
+	go func() {
+		published <- b.Publish(serviceDeployed(t, "app-4", "prod"))
+	}()
+	close(release)
+	if err := <-published; err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	shutdown(t, b)
+	if d := cmp.Diff([]string{"app-1", "app-2", "app-4"}, r.got()); d != "" {
+		t.Errorf("handled diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestBlockedPublisherConcurrentSubscribe(t *testing.T) {
+	b := bus.New(bus.WithQueueSize(1))
+	var runs recorder
+	if _, err := b.Subscribe(cdeventsv05.PipelineRunStartedEventType, runs.handle); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var r recorder
+	// The handler publishes to another subscription while a publisher is
+	// blocked on its own queue and a subscriber waits for the bus
+	_, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(ctx context.Context, event api.CDEventReader) {
+		if event.GetSubjectId() == "app-1" {
+			close(started)
+			<-release
+			if err := b.Publish(pipelineRunStarted(t, "run-1")); err != nil {
+				t.Errorf("didn't expect an error, got %v", err)
+			}
+		}
+		r.handle(ctx, event)
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	if err := b.Publish(serviceDeployed(t, "app-1", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	<-started
+	if err := b.Publish(serviceDeployed(t, "app-2", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	published := make(chan error, 1)
+	go func() {
+		published <- b.Publish(serviceDeployed(t, "app-3", "prod"))
+	}()
+	subscribed := make(chan error, 1)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(context.Context, api.CDEventReader) {})
+		subscribed <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	for name, result := range map[string]chan error{"publish": published, "subscribe": subscribed} {
+		select {
+		case err := <-result:
+			if err != nil {
+				t.Fatalf("didn't expect an error from %s, got %v", name, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s deadlocked", name)
+		}
+	}
+	shutdown(t, b)
+	if d := cmp.Diff([]string{"app-1", "app-2", "app-3"}, r.got()); d != "" {
+		t.Errorf("handled diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff([]string{"run-1"}, runs.got()); d != "" {
+		t.Errorf("runs diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestShutdownDrains(t *testing.T) {
+	b := bus.New(bus.WithQueueSize(100))
+	var r recorder
+	_, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(ctx context.Context, event api.CDEventReader) {
+		time.Sleep(time.Millisecond)
+		r.handle(ctx, event)
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	var want []string
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("app-%02d", i)
+		want = append(want, id)
+		if err := b.Publish(serviceDeployed(t, id, "prod")); err != nil {
+			t.Fatalf("didn't expect an error, got %v", err)
+		}
+	}
+	shutdown(t, b)
+	if d := cmp.Diff(want, r.got()); d != "" {
+		t.Errorf("handled diff(-want,+got):\n%s", d)
+	}
+	if err := b.Publish(serviceDeployed(t, "late", "prod")); !errors.Is(err, bus.ErrClosed) {
+		t.Errorf("expected ErrClosed, got %v", err)
+	}
+	if _, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, r.handle); !errors.Is(err, bus.ErrClosed) {
+		t.Errorf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	b := bus.New()
+	canceled := make(chan struct{})
+	_, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(ctx context.Context, _ api.CDEventReader) {
+		<-ctx.Done()
+		close(canceled)
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	if err := b.Publish(serviceDeployed(t, "app-1", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := b.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the handler context to be canceled")
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	b := bus.New()
+	var r recorder
+	var s *bus.Subscription
+	var err error
+	// Unsubscribing from the handler does not deadlock
+	s, err = b.Subscribe(cdeventsv05.ServiceDeployedEventType, func(ctx context.Context, event api.CDEventReader) {
+		s.Unsubscribe()
+		r.handle(ctx, event)
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	if err := b.Publish(serviceDeployed(t, "app-1", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(r.got()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if err := b.Publish(serviceDeployed(t, "app-2", "prod")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	shutdown(t, b)
+	if d := cmp.Diff([]string{"app-1"}, r.got()); d != "" {
+		t.Errorf("handled diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestConcurrentPublishers(t *testing.T) {
+	b := bus.New(bus.WithQueueSize(4))
+	var all, prod recorder
+	if _, err := b.Subscribe(cdeventsv05.ServiceDeployedEventType, all.handle); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	f := filter.MustCompile(`subject.content.environment.id == "prod"`)
+	if _, err := b.SubscribeFilter(f, prod.handle, bus.WithPolicy(bus.Block)); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	var wg sync.WaitGroup
+	var want, wantProd []string
+	for p := 0; p < 8; p++ {
+		environment := "staging"
+		if p%2 == 0 {
+			environment = "prod"
+		}
+		var events []api.CDEventReader
+		for i := 0; i < 25; i++ {
+			id := fmt.Sprintf("app-%d-%02d", p, i)
+			want = append(want, id)
+			if environment == "prod" {
+				wantProd = append(wantProd, id)
+			}
+			events = append(events, serviceDeployed(t, id, environment))
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, event := range events {
+				if err := b.Publish(event); err != nil {
+					t.Errorf("didn't expect an error, got %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	shutdown(t, b)
+	got, gotProd := all.got(), prod.got()
+	sort.Strings(got)
+	sort.Strings(gotProd)
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("all diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(wantProd, gotProd); d != "" {
+		t.Errorf("prod diff(-want,+got):\n%s", d)
+	}
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cdeventsotel_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/cdeventsotel"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	testSource      = "/ci/pipelines"
+	testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	testTraceState  = "vendor=value"
+)
+
+var testStart = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func newTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+func remoteContext(t *testing.T) context.Context {
+	t.Helper()
+	ce := cloudevents.NewEvent()
+	ce.SetExtension(extensions.TraceParentExtension, testTraceParent)
+	ce.SetExtension(extensions.TraceStateExtension, testTraceState)
+	ctx := cdeventsotel.ExtractTraceContext(context.Background(), ce)
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		t.Fatalf("expected a valid remote span context")
+	}
+	return ctx
+}
+
+func TestTraceContextRoundTrip(t *testing.T) {
+	ctx := remoteContext(t)
+	event, err := cdeventsv05.NewPipelineRunStartedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetSubjectId("run-1")
+	event.SetSubjectPipelineName("release")
+
+	ce, err := cdeventsotel.AsCloudEvent(ctx, event)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	want := map[string]interface{}{
+		extensions.TraceParentExtension: testTraceParent,
+		extensions.TraceStateExtension:  testTraceState,
+	}
+	if d := cmp.Diff(want, ce.Extensions()); d != "" {
+		t.Errorf("extensions diff(-want,+got):\n%s", d)
+	}
+
+	got := trace.SpanContextFromContext(cdeventsotel.ExtractTraceContext(context.Background(), *ce))
+	if d := cmp.Diff(trace.SpanContextFromContext(ctx).TraceID(), got.TraceID()); d != "" {
+		t.Errorf("trace id diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(testTraceState, got.TraceState().String()); d != "" {
+		t.Errorf("trace state diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestTraceContextNoSpan(t *testing.T) {
+	event, err := cdeventsv05.NewPipelineRunStartedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetSubjectId("run-1")
+	ce, err := cdeventsotel.AsCloudEvent(context.Background(), event)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	if len(ce.Extensions()) != 0 {
+		t.Errorf("expected no extensions, got %v", ce.Extensions())
+	}
+	ctx := context.Background()
+	if got := cdeventsotel.ExtractTraceContext(ctx, *ce); got != ctx {
+		t.Errorf("expected the context to be unchanged")
+	}
+}
+
+func pipelineRunEvents(t *testing.T, outcome cdeventsv05.PipelineRunFinishedSubjectContentOutcome) (api.CDEventReader, api.CDEventReader) {
+	t.Helper()
+	started, err := cdeventsv05.NewPipelineRunStartedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	started.SetSource(testSource)
+	started.SetTimestamp(testStart)
+	started.SetSubjectId("run-1")
+	started.SetSubjectPipelineName("release")
+	started.SetChainId("6ca3f9c5-1cef-4ce0-861c-2456a69cf137")
+
+	finished, err := cdeventsv05.NewPipelineRunFinishedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	finished.SetSource(testSource)
+	finished.SetTimestamp(testStart.Add(10 * time.Minute))
+	finished.SetSubjectId("run-1")
+	finished.SetSubjectPipelineName("release")
+	finished.SetSubjectOutcome(outcome)
+	if outcome == "failure" {
+		finished.SetSubjectErrors("step deploy failed")
+	}
+	return started, finished
+}
+
+func TestSpanBuilderPipelineRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		outcome    cdeventsv05.PipelineRunFinishedSubjectContentOutcome
+		wantStatus sdktrace.Status
+	}{{
+		name:    "success",
+		outcome: "success",
+	}, {
+		name:       "failure",
+		outcome:    "failure",
+		wantStatus: sdktrace.Status{Code: codes.Error, Description: "step deploy failed"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tp, exporter := newTracerProvider()
+			builder := cdeventsotel.NewSpanBuilder(tp,
+				cdeventsotel.WithAttributes(attribute.String("deployment.environment", "prod")))
+			started, finished := pipelineRunEvents(t, tc.outcome)
+			ctx := remoteContext(t)
+			for _, event := range []api.CDEventReader{started, finished} {
+				if err := builder.Observe(ctx, event); err != nil {
+					t.Fatalf("didn't expect an error, got %v", err)
+				}
+			}
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected one span, got %d", len(spans))
+			}
+			span := spans[0]
+			if d := cmp.Diff("pipelinerun release", span.Name); d != "" {
+				t.Errorf("name diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(testStart, span.StartTime); d != "" {
+				t.Errorf("start time diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(testStart.Add(10*time.Minute), span.EndTime); d != "" {
+				t.Errorf("end time diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(trace.SpanContextFromContext(ctx).TraceID(), span.Parent.TraceID()); d != "" {
+				t.Errorf("parent trace id diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantStatus, span.Status); d != "" {
+				t.Errorf("status diff(-want,+got):\n%s", d)
+			}
+			attrs := attribute.NewSet(span.Attributes...)
+			wantAttrs := map[attribute.Key]attribute.Value{
+				"deployment.environment":                attribute.StringValue("prod"),
+				cdeventsotel.AttributeEventType:         attribute.StringValue(finished.GetType().String()),
+				cdeventsotel.AttributeSubjectId:         attribute.StringValue("run-1"),
+				cdeventsotel.AttributeSubjectSource:     attribute.StringValue(testSource),
+				cdeventsotel.AttributeChainId:           attribute.StringValue("6ca3f9c5-1cef-4ce0-861c-2456a69cf137"),
+				"cdevents.subject.content.pipelineName": attribute.StringValue("release"),
+				"cdevents.subject.content.outcome":      attribute.StringValue(string(tc.outcome)),
+			}
+			for key, want := range wantAttrs {
+				got, ok := attrs.Value(key)
+				if !ok {
+					t.Errorf("missing attribute %s", key)
+					continue
+				}
+				if d := cmp.Diff(want.Emit(), got.Emit()); d != "" {
+					t.Errorf("attribute %s diff(-want,+got):\n%s", key, d)
+				}
+			}
+		})
+	}
+}
+
+func TestSpanBuilderTaskRunParent(t *testing.T) {
+	tp, exporter := newTracerProvider()
+	builder := cdeventsotel.NewSpanBuilder(tp)
+	pipelineStarted, pipelineFinished := pipelineRunEvents(t, "success")
+
+	taskStarted, err := cdeventsv05.NewTaskRunStartedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	taskStarted.SetSource(testSource)
+	taskStarted.SetTimestamp(testStart.Add(time.Minute))
+	taskStarted.SetSubjectId("task-1")
+	taskStarted.SetSubjectTaskName("unit-tests")
+	taskStarted.SetSubjectPipelineRun(&api.Reference{Id: "run-1"})
+
+	taskFinished, err := cdeventsv05.NewTaskRunFinishedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	taskFinished.SetSource(testSource)
+	taskFinished.SetTimestamp(testStart.Add(5 * time.Minute))
+	taskFinished.SetSubjectId("task-1")
+	taskFinished.SetSubjectTaskName("unit-tests")
+	taskFinished.SetSubjectOutcome("success")
+
+	for _, event := range []api.CDEventReader{pipelineStarted, taskStarted, taskFinished, pipelineFinished} {
+		if err := builder.Observe(context.Background(), event); err != nil {
+			t.Fatalf("didn't expect an error, got %v", err)
+		}
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected two spans, got %d", len(spans))
+	}
+	task, pipeline := spans[0], spans[1]
+	if d := cmp.Diff("taskrun unit-tests", task.Name); d != "" {
+		t.Errorf("name diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(pipeline.SpanContext.SpanID(), task.Parent.SpanID()); d != "" {
+		t.Errorf("parent span id diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(pipeline.SpanContext.TraceID(), task.SpanContext.TraceID()); d != "" {
+		t.Errorf("trace id diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestSpanBuilderFinishedOnly(t *testing.T) {
+	tp, exporter := newTracerProvider()
+	builder := cdeventsotel.NewSpanBuilder(tp)
+	event, err := cdeventsv05.NewTestSuiteRunFinishedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetTimestamp(testStart)
+	event.SetSubjectId("suite-run-1")
+	event.SetSubjectTestSuite(&api.TestSuiteRunFinishedSubjectContentTestSuiteV0_3_0{Id: "unit", Name: "Unit tests"})
+	event.SetSubjectOutcome("fail")
+	event.SetSubjectReason("3 tests failed")
+	if err := builder.Observe(context.Background(), event); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	span := spans[0]
+	if d := cmp.Diff("testsuiterun Unit tests", span.Name); d != "" {
+		t.Errorf("name diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(span.StartTime, span.EndTime); d != "" {
+		t.Errorf("end time diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(sdktrace.Status{Code: codes.Error, Description: "3 tests failed"}, span.Status); d != "" {
+		t.Errorf("status diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestSpanBuilderIgnoredAndClose(t *testing.T) {
+	tp, exporter := newTracerProvider()
+	builder := cdeventsotel.NewSpanBuilder(tp)
+	queued, err := cdeventsv05.NewPipelineRunQueuedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	queued.SetSource(testSource)
+	queued.SetSubjectId("run-1")
+	artifact, err := cdeventsv05.NewArtifactPublishedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	artifact.SetSource(testSource)
+	artifact.SetSubjectId("pkg:golang/example.com/app@v1.0.0")
+	build, err := cdeventsv05.NewBuildStartedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	build.SetSource(testSource)
+	build.SetSubjectId("build-1")
+	for _, event := range []api.CDEventReader{queued, artifact, build} {
+		if err := builder.Observe(context.Background(), event); err != nil {
+			t.Fatalf("didn't expect an error, got %v", err)
+		}
+	}
+	if got := len(exporter.GetSpans()); got != 0 {
+		t.Fatalf("expected no ended spans, got %d", got)
+	}
+	ctx := builder.ContextWithSpan(context.Background(), "build", testSource, "build-1")
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		t.Errorf("expected the context to hold the build span")
+	}
+	builder.Close()
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	if d := cmp.Diff("build build-1", spans[0].Name); d != "" {
+		t.Errorf("name diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestSpanBuilderWithSubjects(t *testing.T) {
+	tp, exporter := newTracerProvider()
+	builder := cdeventsotel.NewSpanBuilder(tp, cdeventsotel.WithSubjects("build"))
+	started, finished := pipelineRunEvents(t, "success")
+	build, err := cdeventsv05.NewBuildFinishedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	build.SetSource(testSource)
+	build.SetSubjectId("build-1")
+	for _, event := range []api.CDEventReader{started, finished, build} {
+		if err := builder.Observe(context.Background(), event); err != nil {
+			t.Fatalf("didn't expect an error, got %v", err)
+		}
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	if d := cmp.Diff("build build-1", spans[0].Name); d != "" {
+		t.Errorf("name diff(-want,+got):\n%s", d)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package cdeventsotel integrates CDEvents with OpenTelemetry tracing.
+//
+// The W3C trace context of a span is carried by the CloudEvents rendering
+// of CDEvents through the distributed tracing extension, i.e. the
+// traceparent and tracestate attributes, so that the traces of producers
+// and consumers of events can be joined.
+//
+// A SpanBuilder turns pairs of started and finished events into spans, for
+// pipeline runs, task runs, builds and test suite runs.
+package cdeventsotel
+
+import (
+	"context"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// traceContext propagates the W3C traceparent and tracestate
+var traceContext = propagation.TraceContext{}
+
+// AsCloudEvent renders a CDEvent as a CloudEvent, like api.AsCloudEvent,
+// with the trace context of ctx, if any
+func AsCloudEvent(ctx context.Context, event api.CDEventReader) (*cloudevents.Event, error) {
+	ce, err := api.AsCloudEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	InjectTraceContext(ctx, ce)
+	return ce, nil
+}
+
+// InjectTraceContext sets the traceparent and tracestate extensions of a
+// CloudEvent from the span context of ctx. CloudEvents are not modified when
+// ctx has no valid span context.
+func InjectTraceContext(ctx context.Context, ce *cloudevents.Event) {
+	carrier := propagation.MapCarrier{}
+	traceContext.Inject(ctx, carrier)
+	dt := extensions.DistributedTracingExtension{
+		TraceParent: carrier.Get(extensions.TraceParentExtension),
+		TraceState:  carrier.Get(extensions.TraceStateExtension),
+	}
+	dt.AddTracingAttributes(ce)
+}
+
+// ExtractTraceContext returns a copy of ctx with the remote span context
+// carried by the traceparent and tracestate extensions of a CloudEvent. It
+// returns ctx unchanged when the CloudEvent has no valid trace context.
+func ExtractTraceContext(ctx context.Context, ce cloudevents.Event) context.Context {
+	dt, ok := extensions.GetDistributedTracingExtension(ce)
+	if !ok {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{extensions.TraceParentExtension: dt.TraceParent}
+	if dt.TraceState != "" {
+		carrier[extensions.TraceStateExtension] = dt.TraceState
+	}
+	return traceContext.Extract(ctx, carrier)
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cdeventsotel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer used by SpanBuilder
+	TracerName = "github.com/cdevents/sdk-go/pkg/cdeventsotel"
+
+	// Attribute keys set on the spans
+	AttributeEventType     = "cdevents.event.type"
+	AttributeEventSource   = "cdevents.event.source"
+	AttributeChainId       = "cdevents.event.chain_id"
+	AttributeSubjectId     = "cdevents.subject.id"
+	AttributeSubjectSource = "cdevents.subject.source"
+	// AttributeContentPrefix prefixes the attributes flattened from the
+	// subject content, e.g. cdevents.subject.content.pipelineName
+	AttributeContentPrefix = "cdevents.subject.content."
+)
+
+// defaultSpanSubjects are the subjects whose started and finished events
+// are turned into spans, unless WithSubjects is set
+var defaultSpanSubjects = map[string]bool{
+	"pipelinerun":  true,
+	"taskrun":      true,
+	"build":        true,
+	"testsuiterun": true,
+}
+
+// spanNameFields are the content fields that name spans, by subject
+var spanNameFields = map[string]string{
+	"pipelinerun":  "pipelineName",
+	"taskrun":      "taskName",
+	"testsuiterun": "testSuite.name",
+}
+
+// errorOutcomes are the outcomes that set the status of spans to Error
+var errorOutcomes = map[string]bool{
+	"failure": true,
+	"error":   true,
+	"fail":    true,
+}
+
+// SpanBuilderOption configures a SpanBuilder
+type SpanBuilderOption func(*SpanBuilder)
+
+// WithAttributes sets attributes on every span
+func WithAttributes(attrs ...attribute.KeyValue) SpanBuilderOption {
+	return func(b *SpanBuilder) {
+		b.attributes = append(b.attributes, attrs...)
+	}
+}
+
+// WithSubjects sets the subjects whose started and finished events are
+// turned into spans, instead of pipeline runs, task runs, builds and test
+// suite runs
+func WithSubjects(subjects ...string) SpanBuilderOption {
+	return func(b *SpanBuilder) {
+		b.subjects = make(map[string]bool, len(subjects))
+		for _, subject := range subjects {
+			b.subjects[subject] = true
+		}
+	}
+}
+
+// spanKey identifies the subject of a span
+type spanKey struct {
+	subject string
+	source  string
+	id      string
+}
+
+// SpanBuilder turns pairs of started and finished events into spans. The
+// span of a subject starts at the timestamp of its started event and ends
+// at the timestamp of its finished event. Task run spans are children of
+// the span of the pipeline run they reference, if it is open.
+//
+// A SpanBuilder is safe for concurrent use.
+type SpanBuilder struct {
+	tracer     trace.Tracer
+	attributes []attribute.KeyValue
+	subjects   map[string]bool
+
+	mu    sync.Mutex
+	spans map[spanKey]trace.Span
+}
+
+// NewSpanBuilder creates a SpanBuilder that creates spans with the tracer
+// provider tp
+func NewSpanBuilder(tp trace.TracerProvider, opts ...SpanBuilderOption) *SpanBuilder {
+	b := &SpanBuilder{
+		tracer:   tp.Tracer(TracerName),
+		subjects: defaultSpanSubjects,
+		spans:    map[spanKey]trace.Span{},
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Observe processes an event. Started events start a span, and finished
+// events end it; other events are ignored. Spans of subjects that have no
+// started event are recorded with no duration when they finish. Spans
+// without an open parent span are children of the span context of ctx, if
+// any, as returned by ExtractTraceContext.
+func (b *SpanBuilder) Observe(ctx context.Context, event api.CDEventReader) error {
+	eventType := event.GetType()
+	if !b.subjects[eventType.Subject] {
+		return nil
+	}
+	switch eventType.Predicate {
+	case "started", "finished":
+	default:
+		return nil
+	}
+	content, err := flattenContent(event.GetSubjectContent())
+	if err != nil {
+		return fmt.Errorf("cannot read subject content of event %s: %w", event.GetId(), err)
+	}
+	key := spanKey{subject: eventType.Subject, source: event.GetSubjectSource(), id: event.GetSubjectId()}
+	attrs := b.attributesFor(event, content)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	span, open := b.spans[key]
+	if !open {
+		span = b.start(ctx, key, event, content)
+	}
+	span.SetAttributes(attrs...)
+	if eventType.Predicate == "started" {
+		if !open {
+			b.spans[key] = span
+		}
+		return nil
+	}
+	delete(b.spans, key)
+	if outcome, ok := content["outcome"].(string); ok && errorOutcomes[outcome] {
+		description, _ := content["errors"].(string)
+		if description == "" {
+			description, _ = content["reason"].(string)
+		}
+		span.SetStatus(codes.Error, description)
+	}
+	span.End(trace.WithTimestamp(event.GetTimestamp()))
+	return nil
+}
+
+// ContextWithSpan returns a copy of ctx with the open span of a subject,
+// e.g. of the "pipelinerun" with the given source and id, so that the span
+// can be propagated to events about the subject. It returns ctx unchanged
+// when the subject has no open span.
+func (b *SpanBuilder) ContextWithSpan(ctx context.Context, subject, subjectSource, subjectId string) context.Context {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	span, ok := b.spans[spanKey{subject: subject, source: subjectSource, id: subjectId}]
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, span)
+}
+
+// Close ends all the open spans
+func (b *SpanBuilder) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key, span := range b.spans {
+		span.End()
+		delete(b.spans, key)
+	}
+}
+
+// start starts the span of a subject. b.mu must be held.
+func (b *SpanBuilder) start(ctx context.Context, key spanKey, event api.CDEventReader, content map[string]any) trace.Span {
+	if key.subject == "taskrun" {
+		if id, ok := content["pipelineRun.id"].(string); ok {
+			source, _ := content["pipelineRun.source"].(string)
+			if source == "" {
+				source = key.source
+			}
+			if parent, ok := b.spans[spanKey{subject: "pipelinerun", source: source, id: id}]; ok {
+				ctx = trace.ContextWithSpan(ctx, parent)
+			}
+		}
+	}
+	name := key.subject + " " + key.id
+	if field, ok := spanNameFields[key.subject]; ok {
+		if value, ok := content[field].(string); ok && value != "" {
+			name = key.subject + " " + value
+		}
+	}
+	_, span := b.tracer.Start(ctx, name,
+		trace.WithTimestamp(event.GetTimestamp()),
+		trace.WithAttributes(b.attributes...))
+	return span
+}
+
+// attributesFor returns the span attributes of an event
+func (b *SpanBuilder) attributesFor(event api.CDEventReader, content map[string]any) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String(AttributeEventType, event.GetType().String()),
+		attribute.String(AttributeEventSource, event.GetSource()),
+		attribute.String(AttributeSubjectId, event.GetSubjectId()),
+		attribute.String(AttributeSubjectSource, event.GetSubjectSource()),
+	}
+	if v04, ok := event.(api.CDEventReaderV04); ok && v04.GetChainId() != "" {
+		attrs = append(attrs, attribute.String(AttributeChainId, v04.GetChainId()))
+	}
+	keys := make([]string, 0, len(content))
+	for key := range content {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if attr, ok := contentAttribute(AttributeContentPrefix+key, content[key]); ok {
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
+
+// flattenContent converts subject content into a map of dot-separated
+// paths to JSON values
+func flattenContent(content any) (map[string]any, error) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]any
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	flat := map[string]any{}
+	flatten(flat, "", values)
+	return flat, nil
+}
+
+func flatten(flat map[string]any, prefix string, values map[string]any) {
+	for key, value := range values {
+		if nested, ok := value.(map[string]any); ok {
+			flatten(flat, prefix+key+".", nested)
+			continue
+		}
+		flat[prefix+key] = value
+	}
+}
+
+// contentAttribute converts a JSON value into a span attribute. Arrays are
+// set as string slices when all their items are strings, and as JSON
+// otherwise.
+func contentAttribute(key string, value any) (attribute.KeyValue, bool) {
+	switch v := value.(type) {
+	case nil:
+		return attribute.KeyValue{}, false
+	case string:
+		return attribute.String(key, v), true
+	case bool:
+		return attribute.Bool(key, v), true
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			return attribute.Int64(key, int64(v)), true
+		}
+		return attribute.Float64(key, v), true
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				raw, _ := json.Marshal(v)
+				return attribute.String(key, string(raw)), true
+			}
+			items = append(items, s)
+		}
+		return attribute.StringSlice(key, items), true
+	default:
+		raw, _ := json.Marshal(v)
+		return attribute.String(key, string(raw)), true
+	}
+}
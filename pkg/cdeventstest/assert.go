@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cdeventstest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Matcher checks a single property of a CDEvent. It returns nil when
+// the event matches, or an error that describes the mismatch.
+type Matcher func(event api.CDEventReader) error
+
+// WithSource matches events with the given source
+func WithSource(source string) Matcher {
+	return func(event api.CDEventReader) error {
+		if event.GetSource() != source {
+			return fmt.Errorf("source %q, want %q", event.GetSource(), source)
+		}
+		return nil
+	}
+}
+
+// WithSubjectId matches events with the given subject id
+func WithSubjectId(subjectId string) Matcher {
+	return func(event api.CDEventReader) error {
+		if event.GetSubjectId() != subjectId {
+			return fmt.Errorf("subject id %q, want %q", event.GetSubjectId(), subjectId)
+		}
+		return nil
+	}
+}
+
+// WithSubjectSource matches events with the given subject source
+func WithSubjectSource(subjectSource string) Matcher {
+	return func(event api.CDEventReader) error {
+		if event.GetSubjectSource() != subjectSource {
+			return fmt.Errorf("subject source %q, want %q", event.GetSubjectSource(), subjectSource)
+		}
+		return nil
+	}
+}
+
+// WithChainId matches v0.4+ events with the given chain id
+func WithChainId(chainId string) Matcher {
+	return func(event api.CDEventReader) error {
+		v04event, ok := event.(api.CDEventReaderV04)
+		if !ok {
+			return fmt.Errorf("event spec version %s does not support chain ids", event.GetVersion())
+		}
+		if v04event.GetChainId() != chainId {
+			return fmt.Errorf("chain id %q, want %q", v04event.GetChainId(), chainId)
+		}
+		return nil
+	}
+}
+
+// WithSubjectContent matches events whose subject content is equal to
+// content. content must be of the content type of the event, e.g.
+// v05.ServiceDeployedSubjectContent for a v05.ServiceDeployedEvent.
+func WithSubjectContent(content interface{}) Matcher {
+	return func(event api.CDEventReader) error {
+		if d := cmp.Diff(content, event.GetSubjectContent()); d != "" {
+			return fmt.Errorf("subject content diff(-want,+got):\n%s", d)
+		}
+		return nil
+	}
+}
+
+// WithCustomData matches events whose custom data, as returned by
+// GetCustomData, is equal to data
+func WithCustomData(data interface{}) Matcher {
+	return func(event api.CDEventReader) error {
+		got, err := event.GetCustomData()
+		if err != nil {
+			return fmt.Errorf("cannot read custom data: %w", err)
+		}
+		if d := cmp.Diff(data, got); d != "" {
+			return fmt.Errorf("custom data diff(-want,+got):\n%s", d)
+		}
+		return nil
+	}
+}
+
+// Where matches events for which the predicate returns true. description
+// is used in the failure message.
+func Where(description string, predicate func(event api.CDEventReader) bool) Matcher {
+	return func(event api.CDEventReader) error {
+		if !predicate(event) {
+			return fmt.Errorf("does not satisfy %q", description)
+		}
+		return nil
+	}
+}
+
+// AssertEmitted checks that at least one event compatible with eventType
+// and satisfying all the matchers was recorded, and returns the first one.
+// Types are compared with CDEventType.IsCompatible, and for custom events
+// the tool name must match as well.
+// If no event matches, the test is marked as failed and nil is returned.
+func (r *Recorder) AssertEmitted(t testing.TB, eventType api.CDEventType, matchers ...Matcher) api.CDEventReader {
+	t.Helper()
+	records := r.Records()
+	mismatches := []string{}
+	for i, record := range records {
+		if record.Event == nil || !typeMatches(eventType, record.Context.GetType()) {
+			continue
+		}
+		errs := []string{}
+		for _, matcher := range matchers {
+			if err := matcher(record.Event); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+		if len(errs) == 0 {
+			return record.Event
+		}
+		mismatches = append(mismatches, fmt.Sprintf("event #%d (%s): %s", i, record.Event.GetId(), strings.Join(errs, "; ")))
+	}
+	if len(mismatches) == 0 {
+		t.Errorf("no event of type %s emitted, got types: %v", eventType, recordedTypes(records))
+	} else {
+		t.Errorf("no event of type %s matches:\n%s", eventType, strings.Join(mismatches, "\n"))
+	}
+	return nil
+}
+
+// AssertNotEmitted checks that no event compatible with eventType and
+// satisfying all the matchers was recorded
+func (r *Recorder) AssertNotEmitted(t testing.TB, eventType api.CDEventType, matchers ...Matcher) {
+	t.Helper()
+	for i, record := range r.Records() {
+		if record.Event == nil || !typeMatches(eventType, record.Context.GetType()) {
+			continue
+		}
+		matched := true
+		for _, matcher := range matchers {
+			if matcher(record.Event) != nil {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			t.Errorf("unexpected event #%d (%s) of type %s emitted", i, record.Event.GetId(), record.Context.GetType())
+		}
+	}
+}
+
+// AssertCount checks that exactly count events were recorded
+func (r *Recorder) AssertCount(t testing.TB, count int) {
+	t.Helper()
+	if got := r.Len(); got != count {
+		t.Errorf("recorded %d events, want %d", got, count)
+	}
+}
+
+// AssertNoInvalidEvents checks that all recorded events can be parsed
+// into a CDEvent and are valid according to api.Validate
+func (r *Recorder) AssertNoInvalidEvents(t testing.TB) {
+	t.Helper()
+	for i, record := range r.Records() {
+		if record.ParseError != nil {
+			t.Errorf("event #%d (%s) cannot be parsed: %v", i, record.CloudEvent.ID(), record.ParseError)
+			continue
+		}
+		if err := api.Validate(record.Event); err != nil {
+			t.Errorf("event #%d (%s) is not valid: %v", i, record.Event.GetId(), err)
+		}
+	}
+}
+
+// AssertChainComplete checks that the events with the given chainId form
+// a complete chain: at least one of them was recorded, every PATH and END
+// link comes from an event in the chain and the chain is terminated by an
+// END link. RELATION links may target events outside of the chain.
+func (r *Recorder) AssertChainComplete(t testing.TB, chainId string) {
+	t.Helper()
+	chain := map[string]api.CDEventReaderV04{}
+	order := []string{}
+	for _, record := range r.Records() {
+		v04event, ok := record.Event.(api.CDEventReaderV04)
+		if !ok || v04event.GetChainId() != chainId {
+			continue
+		}
+		chain[v04event.GetId()] = v04event
+		order = append(order, v04event.GetId())
+	}
+	if len(chain) == 0 {
+		t.Errorf("no event recorded for chain %s", chainId)
+		return
+	}
+	foundEnd := false
+	for _, id := range order {
+		for _, link := range chain[id].GetLinks() {
+			if l, ok := link.(api.EmbeddedLinkWithTagsAndSource); ok {
+				reference := l.GetFrom()
+				if _, found := chain[reference.ContextId]; !found {
+					t.Errorf("event %s in chain %s has a %s link from %q, which is not part of the chain", id, chainId, link.GetLinkType(), reference.ContextId)
+				}
+			}
+			if link.GetLinkType() == api.LinkTypeEnd {
+				foundEnd = true
+			}
+		}
+	}
+	if !foundEnd {
+		t.Errorf("chain %s has no %s link", chainId, api.LinkTypeEnd)
+	}
+}
+
+func typeMatches(want, got api.CDEventType) bool {
+	return want.Custom == got.Custom && want.IsCompatible(got)
+}
+
+func recordedTypes(records []Record) []string {
+	types := make([]string, 0, len(records))
+	for _, record := range records {
+		types = append(types, record.Context.GetType().String())
+	}
+	return types
+}
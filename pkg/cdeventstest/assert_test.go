@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cdeventstest_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/cdeventstest"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAssertEmitted(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType api.CDEventType
+		matchers  []cdeventstest.Matcher
+		wantFound bool
+	}{{
+		name:      "type only",
+		eventType: cdeventsv05.ServiceDeployedEventType,
+		wantFound: true,
+	}, {
+		name:      "compatible version",
+		eventType: api.CDEventType{Subject: "service", Predicate: "deployed", Version: "0.1.0"},
+		wantFound: true,
+	}, {
+		name:      "matching content",
+		eventType: cdeventsv05.ServiceDeployedEventType,
+		matchers: []cdeventstest.Matcher{
+			cdeventstest.WithSource(testSource),
+			cdeventstest.WithSubjectId("service-b"),
+			cdeventstest.WithSubjectContent(api.ServiceDeployedSubjectContentV0_3_0{
+				ArtifactId:  testArtifactId,
+				Environment: &api.Reference{Id: "staging"},
+			}),
+		},
+		wantFound: true,
+	}, {
+		name:      "custom predicate",
+		eventType: cdeventsv05.ServiceDeployedEventType,
+		matchers: []cdeventstest.Matcher{
+			cdeventstest.Where("deployed to prod", func(e api.CDEventReader) bool {
+				return e.GetSubjectContent().(api.ServiceDeployedSubjectContentV0_3_0).Environment.Id == "prod"
+			}),
+		},
+		wantFound: true,
+	}, {
+		name:      "wrong type",
+		eventType: cdeventsv05.ServiceRemovedEventType,
+		wantFound: false,
+	}, {
+		name:      "incompatible version",
+		eventType: api.CDEventType{Subject: "service", Predicate: "deployed", Version: "1.0.0"},
+		wantFound: false,
+	}, {
+		name:      "mismatching matcher",
+		eventType: cdeventsv05.ServiceDeployedEventType,
+		matchers: []cdeventstest.Matcher{
+			cdeventstest.WithSubjectId("service-c"),
+		},
+		wantFound: false,
+	}, {
+		name:      "mismatching chain id",
+		eventType: cdeventsv05.ServiceDeployedEventType,
+		matchers: []cdeventstest.Matcher{
+			cdeventstest.WithChainId("some-chain"),
+		},
+		wantFound: false,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := recorderWithServiceDeployed(t)
+			ft := &fakeT{}
+			got := r.AssertEmitted(ft, tc.eventType, tc.matchers...)
+			if tc.wantFound {
+				if len(ft.errors) > 0 {
+					t.Errorf("unexpected assertion failures: %v", ft.errors)
+				}
+				if got == nil {
+					t.Error("expected an event but got nil")
+				}
+			} else {
+				if len(ft.errors) != 1 {
+					t.Errorf("expected one assertion failure, got %v", ft.errors)
+				}
+				if got != nil {
+					t.Errorf("expected nil but got %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestAssertNotEmitted(t *testing.T) {
+	r := recorderWithServiceDeployed(t)
+	ft := &fakeT{}
+	r.AssertNotEmitted(ft, cdeventsv05.ServiceRemovedEventType)
+	r.AssertNotEmitted(ft, cdeventsv05.ServiceDeployedEventType, cdeventstest.WithSubjectId("service-c"))
+	if len(ft.errors) > 0 {
+		t.Errorf("unexpected assertion failures: %v", ft.errors)
+	}
+	r.AssertNotEmitted(ft, cdeventsv05.ServiceDeployedEventType, cdeventstest.WithSubjectId("service-a"))
+	if len(ft.errors) != 1 {
+		t.Errorf("expected one assertion failure, got %v", ft.errors)
+	}
+}
+
+func TestAssertCount(t *testing.T) {
+	r := recorderWithServiceDeployed(t)
+	ft := &fakeT{}
+	r.AssertCount(ft, 2)
+	if len(ft.errors) > 0 {
+		t.Errorf("unexpected assertion failures: %v", ft.errors)
+	}
+	r.AssertCount(ft, 3)
+	if len(ft.errors) != 1 {
+		t.Errorf("expected one assertion failure, got %v", ft.errors)
+	}
+}
+
+func TestAssertNoInvalidEvents(t *testing.T) {
+	invalidEvent := newServiceDeployed("service-a", "prod")
+	invalidEvent.SetSubjectArtifactId("not-a-purl")
+
+	tests := []struct {
+		name       string
+		data       interface{}
+		wantErrors int
+	}{{
+		name:       "valid event",
+		data:       newServiceDeployed("service-a", "prod"),
+		wantErrors: 0,
+	}, {
+		name:       "invalid event",
+		data:       invalidEvent,
+		wantErrors: 1,
+	}, {
+		name:       "unknown spec version",
+		data:       map[string]interface{}{"context": map[string]interface{}{"specversion": "9.9.9"}},
+		wantErrors: 1,
+	}, {
+		name:       "not a cdevent",
+		data:       []string{"foo"},
+		wantErrors: 1,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := cdeventstest.NewRecorder()
+			ce := cloudevents.NewEvent()
+			ce.SetID("123")
+			ce.SetSource(testSource)
+			ce.SetType("dev.cdevents.service.deployed.0.3.0")
+			panicOnError(ce.SetData(cloudevents.ApplicationJSON, tc.data))
+			r.Send(context.Background(), ce)
+
+			ft := &fakeT{}
+			r.AssertNoInvalidEvents(ft)
+			if d := cmp.Diff(tc.wantErrors, len(ft.errors)); d != "" {
+				t.Errorf("assertion failures %v diff(-want,+got):\n%s", ft.errors, d)
+			}
+		})
+	}
+}
+
+func TestAssertChainComplete(t *testing.T) {
+	chainId := "4c8cb7dd-3448-41de-8768-eec704e2829b"
+	first := newServiceDeployed("service-a", "prod")
+	first.SetChainId(chainId)
+
+	pathLink := api.NewEmbeddedLinkPath()
+	pathLink.SetFrom(api.EventReference{ContextId: first.GetId()})
+	pathLink.SetTags(api.Tags{})
+	second := newServiceDeployed("service-b", "prod")
+	second.SetChainId(chainId)
+	second.SetLinks(api.EmbeddedLinksArray{pathLink})
+
+	endLink := api.NewEmbeddedLinkEnd()
+	endLink.SetFrom(api.EventReference{ContextId: second.GetId()})
+	endLink.SetTags(api.Tags{})
+	last := newServiceDeployed("service-c", "prod")
+	last.SetChainId(chainId)
+	last.SetLinks(api.EmbeddedLinksArray{endLink})
+
+	danglingLink := api.NewEmbeddedLinkEnd()
+	danglingLink.SetFrom(api.EventReference{ContextId: "unknown"})
+	danglingLink.SetTags(api.Tags{})
+	dangling := newServiceDeployed("service-c", "prod")
+	dangling.SetChainId(chainId)
+	dangling.SetLinks(api.EmbeddedLinksArray{danglingLink})
+
+	relationLink := api.NewEmbeddedLinkRelation()
+	relationLink.SetLinkKind("TRIGGER")
+	relationLink.SetTarget(api.EventReference{ContextId: "outside-of-the-chain"})
+	relationLink.SetTags(api.Tags{})
+	related := newServiceDeployed("service-c", "prod")
+	related.SetChainId(chainId)
+	related.SetLinks(api.EmbeddedLinksArray{endLink, relationLink})
+
+	tests := []struct {
+		name       string
+		events     []api.CDEventReader
+		chainId    string
+		wantErrors int
+	}{{
+		name:       "complete chain",
+		events:     []api.CDEventReader{first, second, last},
+		chainId:    chainId,
+		wantErrors: 0,
+	}, {
+		name:       "missing end",
+		events:     []api.CDEventReader{first, second},
+		chainId:    chainId,
+		wantErrors: 1,
+	}, {
+		name:       "dangling link",
+		events:     []api.CDEventReader{first, second, dangling},
+		chainId:    chainId,
+		wantErrors: 1,
+	}, {
+		name:       "relation outside of the chain",
+		events:     []api.CDEventReader{first, second, related},
+		chainId:    chainId,
+		wantErrors: 0,
+	}, {
+		name:       "unknown chain",
+		events:     []api.CDEventReader{first, second, last},
+		chainId:    "other-chain",
+		wantErrors: 1,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := cdeventstest.NewRecorder()
+			send(t, r, tc.events...)
+			ft := &fakeT{}
+			r.AssertChainComplete(ft, tc.chainId)
+			if d := cmp.Diff(tc.wantErrors, len(ft.errors)); d != "" {
+				t.Errorf("assertion failures %v diff(-want,+got):\n%s", ft.errors, d)
+			}
+		})
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	r := recorderWithServiceDeployed(t)
+	r.AssertGolden(t, "testdata/service_deployed.golden.json")
+}
+
+func TestAssertGoldenMismatch(t *testing.T) {
+	if os.Getenv(cdeventstest.UpdateGoldenEnv) != "" {
+		t.Skip("golden files are being updated")
+	}
+	ft := &fakeT{}
+	cdeventstest.AssertGolden(ft, "testdata/service_deployed.golden.json", newServiceDeployed("service-a", "dev"))
+	if len(ft.errors) != 1 {
+		t.Errorf("expected one assertion failure, got %v", ft.errors)
+	}
+}
+
+func TestAssertGoldenLinks(t *testing.T) {
+	first := newServiceDeployed("service-a", "prod")
+	first.SetChainId("4c8cb7dd-3448-41de-8768-eec704e2829b")
+	endLink := api.NewEmbeddedLinkEnd()
+	endLink.SetFrom(api.EventReference{ContextId: first.GetId()})
+	endLink.SetTags(api.Tags{})
+	last := newServiceDeployed("service-b", "prod")
+	last.SetChainId("4c8cb7dd-3448-41de-8768-eec704e2829b")
+	last.SetLinks(api.EmbeddedLinksArray{endLink})
+	cdeventstest.AssertGolden(t, "testdata/service_deployed_chain.golden.json", first, last)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cdeventstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	// UpdateGoldenEnv is the environment variable that, when set to a non
+	// empty value, makes the golden assertions overwrite the golden files
+	// with the current events instead of comparing them
+	UpdateGoldenEnv = "CDEVENTS_UPDATE_GOLDEN"
+
+	ignoredTimestampValue = "<timestamp>"
+)
+
+// AssertGolden compares the events with the content of the golden file
+// at path. The golden file holds a JSON array of events. The context id
+// and timestamp are ignored in the comparison, as they change on every run:
+// the id of each event is replaced by a placeholder with its position, and
+// links to the event refer to the same placeholder.
+func AssertGolden(t testing.TB, path string, events ...api.CDEventReader) {
+	t.Helper()
+	placeholders := make(map[string]string, len(events))
+	for i, event := range events {
+		placeholders[event.GetId()] = fmt.Sprintf("<id-%d>", i)
+	}
+	got := make([]interface{}, 0, len(events))
+	for i, event := range events {
+		normalized, err := normalize(event, placeholders)
+		if err != nil {
+			t.Errorf("cannot normalize event #%d: %v", i, err)
+			return
+		}
+		got = append(got, normalized)
+	}
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := writeGolden(path, got); err != nil {
+			t.Errorf("cannot update golden file %s: %v", path, err)
+		}
+		return
+	}
+	goldenBytes, err := os.ReadFile(path) //nolint: gosec
+	if err != nil {
+		t.Errorf("cannot read golden file %s, set %s=1 to create it: %v", path, UpdateGoldenEnv, err)
+		return
+	}
+	var want []interface{}
+	if err := json.Unmarshal(goldenBytes, &want); err != nil {
+		t.Errorf("cannot parse golden file %s: %v", path, err)
+		return
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("events do not match golden file %s, diff(-want,+got):\n%s", path, d)
+	}
+}
+
+// AssertGolden compares all the recorded events with the golden file at path
+func (r *Recorder) AssertGolden(t testing.TB, path string) {
+	t.Helper()
+	AssertGolden(t, path, r.Events()...)
+}
+
+// normalize renders the event as a generic JSON object, with the
+// fields that change on every run replaced by fixed values. Ids, in the
+// context and in links, are replaced by their placeholder when they have one.
+func normalize(event api.CDEventReader, placeholders map[string]string) (interface{}, error) {
+	jsonBytes, err := api.AsJsonBytes(event)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(jsonBytes, &normalized); err != nil {
+		return nil, err
+	}
+	context, ok := normalized["context"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("event %s has no context", event.GetId())
+	}
+	replacePlaceholder(context, "id", placeholders)
+	if _, ok := context["timestamp"]; ok {
+		context["timestamp"] = ignoredTimestampValue
+	}
+	links, _ := context["links"].([]interface{})
+	for _, link := range links {
+		link, ok := link.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, field := range []string{"from", "target"} {
+			if reference, ok := link[field].(map[string]interface{}); ok {
+				replacePlaceholder(reference, "contextId", placeholders)
+			}
+		}
+	}
+	return normalized, nil
+}
+
+// replacePlaceholder replaces the id in the field of object by its placeholder
+func replacePlaceholder(object map[string]interface{}, field string, placeholders map[string]string) {
+	id, ok := object[field].(string)
+	if !ok {
+		return
+	}
+	if placeholder, ok := placeholders[id]; ok {
+		object[field] = placeholder
+	}
+}
+
+func writeGolden(path string, events []interface{}) error {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(events); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buffer.Bytes(), 0600)
+}
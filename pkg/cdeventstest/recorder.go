@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package cdeventstest contains helpers to unit test code that produces
+// CDEvents, without standing up an HTTP server or parsing JSON by hand.
+package cdeventstest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv03 "github.com/cdevents/sdk-go/pkg/api/v03"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// Record holds a CloudEvent received by the Recorder, together with the
+// CDEvent parsed from its payload.
+type Record struct {
+	// CloudEvent is a copy of the CloudEvent as it was sent
+	CloudEvent cloudevents.Event
+
+	// Context is the CDEvents context parsed from the payload. It is
+	// available even when the payload cannot be parsed into a CDEvent,
+	// and it holds the actual type of custom events.
+	Context api.ContextForUnmarshalling
+
+	// Event is the CDEvent parsed from the payload, nil if ParseError is set
+	Event api.CDEventReader
+
+	// ParseError is set when the payload could not be parsed into a CDEvent
+	ParseError error
+}
+
+// Recorder is an in-memory CloudEvents client that records all the events
+// sent through it. It satisfies the cloudevents.Client interface, so it can
+// be injected in place of the client used by the code under test.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	records []Record
+	result  protocol.Result
+}
+
+var _ cloudevents.Client = (*Recorder)(nil)
+
+// NewRecorder creates an empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Send records the event and returns the result set via SetResult,
+// which is an ACK by default
+func (r *Recorder) Send(_ context.Context, event cloudevents.Event) protocol.Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, newRecord(event))
+	return r.result
+}
+
+// Request records the event like Send does. It never returns a response event.
+func (r *Recorder) Request(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+	return nil, r.Send(ctx, event)
+}
+
+// StartReceiver is not supported by the Recorder and always returns an error
+func (r *Recorder) StartReceiver(_ context.Context, _ interface{}) error {
+	return fmt.Errorf("the cdeventstest recorder cannot receive events")
+}
+
+// SetResult sets the result returned by Send and Request. Use it to
+// simulate delivery failures, e.g. with protocol.NewReceipt(false, ...).
+// A nil result means ACK.
+func (r *Recorder) SetResult(result protocol.Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result = result
+}
+
+// Records returns a copy of all the records, in the order they were sent
+func (r *Recorder) Records() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	records := make([]Record, len(r.records))
+	copy(records, r.records)
+	return records
+}
+
+// Events returns all the CDEvents that could be parsed, in the order they
+// were sent. Use Records to inspect events that could not be parsed.
+func (r *Recorder) Events() []api.CDEventReader {
+	records := r.Records()
+	events := make([]api.CDEventReader, 0, len(records))
+	for _, record := range records {
+		if record.Event != nil {
+			events = append(events, record.Event)
+		}
+	}
+	return events
+}
+
+// Len returns the number of recorded events
+func (r *Recorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.records)
+}
+
+// Reset discards all recorded events
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = nil
+}
+
+func newRecord(event cloudevents.Event) Record {
+	record := Record{CloudEvent: event.Clone()}
+	eventAux := &struct {
+		Context api.ContextForUnmarshalling `json:"context"`
+	}{}
+	if err := json.Unmarshal(event.Data(), eventAux); err != nil {
+		record.ParseError = fmt.Errorf("cannot parse the CDEvent context: %w", err)
+		return record
+	}
+	record.Context = eventAux.Context
+	record.Event, record.ParseError = ParseEvent(event.Data())
+	return record
+}
+
+// ParseEvent parses a CDEvent in JSON format into the Go type that matches
+// its spec version. Spec versions v0.3, v0.4 and v0.5 are supported.
+func ParseEvent(data []byte) (api.CDEventReader, error) {
+	eventAux := &struct {
+		Context api.ContextForUnmarshalling `json:"context"`
+	}{}
+	if err := json.Unmarshal(data, eventAux); err != nil {
+		return nil, err
+	}
+	version := eventAux.Context.GetVersion()
+	switch {
+	case strings.HasPrefix(version, "0.5."):
+		return cdeventsv05.NewFromJsonBytes(data)
+	case strings.HasPrefix(version, "0.4."):
+		return cdeventsv04.NewFromJsonBytes(data)
+	case strings.HasPrefix(version, "0.3."):
+		return cdeventsv03.NewFromJsonBytes(data)
+	default:
+		return nil, fmt.Errorf("unsupported spec version %q", version)
+	}
+}
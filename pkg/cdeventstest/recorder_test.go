@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cdeventstest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/cdeventstest"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testSource     = "/event/source/123"
+	testArtifactId = "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427"
+)
+
+// fakeT captures assertion failures instead of failing the test
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func panicOnError(err error) {
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+func newServiceDeployed(subjectId, environment string) *cdeventsv05.ServiceDeployedEvent {
+	event, err := cdeventsv05.NewServiceDeployedEvent()
+	panicOnError(err)
+	event.SetSource(testSource)
+	event.SetSubjectId(subjectId)
+	event.SetSubjectArtifactId(testArtifactId)
+	event.SetSubjectEnvironment(&api.Reference{Id: environment})
+	event.SetTimestamp(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	return event
+}
+
+func send(t *testing.T, r *cdeventstest.Recorder, events ...api.CDEventReader) {
+	t.Helper()
+	for _, event := range events {
+		ce, err := api.AsCloudEvent(event)
+		if err != nil {
+			t.Fatalf("cannot render event as CloudEvent: %v", err)
+		}
+		if result := r.Send(context.Background(), *ce); !cloudevents.IsACK(result) {
+			t.Fatalf("send failed: %v", result)
+		}
+	}
+}
+
+func TestRecorderSend(t *testing.T) {
+	r := recorderWithServiceDeployed(t)
+	if d := cmp.Diff(2, r.Len()); d != "" {
+		t.Errorf("recorded events diff(-want,+got):\n%s", d)
+	}
+	records := r.Records()
+	for i, record := range records {
+		if record.ParseError != nil {
+			t.Errorf("unexpected parse error for record #%d: %v", i, record.ParseError)
+		}
+		if d := cmp.Diff(cdeventsv05.ServiceDeployedEventType, record.Event.GetType()); d != "" {
+			t.Errorf("record #%d type diff(-want,+got):\n%s", i, d)
+		}
+	}
+	if d := cmp.Diff("service-a", r.Events()[0].GetSubjectId()); d != "" {
+		t.Errorf("subject id diff(-want,+got):\n%s", d)
+	}
+	r.Reset()
+	if d := cmp.Diff(0, r.Len()); d != "" {
+		t.Errorf("recorded events after reset diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestRecorderMultipleSpecVersions(t *testing.T) {
+	r := cdeventstest.NewRecorder()
+	v04event, err := cdeventsv04.NewPipelineRunQueuedEvent()
+	panicOnError(err)
+	v04event.SetSource(testSource)
+	v04event.SetSubjectId("pipelineRun1")
+	send(t, r, v04event, newServiceDeployed("service-a", "prod"))
+
+	events := r.Events()
+	if d := cmp.Diff(2, len(events)); d != "" {
+		t.Fatalf("parsed events diff(-want,+got):\n%s", d)
+	}
+	if _, ok := events[0].(*cdeventsv04.PipelineRunQueuedEvent); !ok {
+		t.Errorf("expected a v04 PipelineRunQueuedEvent, got %T", events[0])
+	}
+	if _, ok := events[1].(*cdeventsv05.ServiceDeployedEvent); !ok {
+		t.Errorf("expected a v05 ServiceDeployedEvent, got %T", events[1])
+	}
+}
+
+func TestRecorderSetResult(t *testing.T) {
+	r := cdeventstest.NewRecorder()
+	r.SetResult(protocol.NewReceipt(false, "broker unavailable"))
+	ce, err := api.AsCloudEvent(newServiceDeployed("service-a", "prod"))
+	panicOnError(err)
+	if result := r.Send(context.Background(), *ce); cloudevents.IsACK(result) {
+		t.Errorf("expected a NACK, got %v", result)
+	}
+	if d := cmp.Diff(1, r.Len()); d != "" {
+		t.Errorf("recorded events diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestRecorderStartReceiver(t *testing.T) {
+	r := cdeventstest.NewRecorder()
+	if err := r.StartReceiver(context.Background(), func() {}); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func recorderWithServiceDeployed(t *testing.T) *cdeventstest.Recorder {
+	t.Helper()
+	r := cdeventstest.NewRecorder()
+	send(t, r, newServiceDeployed("service-a", "prod"), newServiceDeployed("service-b", "staging"))
+	return r
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package filter compiles CEL expressions that select CDEvents, e.g.
+//
+//	context.type.glob("dev.cdevents.service.*") && subject.content.environment.id == "prod"
+//
+// Expressions are evaluated over the JSON rendering of an event, through
+// the following variables:
+//
+//	context                the context of the event, e.g. context.source
+//	subject                the subject of the event, e.g. subject.content.outcome
+//	customData             the custom data, decoded when it is JSON, as bytes otherwise
+//	customDataContentType  the content type of the custom data
+//
+// On top of the standard CEL functions, strings have the following methods:
+//
+//	glob(pattern)          matches a pattern where * matches any sequence of characters
+//	compatibleWith(type)   checks that two event types are compatible, see api.CDEventType.IsCompatible
+//	typeSubject()          the subject of an event type, e.g. "service"
+//	typePredicate()        the predicate of an event type, e.g. "deployed"
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+const (
+	varContext               = "context"
+	varSubject               = "subject"
+	varCustomData            = "customData"
+	varCustomDataContentType = "customDataContentType"
+)
+
+var (
+	// ErrInvalidExpression is returned when an expression does not compile
+	ErrInvalidExpression = errors.New("invalid filter expression")
+
+	// ErrEvaluation is returned when an expression cannot be evaluated
+	// against an event, e.g. because a field is missing
+	ErrEvaluation = errors.New("cannot evaluate filter expression")
+
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	globCache sync.Map
+)
+
+// Filter is a compiled filter expression. A Filter is safe for concurrent
+// use.
+type Filter struct {
+	expression string
+	program    cel.Program
+}
+
+// Compile compiles a filter expression. The expression must evaluate to a
+// boolean.
+func Compile(expression string) (*Filter, error) {
+	e, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, issues.Err())
+	}
+	if out := ast.OutputType(); !out.IsExactType(cel.BoolType) && !out.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("%w: expression %q evaluates to %s, not bool", ErrInvalidExpression, expression, out)
+	}
+	program, err := e.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, err)
+	}
+	return &Filter{expression: expression, program: program}, nil
+}
+
+// MustCompile is like Compile but panics if the expression does not compile
+func MustCompile(expression string) *Filter {
+	f, err := Compile(expression)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// ForType returns a Filter that matches events whose type is compatible
+// with eventType, i.e. with the same subject, predicate and major version
+func ForType(eventType api.CDEventType) *Filter {
+	return MustCompile(fmt.Sprintf("context.type.compatibleWith(%q)", eventType.String()))
+}
+
+// String returns the expression of the filter
+func (f *Filter) String() string {
+	return f.expression
+}
+
+// Eval evaluates the filter against an event. Expressions that refer to
+// fields missing from the event fail to evaluate; use has() to check for
+// optional fields.
+func (f *Filter) Eval(event api.CDEventReader) (bool, error) {
+	vars, err := activation(event)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrEvaluation, err)
+	}
+	out, _, err := f.program.Eval(vars)
+	if err != nil {
+		return false, fmt.Errorf("%w %q on event %s: %w", ErrEvaluation, f.expression, event.GetId(), err)
+	}
+	match, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w %q on event %s: got %v, not bool", ErrEvaluation, f.expression, event.GetId(), out.Value())
+	}
+	return match, nil
+}
+
+// Match reports whether an event matches the filter. Events the filter
+// cannot be evaluated against do not match.
+func (f *Filter) Match(event api.CDEventReader) bool {
+	match, err := f.Eval(event)
+	return err == nil && match
+}
+
+// activation returns the variables of an event
+func activation(event api.CDEventReader) (map[string]any, error) {
+	data, err := api.AsJsonBytes(event)
+	if err != nil {
+		return nil, err
+	}
+	var rendered map[string]any
+	if err := json.Unmarshal(data, &rendered); err != nil {
+		return nil, err
+	}
+	contentType := event.GetCustomDataContentType()
+	var customData any
+	switch {
+	case rendered[varCustomData] == nil:
+	case contentType == "" || contentType == "application/json":
+		customData = rendered[varCustomData]
+	default:
+		if customData, err = event.GetCustomDataRaw(); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]any{
+		varContext:               rendered[varContext],
+		varSubject:               rendered[varSubject],
+		varCustomData:            customData,
+		varCustomDataContentType: contentType,
+	}, nil
+}
+
+func celEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(varContext, cel.DynType),
+			cel.Variable(varSubject, cel.DynType),
+			cel.Variable(varCustomData, cel.DynType),
+			cel.Variable(varCustomDataContentType, cel.StringType),
+			cel.Function("glob",
+				cel.MemberOverload("string_glob_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+					cel.BinaryBinding(glob))),
+			cel.Function("compatibleWith",
+				cel.MemberOverload("string_compatibleWith_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+					cel.BinaryBinding(compatibleWith))),
+			cel.Function("typeSubject",
+				cel.MemberOverload("string_typeSubject", []*cel.Type{cel.StringType}, cel.StringType,
+					cel.UnaryBinding(typeField(func(t *api.CDEventType) string { return t.Subject })))),
+			cel.Function("typePredicate",
+				cel.MemberOverload("string_typePredicate", []*cel.Type{cel.StringType}, cel.StringType,
+					cel.UnaryBinding(typeField(func(t *api.CDEventType) string { return t.Predicate })))),
+		)
+	})
+	return env, envErr
+}
+
+func glob(value, pattern ref.Val) ref.Val {
+	p := string(pattern.(types.String))
+	re, ok := globCache.Load(p)
+	if !ok {
+		parts := strings.Split(p, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		re, _ = globCache.LoadOrStore(p, regexp.MustCompile("^"+strings.Join(parts, ".*")+"$"))
+	}
+	return types.Bool(re.(*regexp.Regexp).MatchString(string(value.(types.String))))
+}
+
+func compatibleWith(value, other ref.Val) ref.Val {
+	t, err := api.ParseType(string(value.(types.String)))
+	if err != nil {
+		return types.NewErrFromString(err.Error())
+	}
+	o, err := api.ParseType(string(other.(types.String)))
+	if err != nil {
+		return types.NewErrFromString(err.Error())
+	}
+	return types.Bool(t.Custom == o.Custom && t.IsCompatible(*o))
+}
+
+func typeField(field func(*api.CDEventType) string) func(ref.Val) ref.Val {
+	return func(value ref.Val) ref.Val {
+		t, err := api.ParseType(string(value.(types.String)))
+		if err != nil {
+			return types.NewErrFromString(err.Error())
+		}
+		return types.String(field(t))
+	}
+}
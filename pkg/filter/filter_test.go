@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/filter"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testSource     = "/ci/deployer"
+	testArtifactId = "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427"
+)
+
+func serviceDeployed(t *testing.T, environment string) *cdeventsv05.ServiceDeployedEvent {
+	t.Helper()
+	event, err := cdeventsv05.NewServiceDeployedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetSubjectId("myapp")
+	event.SetSubjectArtifactId(testArtifactId)
+	event.SetSubjectEnvironment(&api.Reference{Id: environment})
+	return event
+}
+
+func pipelineRunFinished(t *testing.T) *cdeventsv05.PipelineRunFinishedEvent {
+	t.Helper()
+	event, err := cdeventsv05.NewPipelineRunFinishedEvent()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	event.SetSource(testSource)
+	event.SetSubjectId("run-1")
+	event.SetSubjectPipelineName("release")
+	event.SetSubjectOutcome("failure")
+	return event
+}
+
+func TestFilter(t *testing.T) {
+	prod := serviceDeployed(t, "prod")
+	if err := prod.SetCustomData("application/json", map[string]any{"team": "payments", "replicas": 3}); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	staging := serviceDeployed(t, "staging")
+	if err := staging.SetCustomData("text/plain", []byte("rollout: canary")); err != nil {
+		t.Fatalf("didn't expect an error, got %v", err)
+	}
+	run := pipelineRunFinished(t)
+
+	tests := []struct {
+		name       string
+		expression string
+		event      api.CDEventReader
+		want       bool
+		wantError  bool
+	}{{
+		name:       "type glob and content",
+		expression: `context.type.glob("dev.cdevents.service.*") && subject.content.environment.id == "prod"`,
+		event:      prod,
+		want:       true,
+	}, {
+		name:       "type glob and content no match",
+		expression: `context.type.glob("dev.cdevents.service.*") && subject.content.environment.id == "prod"`,
+		event:      staging,
+		want:       false,
+	}, {
+		name:       "type regex",
+		expression: `context.type.matches("^dev\\.cdevents\\.pipelinerun\\.")`,
+		event:      run,
+		want:       true,
+	}, {
+		name:       "compatible type",
+		expression: `context.type.compatibleWith("dev.cdevents.service.deployed.0.1.0")`,
+		event:      prod,
+		want:       true,
+	}, {
+		name:       "incompatible major version",
+		expression: `context.type.compatibleWith("dev.cdevents.service.deployed.1.0.0")`,
+		event:      prod,
+		want:       false,
+	}, {
+		name:       "type subject and predicate",
+		expression: `context.type.typeSubject() == "pipelinerun" && context.type.typePredicate() == "finished"`,
+		event:      run,
+		want:       true,
+	}, {
+		name:       "context and subject fields",
+		expression: `context.source == "/ci/deployer" && subject.id == "run-1" && subject.content.outcome in ["failure", "error"]`,
+		event:      run,
+		want:       true,
+	}, {
+		name:       "json custom data",
+		expression: `customData.team == "payments" && customData.replicas > 2`,
+		event:      prod,
+		want:       true,
+	}, {
+		name:       "raw custom data",
+		expression: `customDataContentType == "text/plain" && string(customData).contains("canary")`,
+		event:      staging,
+		want:       true,
+	}, {
+		name:       "optional field guarded with has",
+		expression: `has(subject.content.environment) && subject.content.environment.id == "prod"`,
+		event:      run,
+		want:       false,
+	}, {
+		name:       "missing field",
+		expression: `subject.content.environment.id == "prod"`,
+		event:      run,
+		wantError:  true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := filter.Compile(tc.expression)
+			if err != nil {
+				t.Fatalf("didn't expect an error, got %v", err)
+			}
+			got, err := f.Eval(tc.event)
+			if tc.wantError {
+				if !errors.Is(err, filter.ErrEvaluation) {
+					t.Fatalf("expected ErrEvaluation, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("didn't expect an error, got %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("eval diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.want, f.Match(tc.event)); d != "" {
+				t.Errorf("match diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestCompileInvalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{{
+		name:       "syntax error",
+		expression: `context.type ==`,
+	}, {
+		name:       "unknown variable",
+		expression: `event.type == "foo"`,
+	}, {
+		name:       "not a boolean",
+		expression: `customDataContentType + "x"`,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := filter.Compile(tc.expression); !errors.Is(err, filter.ErrInvalidExpression) {
+				t.Errorf("expected ErrInvalidExpression, got %v", err)
+			}
+		})
+	}
+}
+
+func TestForType(t *testing.T) {
+	f := filter.ForType(cdeventsv05.ServiceDeployedEventType)
+	if !f.Match(serviceDeployed(t, "prod")) {
+		t.Errorf("expected %s to match a service deployed event", f)
+	}
+	if f.Match(pipelineRunFinished(t)) {
+		t.Errorf("expected %s not to match a pipeline run finished event", f)
+	}
+}
+
+func TestNewSender(t *testing.T) {
+	var got []string
+	next := sender.SenderFunc(func(_ context.Context, event api.CDEventReader) error {
+		got = append(got, event.GetSubjectId())
+		return nil
+	})
+	s := filter.NewSender(filter.MustCompile(`subject.content.environment.id == "prod"`), next)
+	for _, event := range []api.CDEventReader{serviceDeployed(t, "prod"), pipelineRunFinished(t), serviceDeployed(t, "staging")} {
+		if err := s.Send(context.Background(), event); err != nil {
+			t.Fatalf("didn't expect an error, got %v", err)
+		}
+	}
+	if d := cmp.Diff([]string{"myapp"}, got); d != "" {
+		t.Errorf("sent diff(-want,+got):\n%s", d)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"context"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/cdevents/sdk-go/pkg/sender"
+)
+
+// NewSender returns a Sender that only delivers the events that match f to
+// next, and drops the others
+func NewSender(f *Filter, next sender.Sender) sender.Sender {
+	return sender.SenderFunc(func(ctx context.Context, event api.CDEventReader) error {
+		if !f.Match(event) {
+			return nil
+		}
+		return next.Send(ctx, event)
+	})
+}
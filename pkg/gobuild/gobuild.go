@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package gobuild builds ArtifactPackaged events for Go binaries from the
+// build information embedded by the Go toolchain.
+//
+// The artifactId of the events is a pkg:golang purl made of the path and
+// version of the main module, with the path of the main package within the
+// module as subpath, e.g. pkg:golang/github.com/acme/myapp@v1.2.0#cmd/myapp.
+// The vcs.revision build setting populates the change of the events.
+package gobuild
+
+import (
+	"debug/buildinfo"
+	"errors"
+	"runtime/debug"
+	"strings"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	develVersion       = "(devel)"
+	settingVCSRevision = "vcs.revision"
+)
+
+var (
+	// ErrNoModule is returned when the build information has no main module
+	ErrNoModule = errors.New("build information has no main module")
+
+	// ErrNoVersion is returned when the main module has no version, like
+	// binaries built with go build outside a tagged VCS checkout
+	ErrNoVersion = errors.New("main module has no version")
+
+	// ErrNoRevision is returned when the build information has no VCS
+	// revision, like binaries built with -buildvcs=false
+	ErrNoRevision = errors.New("build information has no VCS revision")
+)
+
+type options struct {
+	source       string
+	version      string
+	change       *api.Reference
+	changeSource string
+	sbomURI      string
+}
+
+// Option configures the produced events
+type Option func(*options)
+
+// WithSource sets the source of the events. It defaults to the path of the
+// main module.
+func WithSource(source string) Option {
+	return func(o *options) {
+		o.source = source
+	}
+}
+
+// WithVersion sets the version of the artifact, for binaries built without
+// a module version
+func WithVersion(version string) Option {
+	return func(o *options) {
+		o.version = version
+	}
+}
+
+// WithChangeSource sets the source of the change, typically the URL of the
+// repository, used with the VCS revision of the binary
+func WithChangeSource(source string) Option {
+	return func(o *options) {
+		o.changeSource = source
+	}
+}
+
+// WithChange sets the change of the events, for binaries built without VCS
+// information. It takes precedence over the VCS revision.
+func WithChange(change *api.Reference) Option {
+	return func(o *options) {
+		o.change = change
+	}
+}
+
+// WithSbomURI sets the uri of the SBOM of the artifact
+func WithSbomURI(uri string) Option {
+	return func(o *options) {
+		o.sbomURI = uri
+	}
+}
+
+// Purl returns the pkg:golang purl of the binary described by info
+func Purl(info *debug.BuildInfo, opts ...Option) (string, error) {
+	o := newOptions(opts)
+	return o.purl(info)
+}
+
+// NewArtifactPackagedEvent creates an ArtifactPackaged event for the binary
+// described by info
+func NewArtifactPackagedEvent(info *debug.BuildInfo, opts ...Option) (*cdeventsv05.ArtifactPackagedEvent, error) {
+	o := newOptions(opts)
+	artifactId, err := o.purl(info)
+	if err != nil {
+		return nil, err
+	}
+	change := o.change
+	if change == nil {
+		revision, ok := Revision(info)
+		if !ok {
+			return nil, ErrNoRevision
+		}
+		change = &api.Reference{Id: revision, Source: o.changeSource}
+	}
+	event, err := cdeventsv05.NewArtifactPackagedEvent()
+	if err != nil {
+		return nil, err
+	}
+	source := o.source
+	if source == "" {
+		source = info.Main.Path
+	}
+	event.SetSource(source)
+	event.SetSubjectId(artifactId)
+	event.SetSubjectChange(change)
+	if o.sbomURI != "" {
+		event.SetSubjectSbom(&cdeventsv05.ArtifactPackagedSubjectContentSbom{Uri: o.sbomURI})
+	}
+	return event, nil
+}
+
+// NewArtifactPackagedEventFromFile creates an ArtifactPackaged event for the
+// Go binary at path
+func NewArtifactPackagedEventFromFile(path string, opts ...Option) (*cdeventsv05.ArtifactPackagedEvent, error) {
+	info, err := buildinfo.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewArtifactPackagedEvent(info, opts...)
+}
+
+// NewArtifactPackagedEventFromRunningBinary creates an ArtifactPackaged event
+// for the running binary
+func NewArtifactPackagedEventFromRunningBinary(opts ...Option) (*cdeventsv05.ArtifactPackagedEvent, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, ErrNoModule
+	}
+	return NewArtifactPackagedEvent(info, opts...)
+}
+
+// Revision returns the VCS revision of the binary described by info
+func Revision(info *debug.BuildInfo) (string, bool) {
+	for _, setting := range info.Settings {
+		if setting.Key == settingVCSRevision && setting.Value != "" {
+			return setting.Value, true
+		}
+	}
+	return "", false
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *options) purl(info *debug.BuildInfo) (string, error) {
+	if info == nil || info.Main.Path == "" {
+		return "", ErrNoModule
+	}
+	version := o.version
+	if version == "" && info.Main.Version != develVersion {
+		version = info.Main.Version
+	}
+	if version == "" {
+		return "", ErrNoVersion
+	}
+	// The main package is a subpath of the main module, unless it's its root
+	var subpath string
+	if strings.HasPrefix(info.Path, info.Main.Path+"/") {
+		subpath = strings.TrimPrefix(info.Path, info.Main.Path+"/")
+	}
+	// Module paths are lowercased, as in normalized pkg:golang purls
+	namespace, name := "", strings.ToLower(info.Main.Path)
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+	return purl.NewPackageURL(purl.TypeGolang, namespace, name, version, nil, subpath).ToString(), nil
+}
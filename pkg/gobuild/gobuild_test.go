@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gobuild_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	"github.com/cdevents/sdk-go/pkg/gobuild"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testRevision     = "9f8e2c4b7a1d3e5f60718293a4b5c6d7e8f90a1b"
+	testChangeSource = "https://github.com/acme/myapp"
+)
+
+func loadBuildInfo(t *testing.T, name string) *debug.BuildInfo {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("cannot read fixture %s: %v", name, err)
+	}
+	info, err := debug.ParseBuildInfo(string(data))
+	if err != nil {
+		t.Fatalf("cannot parse fixture %s: %v", name, err)
+	}
+	return info
+}
+
+func TestNewArtifactPackagedEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		fixture        string
+		opts           []gobuild.Option
+		wantSource     string
+		wantArtifactId string
+		wantChange     *api.Reference
+		wantError      error
+	}{{
+		name:           "release",
+		fixture:        "myapp.txt",
+		opts:           []gobuild.Option{gobuild.WithChangeSource(testChangeSource)},
+		wantSource:     "github.com/acme/myapp",
+		wantArtifactId: "pkg:golang/github.com/acme/myapp@v1.2.0#cmd/myapp",
+		wantChange:     &api.Reference{Id: testRevision, Source: testChangeSource},
+	}, {
+		name:    "release with options",
+		fixture: "myapp.txt",
+		opts: []gobuild.Option{
+			gobuild.WithSource("/ci/myapp"),
+			gobuild.WithVersion("v1.2.1"),
+			gobuild.WithChange(&api.Reference{Id: "42", Source: testChangeSource}),
+		},
+		wantSource:     "/ci/myapp",
+		wantArtifactId: "pkg:golang/github.com/acme/myapp@v1.2.1#cmd/myapp",
+		wantChange:     &api.Reference{Id: "42", Source: testChangeSource},
+	}, {
+		name:    "devel with version",
+		fixture: "devel.txt",
+		opts: []gobuild.Option{
+			gobuild.WithVersion("v0.1.0"),
+			gobuild.WithChange(&api.Reference{Id: "42"}),
+		},
+		wantSource:     "github.com/Acme/Tool",
+		wantArtifactId: "pkg:golang/github.com/acme/tool@v0.1.0",
+		wantChange:     &api.Reference{Id: "42"},
+	}, {
+		name:      "devel",
+		fixture:   "devel.txt",
+		wantError: gobuild.ErrNoVersion,
+	}, {
+		name:      "no revision",
+		fixture:   "devel.txt",
+		opts:      []gobuild.Option{gobuild.WithVersion("v0.1.0")},
+		wantError: gobuild.ErrNoRevision,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := gobuild.NewArtifactPackagedEvent(loadBuildInfo(t, tc.fixture), tc.opts...)
+			if tc.wantError != nil {
+				if !errors.Is(err, tc.wantError) {
+					t.Fatalf("error %v, want %v", err, tc.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantSource, event.GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantArtifactId, event.GetSubjectId()); d != "" {
+				t.Errorf("artifactId diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantChange, event.Subject.Content.Change); d != "" {
+				t.Errorf("change diff(-want,+got):\n%s", d)
+			}
+			if err := api.Validate(event); err != nil {
+				t.Errorf("produced event is not valid: %v", err)
+			}
+			if err := api.CheckPurl(event.GetSubjectId()); err != nil {
+				t.Errorf("produced purl is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewArtifactPackagedEventNoModule(t *testing.T) {
+	if _, err := gobuild.NewArtifactPackagedEvent(nil); !errors.Is(err, gobuild.ErrNoModule) {
+		t.Errorf("expected ErrNoModule, got %v", err)
+	}
+	if _, err := gobuild.NewArtifactPackagedEvent(&debug.BuildInfo{}); !errors.Is(err, gobuild.ErrNoModule) {
+		t.Errorf("expected ErrNoModule, got %v", err)
+	}
+}
+
+func TestSbomURI(t *testing.T) {
+	event, err := gobuild.NewArtifactPackagedEvent(loadBuildInfo(t, "myapp.txt"),
+		gobuild.WithSbomURI("https://artifacts.example.com/myapp/v1.2.0/sbom.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Subject.Content.Sbom == nil || event.Subject.Content.Sbom.Uri != "https://artifacts.example.com/myapp/v1.2.0/sbom.json" {
+		t.Errorf("unexpected sbom %v", event.Subject.Content.Sbom)
+	}
+}
+
+func TestNewArtifactPackagedEventFromFile(t *testing.T) {
+	// The test binary is a Go binary built without version and VCS information
+	path, err := os.Executable()
+	if err != nil {
+		t.Skipf("cannot find the test binary: %v", err)
+	}
+	opts := []gobuild.Option{gobuild.WithVersion("v0.0.1"), gobuild.WithChange(&api.Reference{Id: "42"})}
+	fromFile, err := gobuild.NewArtifactPackagedEventFromFile(path, opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(fromFile.GetSubjectId(), "pkg:golang/github.com/cdevents/sdk-go@v0.0.1") {
+		t.Errorf("unexpected artifactId %s", fromFile.GetSubjectId())
+	}
+	running, err := gobuild.NewArtifactPackagedEventFromRunningBinary(opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff(fromFile.GetSubjectId(), running.GetSubjectId()); d != "" {
+		t.Errorf("artifactId diff(-file,+running):\n%s", d)
+	}
+	if _, err := gobuild.NewArtifactPackagedEventFromFile(filepath.Join("testdata", "myapp.txt")); err == nil {
+		t.Error("expected an error reading a file that is not a Go binary")
+	}
+}
+
+func TestRevision(t *testing.T) {
+	if got, ok := gobuild.Revision(loadBuildInfo(t, "myapp.txt")); !ok || got != testRevision {
+		t.Errorf("revision %q, %v, want %q", got, ok, testRevision)
+	}
+	if got, ok := gobuild.Revision(loadBuildInfo(t, "devel.txt")); ok {
+		t.Errorf("unexpected revision %q", got)
+	}
+}
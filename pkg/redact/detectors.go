@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package redact
+
+import "regexp"
+
+// Detector finds sensitive values in text
+type Detector struct {
+	// Name identifies the detector in reports
+	Name string
+	// Pattern matches the sensitive values
+	Pattern *regexp.Regexp
+}
+
+var (
+	// EmailDetector finds email addresses
+	EmailDetector = Detector{
+		Name:    "email",
+		Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+	}
+
+	// AWSAccessKeyDetector finds AWS access key ids
+	AWSAccessKeyDetector = Detector{
+		Name:    "aws-access-key",
+		Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+	}
+
+	// AWSSecretKeyDetector finds AWS secret access keys assigned to a
+	// variable or key named after them
+	AWSSecretKeyDetector = Detector{
+		Name:    "aws-secret-key",
+		Pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?[A-Za-z0-9/+=]{40}`),
+	}
+
+	// GitHubTokenDetector finds GitHub personal access, OAuth, app and
+	// refresh tokens
+	GitHubTokenDetector = Detector{
+		Name:    "github-token",
+		Pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`),
+	}
+
+	// GitLabTokenDetector finds GitLab personal access tokens
+	GitLabTokenDetector = Detector{
+		Name:    "gitlab-token",
+		Pattern: regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}`),
+	}
+
+	// SlackTokenDetector finds Slack tokens
+	SlackTokenDetector = Detector{
+		Name:    "slack-token",
+		Pattern: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`),
+	}
+
+	// JWTDetector finds JSON web tokens
+	JWTDetector = Detector{
+		Name:    "jwt",
+		Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	}
+
+	// BearerTokenDetector finds bearer tokens in authorization headers
+	BearerTokenDetector = Detector{
+		Name:    "bearer-token",
+		Pattern: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]+=*`),
+	}
+
+	// DefaultDetectors are the detectors used by redactors by default
+	DefaultDetectors = []Detector{
+		EmailDetector,
+		AWSAccessKeyDetector,
+		AWSSecretKeyDetector,
+		GitHubTokenDetector,
+		GitLabTokenDetector,
+		SlackTokenDetector,
+		JWTDetector,
+		BearerTokenDetector,
+	}
+)
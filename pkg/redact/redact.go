@@ -0,0 +1,454 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package redact scrubs sensitive data from CDEvents before they leave a
+// trusted network.
+//
+// Rules select values by their location in the JSON rendering of an event,
+// with JSON pointers whose segments may be globs:
+//
+//	/subject/content/creator       the creator of a ticket
+//	/subject/content/assignees     all the assignees of a ticket
+//	/customData/*/password         the password of any top-level object of the custom data
+//	/customData/**/*token*         any value whose key contains "token", at any depth
+//
+// All the strings within a selected value are replaced. Detectors find
+// sensitive substrings, like tokens or email addresses, in the subject
+// content and the custom data, and only replace the matches. Custom data
+// that is not JSON is scrubbed as text by the detectors, and replaced as a
+// whole by a rule on /customData.
+package redact
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+)
+
+const (
+	// RedactedValue replaces redacted values, unless WithReplacement is set
+	RedactedValue = "[REDACTED]"
+
+	customDataPath     = "/customData"
+	subjectContentPath = "/subject/content"
+)
+
+var (
+	// ErrInvalidRule is returned for rules that are not JSON pointers
+	ErrInvalidRule = errors.New("invalid redaction rule")
+
+	// ErrNotWritable is returned when redacting an event that cannot be
+	// modified
+	ErrNotWritable = errors.New("event cannot be modified")
+
+	// ErrInvalidRedaction is returned when redacting makes an event invalid,
+	// e.g. when a rule replaces a value that must be a purl or an enum value
+	ErrInvalidRedaction = errors.New("redacted event is not valid")
+)
+
+// Option configures a Redactor
+type Option func(*Redactor)
+
+// WithRules adds rules that select the values to redact, as JSON pointers
+// whose segments may be globs: "*" matches within a segment, and a "**"
+// segment matches any number of segments
+func WithRules(rules ...string) Option {
+	return func(r *Redactor) {
+		r.rawRules = append(r.rawRules, rules...)
+	}
+}
+
+// WithCustomDataKeys adds rules that select the values of the given keys of
+// JSON custom data, at any depth. Keys are compared case-insensitively.
+func WithCustomDataKeys(keys ...string) Option {
+	return func(r *Redactor) {
+		for _, key := range keys {
+			r.rawRules = append(r.rawRules, customDataPath+"/**/"+escapePointer(caseInsensitiveGlob(key)))
+		}
+	}
+}
+
+// WithDetectors sets the detectors of sensitive substrings, instead of
+// DefaultDetectors. Without detectors, only rules apply.
+func WithDetectors(detectors ...Detector) Option {
+	return func(r *Redactor) {
+		r.detectors = detectors
+	}
+}
+
+// WithReplacement sets the text that replaces redacted values, by default
+// RedactedValue
+func WithReplacement(replacement string) Option {
+	return func(r *Redactor) {
+		r.replacement = replacement
+	}
+}
+
+// Redactor redacts events. A Redactor is safe for concurrent use.
+type Redactor struct {
+	rawRules    []string
+	rules       []rule
+	detectors   []Detector
+	replacement string
+}
+
+type rule struct {
+	pattern  string
+	segments []string
+}
+
+// New creates a Redactor
+func New(opts ...Option) (*Redactor, error) {
+	r := &Redactor{
+		detectors:   DefaultDetectors,
+		replacement: RedactedValue,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	for _, pattern := range r.rawRules {
+		if !strings.HasPrefix(pattern, "/") {
+			return nil, fmt.Errorf("%w %q: must start with /", ErrInvalidRule, pattern)
+		}
+		segments := splitPointer(pattern)
+		for _, segment := range segments {
+			if _, err := path.Match(segment, ""); err != nil {
+				return nil, fmt.Errorf("%w %q: %w", ErrInvalidRule, pattern, err)
+			}
+		}
+		r.rules = append(r.rules, rule{pattern: pattern, segments: segments})
+	}
+	return r, nil
+}
+
+// Finding records the redaction of a value
+type Finding struct {
+	// Path is the JSON pointer of the redacted value
+	Path string
+	// Rule is the rule that selected the value, if any
+	Rule string
+	// Detector is the name of the detector that found sensitive
+	// substrings in the value, if any
+	Detector string
+	// Count is the number of strings or substrings redacted
+	Count int
+}
+
+// Report lists what was redacted from an event. It does not include the
+// redacted values.
+type Report struct {
+	// EventId is the id of the redacted event
+	EventId  string
+	Findings []Finding
+}
+
+// Empty reports whether nothing was redacted
+func (r *Report) Empty() bool {
+	return len(r.Findings) == 0
+}
+
+// String summarizes the report
+func (r *Report) String() string {
+	if r.Empty() {
+		return fmt.Sprintf("event %s: nothing redacted", r.EventId)
+	}
+	parts := make([]string, 0, len(r.Findings))
+	for _, f := range r.Findings {
+		by := "rule " + f.Rule
+		if f.Detector != "" {
+			by = "detector " + f.Detector
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s, %d)", f.Path, by, f.Count))
+	}
+	return fmt.Sprintf("event %s: redacted %s", r.EventId, strings.Join(parts, ", "))
+}
+
+func (r *Report) add(f Finding) {
+	r.Findings = append(r.Findings, f)
+}
+
+// Redact redacts an event in place, and reports what was redacted. The
+// event must implement api.CDEventWriter, as the generated events do.
+// Redacted events are validated again, and Redact fails with
+// ErrInvalidRedaction if a replaced value breaks a format or enum
+// constraint; the event must not be sent then, as it may be partially
+// redacted.
+func (r *Redactor) Redact(event api.CDEventReader) (*Report, error) {
+	report := &Report{EventId: event.GetId()}
+	w, ok := event.(api.CDEventWriter)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotWritable, event)
+	}
+	raw, err := api.AsJsonBytes(event)
+	if err != nil {
+		return nil, err
+	}
+	var rendered map[string]any
+	if err := json.Unmarshal(raw, &rendered); err != nil {
+		return nil, err
+	}
+	// Custom data is redacted separately, as it may not be JSON
+	delete(rendered, "customData")
+	delete(rendered, "customDataContentType")
+	before := len(report.Findings)
+	redacted := r.redactTree(rendered, "", report)
+	if len(report.Findings) > before {
+		data, err := json.Marshal(redacted)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, event); err != nil {
+			return nil, fmt.Errorf("cannot update event %s: %w", event.GetId(), err)
+		}
+	}
+	if err := r.redactCustomData(event, w, report); err != nil {
+		return nil, err
+	}
+	if !report.Empty() {
+		if err := api.Validate(event); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRedaction, err)
+		}
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return report.Findings[i].Path < report.Findings[j].Path
+	})
+	return report, nil
+}
+
+// RedactFunc returns a function that redacts events, for use with
+// the sender.Redact middleware. Reports are passed to onReport, if set,
+// when something was redacted.
+func (r *Redactor) RedactFunc(onReport func(*Report)) func(event api.CDEventReader) error {
+	return func(event api.CDEventReader) error {
+		report, err := r.Redact(event)
+		if err != nil {
+			return err
+		}
+		if onReport != nil && !report.Empty() {
+			onReport(report)
+		}
+		return nil
+	}
+}
+
+func (r *Redactor) redactCustomData(event api.CDEventReader, w api.CDEventWriter, report *Report) error {
+	contentType := event.GetCustomDataContentType()
+	if contentType == "" || contentType == "application/json" {
+		raw, err := event.GetCustomDataRaw()
+		if err != nil || len(raw) == 0 {
+			return err
+		}
+		var data any
+		if err := json.Unmarshal(raw, &data); err != nil || data == nil {
+			return err
+		}
+		before := len(report.Findings)
+		data = r.redactTree(data, customDataPath, report)
+		if len(report.Findings) == before {
+			return nil
+		}
+		return w.SetCustomData("application/json", data)
+	}
+	raw, err := rawCustomData(event)
+	if err != nil || len(raw) == 0 {
+		return err
+	}
+	var redacted string
+	if rule, ok := r.match(customDataPath); ok {
+		redacted = r.replacement
+		report.add(Finding{Path: customDataPath, Rule: rule, Count: 1})
+	} else {
+		if !utf8.Valid(raw) {
+			// Binary data cannot be scanned
+			return nil
+		}
+		var found bool
+		redacted, found = r.detect(string(raw), customDataPath, report)
+		if !found {
+			return nil
+		}
+	}
+	return w.SetCustomData(contentType, []byte(redacted))
+}
+
+// rawCustomData returns custom data that is not JSON as bytes. Events
+// parsed from JSON hold it as a base64 string, that GetCustomData decodes.
+func rawCustomData(event api.CDEventReader) ([]byte, error) {
+	if raw, err := event.GetCustomDataRaw(); err == nil {
+		return raw, nil
+	}
+	data, err := event.GetCustomData()
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := data.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unsupported custom data %T with content type %s", data, event.GetCustomDataContentType())
+	}
+	return raw, nil
+}
+
+// redactTree redacts a JSON value at pointer p
+func (r *Redactor) redactTree(value any, p string, report *Report) any {
+	if p != "" {
+		if rule, ok := r.match(p); ok {
+			count := 0
+			value = r.replaceAll(value, &count)
+			if count > 0 {
+				report.add(Finding{Path: p, Rule: rule, Count: count})
+			}
+			return value
+		}
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = r.redactTree(item, p+"/"+escapePointer(key), report)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = r.redactTree(item, p+"/"+strconv.Itoa(i), report)
+		}
+	case string:
+		if isDetectable(p) {
+			if redacted, found := r.detect(v, p, report); found {
+				return redacted
+			}
+		}
+	}
+	return value
+}
+
+// isDetectable reports whether detectors apply at pointer p
+func isDetectable(p string) bool {
+	for _, prefix := range []string{subjectContentPath, customDataPath} {
+		if p == prefix || strings.HasPrefix(p, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceAll replaces all the strings within a JSON value, keeping its
+// structure so that the event can still be decoded
+func (r *Redactor) replaceAll(value any, count *int) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = r.replaceAll(item, count)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = r.replaceAll(item, count)
+		}
+	case string:
+		if v != "" && v != r.replacement {
+			*count++
+			return r.replacement
+		}
+	}
+	return value
+}
+
+// detect replaces the matches of the detectors in text
+func (r *Redactor) detect(text, p string, report *Report) (string, bool) {
+	found := false
+	for _, d := range r.detectors {
+		matches := d.Pattern.FindAllStringIndex(text, -1)
+		if len(matches) == 0 {
+			continue
+		}
+		found = true
+		text = d.Pattern.ReplaceAllLiteralString(text, r.replacement)
+		report.add(Finding{Path: p, Detector: d.Name, Count: len(matches)})
+	}
+	return text, found
+}
+
+// match returns the first rule that matches pointer p
+func (r *Redactor) match(p string) (string, bool) {
+	segments := splitPointer(p)
+	for _, rule := range r.rules {
+		if matchSegments(rule.segments, segments) {
+			return rule.pattern, true
+		}
+	}
+	return "", false
+}
+
+func matchSegments(patterns, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+	if patterns[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(patterns[0], segments[0]); !ok {
+		return false
+	}
+	return matchSegments(patterns[1:], segments[1:])
+}
+
+// splitPointer splits a JSON pointer into unescaped segments
+func splitPointer(p string) []string {
+	if p == "" || p == "/" {
+		return nil
+	}
+	segments := strings.Split(strings.TrimPrefix(p, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+	return segments
+}
+
+// caseInsensitiveGlob returns a glob that matches text in any case
+func caseInsensitiveGlob(text string) string {
+	var glob strings.Builder
+	for _, c := range text {
+		lower, upper := unicode.ToLower(c), unicode.ToUpper(c)
+		switch {
+		case lower != upper:
+			glob.WriteString("[" + string(lower) + string(upper) + "]")
+		case strings.ContainsRune(`*?[]\`, c):
+			glob.WriteString("\\" + string(c))
+		default:
+			glob.WriteRune(c)
+		}
+	}
+	return glob.String()
+}
+
+func escapePointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The types in this file hold the subset of the CycloneDX and SPDX JSON
+// formats used to find the components and their purls.
+// CycloneDX: https://cyclonedx.org/docs/1.6/json/
+// SPDX: https://spdx.github.io/spdx-spec/v2.3/
+
+const (
+	spdxDocumentId    = "SPDXRef-DOCUMENT"
+	spdxDescribes     = "DESCRIBES"
+	spdxDescribedBy   = "DESCRIBED_BY"
+	spdxPurlReference = "purl"
+)
+
+// detect is used to find the format of a document
+type detect struct {
+	BomFormat   string `json:"bomFormat"`
+	SpecVersion string `json:"specVersion"`
+	SPDXVersion string `json:"spdxVersion"`
+}
+
+type cycloneDXComponent struct {
+	Name       string               `json:"name"`
+	Version    string               `json:"version"`
+	Purl       string               `json:"purl"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXDocument struct {
+	SpecVersion string `json:"specVersion"`
+	Metadata    struct {
+		Component *cycloneDXComponent `json:"component"`
+	} `json:"metadata"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type spdxExternalRef struct {
+	ReferenceType    string `json:"referenceType"`
+	ReferenceLocator string `json:"referenceLocator"`
+}
+
+type spdxPackage struct {
+	SPDXID       string            `json:"SPDXID"`
+	Name         string            `json:"name"`
+	VersionInfo  string            `json:"versionInfo"`
+	ExternalRefs []spdxExternalRef `json:"externalRefs"`
+}
+
+// purl returns the first purl of the package, if any
+func (p spdxPackage) purl() string {
+	for _, ref := range p.ExternalRefs {
+		if ref.ReferenceType == spdxPurlReference {
+			return ref.ReferenceLocator
+		}
+	}
+	return ""
+}
+
+type spdxRelationship struct {
+	SpdxElementId      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DocumentDescribes []string           `json:"documentDescribes"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+func parseCycloneDX(data []byte) (*Document, error) {
+	var bom cycloneDXDocument
+	if err := json.Unmarshal(data, &bom); err != nil {
+		return nil, fmt.Errorf("cannot parse the CycloneDX document: %w", err)
+	}
+	doc := &Document{
+		Format:      FormatCycloneDX,
+		SpecVersion: bom.SpecVersion,
+	}
+	var walk func(components []cycloneDXComponent)
+	walk = func(components []cycloneDXComponent) {
+		for _, c := range components {
+			doc.Components = append(doc.Components, Component{Name: c.Name, Version: c.Version, Purl: c.Purl})
+			walk(c.Components)
+		}
+	}
+	if primary := bom.Metadata.Component; primary != nil {
+		doc.Primary = &Component{Name: primary.Name, Version: primary.Version, Purl: primary.Purl}
+		walk([]cycloneDXComponent{*primary})
+	}
+	walk(bom.Components)
+	return doc, nil
+}
+
+func parseSPDX(data []byte) (*Document, error) {
+	var spdx spdxDocument
+	if err := json.Unmarshal(data, &spdx); err != nil {
+		return nil, fmt.Errorf("cannot parse the SPDX document: %w", err)
+	}
+	doc := &Document{
+		Format:      FormatSPDX,
+		SpecVersion: strings.TrimPrefix(spdx.SPDXVersion, "SPDX-"),
+	}
+	// The described packages are listed in documentDescribes (SPDX 2.2)
+	// or through DESCRIBES relationships (SPDX 2.3)
+	described := append([]string{}, spdx.DocumentDescribes...)
+	for _, r := range spdx.Relationships {
+		switch {
+		case r.SpdxElementId == spdxDocumentId && r.RelationshipType == spdxDescribes:
+			described = append(described, r.RelatedSpdxElement)
+		case r.RelatedSpdxElement == spdxDocumentId && r.RelationshipType == spdxDescribedBy:
+			described = append(described, r.SpdxElementId)
+		}
+	}
+	for _, p := range spdx.Packages {
+		component := Component{Name: p.Name, Version: p.VersionInfo, Purl: p.purl()}
+		doc.Components = append(doc.Components, component)
+		if doc.Primary == nil && len(described) > 0 && p.SPDXID == described[0] {
+			doc.Primary = &component
+		}
+	}
+	return doc, nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package sbom reads CycloneDX and SPDX JSON documents to build artifact
+// events that reference them.
+//
+// The purl of the primary component of the SBOM, i.e. the component the
+// SBOM describes, is used as artifactId of the ArtifactPackaged and
+// ArtifactPublished events, and the location of the SBOM as their sbom uri.
+// Verify checks that the artifactId of any event is a component of an SBOM.
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	purl "github.com/package-url/packageurl-go"
+)
+
+// Format is the format of an SBOM document
+type Format string
+
+const (
+	// FormatCycloneDX is the CycloneDX JSON format
+	FormatCycloneDX Format = "CycloneDX"
+	// FormatSPDX is the SPDX JSON format
+	FormatSPDX Format = "SPDX"
+)
+
+var (
+	// ErrUnsupportedFormat is returned for documents that are neither
+	// CycloneDX nor SPDX JSON documents
+	ErrUnsupportedFormat = errors.New("unsupported SBOM format")
+
+	// ErrNoPrimaryComponent is returned when the primary component of an
+	// SBOM is missing or has no purl
+	ErrNoPrimaryComponent = errors.New("SBOM has no primary component with a purl")
+
+	// ErrArtifactNotFound is returned when the artifactId of an event is
+	// not a component of the SBOM
+	ErrArtifactNotFound = errors.New("artifact not found in the SBOM")
+)
+
+// Component is a software component listed in an SBOM
+type Component struct {
+	Name    string
+	Version string
+	// Purl is the package URL of the component, if the SBOM provides one
+	Purl string
+}
+
+// Document is an SBOM document
+type Document struct {
+	Format      Format
+	SpecVersion string
+	// Primary is the component described by the SBOM, if any
+	Primary *Component
+	// Components lists all the components of the SBOM, including the
+	// primary one
+	Components []Component
+}
+
+// Parse parses a CycloneDX or SPDX JSON document
+func Parse(data []byte) (*Document, error) {
+	var d detect
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, fmt.Errorf("cannot parse the SBOM: %w", err)
+	}
+	switch {
+	case d.BomFormat == string(FormatCycloneDX):
+		return parseCycloneDX(data)
+	case strings.HasPrefix(d.SPDXVersion, "SPDX-"):
+		return parseSPDX(data)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// ReadFile reads and parses a CycloneDX or SPDX JSON document
+func ReadFile(path string) (*Document, error) {
+	// Reading the file chosen by the caller is the purpose of ReadFile
+	data, err := os.ReadFile(filepath.Clean(path)) //nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// PrimaryPurl returns the purl of the primary component of the SBOM
+func (d *Document) PrimaryPurl() (string, error) {
+	if d.Primary == nil || d.Primary.Purl == "" {
+		return "", ErrNoPrimaryComponent
+	}
+	if _, err := purl.FromString(d.Primary.Purl); err != nil {
+		return "", fmt.Errorf("%w: %s", ErrNoPrimaryComponent, err)
+	}
+	return d.Primary.Purl, nil
+}
+
+// Find returns the component of the SBOM identified by packageURL, if any.
+// Package URLs match when their type, namespace, name, version and subpath
+// are the same, and the qualifiers they share have the same values, so that
+// a purl without qualifiers matches any of its variants.
+func (d *Document) Find(packageURL string) (*Component, bool) {
+	want, err := purl.FromString(packageURL)
+	if err != nil {
+		return nil, false
+	}
+	for i, c := range d.Components {
+		got, err := purl.FromString(c.Purl)
+		if err == nil && match(want, got) {
+			return &d.Components[i], true
+		}
+	}
+	return nil, false
+}
+
+func match(a, b purl.PackageURL) bool {
+	if !strings.EqualFold(a.Type, b.Type) || a.Namespace != b.Namespace ||
+		a.Name != b.Name || a.Version != b.Version || a.Subpath != b.Subpath {
+		return false
+	}
+	bq := b.Qualifiers.Map()
+	for key, value := range a.Qualifiers.Map() {
+		if other, ok := bq[key]; ok && other != value {
+			return false
+		}
+	}
+	return true
+}
+
+// NewArtifactPackagedEvent creates an ArtifactPackaged event for the
+// primary component of the SBOM, with the sbom uri set to sbomURI
+func NewArtifactPackagedEvent(d *Document, sbomURI string) (*cdeventsv05.ArtifactPackagedEvent, error) {
+	artifactId, err := d.PrimaryPurl()
+	if err != nil {
+		return nil, err
+	}
+	event, err := cdeventsv05.NewArtifactPackagedEvent()
+	if err != nil {
+		return nil, err
+	}
+	event.SetSubjectId(artifactId)
+	event.SetSubjectSbom(&cdeventsv05.ArtifactPackagedSubjectContentSbom{Uri: sbomURI})
+	return event, nil
+}
+
+// NewArtifactPublishedEvent creates an ArtifactPublished event for the
+// primary component of the SBOM, with the sbom uri set to sbomURI
+func NewArtifactPublishedEvent(d *Document, sbomURI string) (*cdeventsv05.ArtifactPublishedEvent, error) {
+	artifactId, err := d.PrimaryPurl()
+	if err != nil {
+		return nil, err
+	}
+	event, err := cdeventsv05.NewArtifactPublishedEvent()
+	if err != nil {
+		return nil, err
+	}
+	event.SetSubjectId(artifactId)
+	event.SetSubjectSbom(&cdeventsv05.ArtifactPublishedSubjectContentSbom{Uri: sbomURI})
+	return event, nil
+}
+
+// ArtifactId returns the artifactId of an event: the subject id of artifact
+// events, or the artifactId field of the subject content of other events,
+// like ServiceDeployed or BuildFinished. It returns false when the event
+// does not reference an artifact.
+func ArtifactId(event api.CDEventReader) (string, bool) {
+	if event.GetType().Subject == "artifact" {
+		return event.GetSubjectId(), event.GetSubjectId() != ""
+	}
+	content, err := json.Marshal(event.GetSubjectContent())
+	if err != nil {
+		return "", false
+	}
+	var fields struct {
+		ArtifactId string `json:"artifactId"`
+	}
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return "", false
+	}
+	return fields.ArtifactId, fields.ArtifactId != ""
+}
+
+// Verify checks that the artifactId of event is a component of the SBOM
+func Verify(event api.CDEventReader, d *Document) error {
+	artifactId, ok := ArtifactId(event)
+	if !ok {
+		return fmt.Errorf("%w: event %s has no artifactId", ErrArtifactNotFound, event.GetId())
+	}
+	if _, found := d.Find(artifactId); !found {
+		return fmt.Errorf("%w: %s", ErrArtifactNotFound, artifactId)
+	}
+	return nil
+}
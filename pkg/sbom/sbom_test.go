@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sbom_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/sbom"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testImagePurl  = "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?repository_url=ghcr.io%2Facme%2Fmyapp&tag=v1.2.0"
+	testModulePurl = "pkg:golang/github.com/acme/myapp@v1.2.0"
+	testSbomURI    = "https://artifacts.example.com/myapp/v1.2.0/sbom.json"
+)
+
+func mustReadFile(t *testing.T, name string) *sbom.Document {
+	t.Helper()
+	doc, err := sbom.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("cannot read SBOM %s: %v", name, err)
+	}
+	return doc
+}
+
+func TestReadFile(t *testing.T) {
+	tests := []struct {
+		file            string
+		wantFormat      sbom.Format
+		wantSpecVersion string
+		wantPrimary     *sbom.Component
+		wantComponents  int
+	}{{
+		file:            "cyclonedx.json",
+		wantFormat:      sbom.FormatCycloneDX,
+		wantSpecVersion: "1.5",
+		wantPrimary: &sbom.Component{
+			Name:    "ghcr.io/acme/myapp",
+			Version: "sha256:0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427",
+			Purl:    testImagePurl,
+		},
+		wantComponents: 5,
+	}, {
+		file:            "spdx.json",
+		wantFormat:      sbom.FormatSPDX,
+		wantSpecVersion: "2.3",
+		wantPrimary: &sbom.Component{
+			Name:    "github.com/acme/myapp",
+			Version: "v1.2.0",
+			Purl:    testModulePurl,
+		},
+		wantComponents: 2,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.file, func(t *testing.T) {
+			doc := mustReadFile(t, tc.file)
+			if d := cmp.Diff(tc.wantFormat, doc.Format); d != "" {
+				t.Errorf("format diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSpecVersion, doc.SpecVersion); d != "" {
+				t.Errorf("spec version diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantPrimary, doc.Primary); d != "" {
+				t.Errorf("primary diff(-want,+got):\n%s", d)
+			}
+			if len(doc.Components) != tc.wantComponents {
+				t.Errorf("expected %d components, got %d", tc.wantComponents, len(doc.Components))
+			}
+			got, err := doc.PrimaryPurl()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantPrimary.Purl, got); d != "" {
+				t.Errorf("primary purl diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		document    string
+		wantPrimary *sbom.Component
+		wantError   error
+	}{{
+		name: "SPDX 2.2 documentDescribes",
+		document: `{"spdxVersion": "SPDX-2.2", "documentDescribes": ["SPDXRef-myapp"], "packages": [
+			{"SPDXID": "SPDXRef-myapp", "name": "myapp", "externalRefs": [{"referenceType": "purl", "referenceLocator": "pkg:npm/myapp@1.0.0"}]}]}`,
+		wantPrimary: &sbom.Component{Name: "myapp", Purl: "pkg:npm/myapp@1.0.0"},
+	}, {
+		name: "SPDX described by",
+		document: `{"spdxVersion": "SPDX-2.3", "packages": [{"SPDXID": "SPDXRef-myapp", "name": "myapp"}],
+			"relationships": [{"spdxElementId": "SPDXRef-myapp", "relationshipType": "DESCRIBED_BY", "relatedSpdxElement": "SPDXRef-DOCUMENT"}]}`,
+		wantPrimary: &sbom.Component{Name: "myapp"},
+	}, {
+		name:     "CycloneDX without metadata",
+		document: `{"bomFormat": "CycloneDX", "specVersion": "1.4", "components": [{"name": "left-pad", "purl": "pkg:npm/left-pad@1.3.0"}]}`,
+	}, {
+		name:      "not an SBOM",
+		document:  `{"apiVersion": "v1", "kind": "Pod"}`,
+		wantError: sbom.ErrUnsupportedFormat,
+	}, {
+		name:     "not JSON",
+		document: `<bom xmlns="http://cyclonedx.org/schema/bom/1.5"/>`,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := sbom.Parse([]byte(tc.document))
+			if tc.wantError != nil && !errors.Is(err, tc.wantError) {
+				t.Fatalf("error %v, want %v", err, tc.wantError)
+			}
+			if tc.wantError != nil {
+				return
+			}
+			if tc.document[0] != '{' {
+				if err == nil {
+					t.Error("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantPrimary, doc.Primary); d != "" {
+				t.Errorf("primary diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestPrimaryPurlMissing(t *testing.T) {
+	doc, err := sbom.Parse([]byte(`{"bomFormat": "CycloneDX", "specVersion": "1.5", "metadata": {"component": {"name": "myapp"}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := doc.PrimaryPurl(); !errors.Is(err, sbom.ErrNoPrimaryComponent) {
+		t.Errorf("expected ErrNoPrimaryComponent, got %v", err)
+	}
+	if _, err := sbom.NewArtifactPackagedEvent(doc, testSbomURI); !errors.Is(err, sbom.ErrNoPrimaryComponent) {
+		t.Errorf("expected ErrNoPrimaryComponent, got %v", err)
+	}
+}
+
+func TestFind(t *testing.T) {
+	doc := mustReadFile(t, "cyclonedx.json")
+	tests := []struct {
+		purl     string
+		wantName string
+	}{{
+		purl:     testImagePurl,
+		wantName: "ghcr.io/acme/myapp",
+	}, {
+		// Qualifiers missing from the purl are ignored
+		purl:     "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427",
+		wantName: "ghcr.io/acme/myapp",
+	}, {
+		// Nested components are included
+		purl:     "pkg:golang/github.com/google/uuid@v1.6.0",
+		wantName: "github.com/google/uuid",
+	}, {
+		purl: "pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427?tag=latest",
+	}, {
+		purl: "pkg:golang/github.com/google/uuid@v1.5.0",
+	}, {
+		purl: "not-a-purl",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.purl, func(t *testing.T) {
+			component, found := doc.Find(tc.purl)
+			if found != (tc.wantName != "") {
+				t.Fatalf("found %v, want %v", found, tc.wantName != "")
+			}
+			if found && component.Name != tc.wantName {
+				t.Errorf("name %q, want %q", component.Name, tc.wantName)
+			}
+		})
+	}
+}
+
+func TestNewArtifactEvents(t *testing.T) {
+	doc := mustReadFile(t, "cyclonedx.json")
+	packaged, err := sbom.NewArtifactPackagedEvent(doc, testSbomURI)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	packaged.SetSource("/ci/myapp")
+	packaged.SetSubjectChange(&api.Reference{Id: "42", Source: "https://github.com/acme/myapp"})
+	published, err := sbom.NewArtifactPublishedEvent(doc, testSbomURI)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	published.SetSource("/ci/myapp")
+	events := []api.CDEventV04{packaged, published}
+	for _, event := range events {
+		if d := cmp.Diff(testImagePurl, event.GetSubjectId()); d != "" {
+			t.Errorf("subject id diff(-want,+got):\n%s", d)
+		}
+		if err := api.Validate(event); err != nil {
+			t.Errorf("produced event is not valid: %v", err)
+		}
+		if err := sbom.Verify(event, doc); err != nil {
+			t.Errorf("unexpected verification error: %v", err)
+		}
+	}
+	if d := cmp.Diff(&cdeventsv05.ArtifactPackagedSubjectContentSbom{Uri: testSbomURI}, packaged.Subject.Content.Sbom); d != "" {
+		t.Errorf("packaged sbom diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(&cdeventsv05.ArtifactPublishedSubjectContentSbom{Uri: testSbomURI}, published.Subject.Content.Sbom); d != "" {
+		t.Errorf("published sbom diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	doc := mustReadFile(t, "spdx.json")
+	deployed := func(artifactId string) api.CDEventReader {
+		event, err := cdeventsv05.NewServiceDeployedEvent()
+		if err != nil {
+			t.Fatalf("cannot create event: %v", err)
+		}
+		event.SetSubjectArtifactId(artifactId)
+		return event
+	}
+	signed := func(artifactId string) api.CDEventReader {
+		event, err := cdeventsv05.NewArtifactSignedEvent()
+		if err != nil {
+			t.Fatalf("cannot create event: %v", err)
+		}
+		event.SetSubjectId(artifactId)
+		return event
+	}
+	environmentCreated, err := cdeventsv05.NewEnvironmentCreatedEvent()
+	if err != nil {
+		t.Fatalf("cannot create event: %v", err)
+	}
+	tests := []struct {
+		name      string
+		event     api.CDEventReader
+		wantError bool
+	}{{
+		name:  "service deployed",
+		event: deployed(testModulePurl),
+	}, {
+		name:  "artifact signed",
+		event: signed("pkg:golang/github.com/google/uuid@v1.6.0"),
+	}, {
+		name:      "other version",
+		event:     deployed("pkg:golang/github.com/acme/myapp@v1.1.0"),
+		wantError: true,
+	}, {
+		name:      "artifact not in the sbom",
+		event:     signed(testImagePurl),
+		wantError: true,
+	}, {
+		name:      "no artifactId",
+		event:     environmentCreated,
+		wantError: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := sbom.Verify(tc.event, doc)
+			if tc.wantError && !errors.Is(err, sbom.ErrArtifactNotFound) {
+				t.Errorf("expected ErrArtifactNotFound, got %v", err)
+			}
+			if !tc.wantError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sender
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+)
+
+// LinkKindTrigger is the kind of the relation link to the event that
+// triggered an event
+const LinkKindTrigger = "TRIGGER"
+
+// Middleware wraps a Sender, to enrich, check or observe events before
+// they are sent. Middlewares that change events modify them in place.
+type Middleware func(next Sender) Sender
+
+// Chain wraps s with middlewares. The first middleware is the outermost
+// one, so it sees events first.
+func Chain(s Sender, middlewares ...Middleware) Sender {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		s = middlewares[i](s)
+	}
+	return s
+}
+
+// Enrich returns a Middleware that calls fn on events before sending them.
+// Events are not sent when fn returns an error.
+func Enrich(fn func(ctx context.Context, event api.CDEventReader) error) Middleware {
+	return func(next Sender) Sender {
+		return SenderFunc(func(ctx context.Context, event api.CDEventReader) error {
+			if err := fn(ctx, event); err != nil {
+				return err
+			}
+			return next.Send(ctx, event)
+		})
+	}
+}
+
+// DefaultSource returns a Middleware that sets the source of events that
+// have none. The subject source defaults to the source as well.
+func DefaultSource(source string) Middleware {
+	return Enrich(func(_ context.Context, event api.CDEventReader) error {
+		if w, ok := event.(api.CDEventWriter); ok && event.GetSource() == "" {
+			w.SetSource(source)
+		}
+		return nil
+	})
+}
+
+// SourcePrefix returns a Middleware that prefixes the source of events
+// with prefix, joined as paths: with the "/team/payments" prefix, the
+// "/ci/build" source becomes "/team/payments/ci/build". Events without a
+// source get prefix as source, and sources that already start with prefix
+// are not modified. A subject source equal to the source is prefixed too.
+func SourcePrefix(prefix string) Middleware {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return Enrich(func(_ context.Context, event api.CDEventReader) error {
+		w, ok := event.(api.CDEventWriter)
+		if !ok {
+			return nil
+		}
+		source := event.GetSource()
+		if source == prefix || strings.HasPrefix(source, prefix+"/") {
+			return nil
+		}
+		prefixed := prefix
+		if source != "" {
+			prefixed += "/" + strings.TrimPrefix(source, "/")
+		}
+		if event.GetSubjectSource() == source {
+			w.SetSubjectSource(prefixed)
+		}
+		w.SetSource(prefixed)
+		return nil
+	})
+}
+
+// ChainContext holds the chain that the events sent with a context belong
+// to
+type ChainContext struct {
+	// ChainId is the chainId of the events
+	ChainId string
+	// Trigger is the id of the event that triggered the events, if any
+	Trigger string
+	// Tags are set on the link to the trigger event
+	Tags api.Tags
+}
+
+type chainContextKey struct{}
+
+// ContextWithChain returns a copy of ctx that carries a chain context
+func ContextWithChain(ctx context.Context, chain ChainContext) context.Context {
+	return context.WithValue(ctx, chainContextKey{}, chain)
+}
+
+// ContextWithTrigger returns a copy of ctx with the chain context of the
+// events triggered by event: they share its chainId, or use its id as
+// chainId when it has none, and link to it
+func ContextWithTrigger(ctx context.Context, event api.CDEventReader) context.Context {
+	chain := ChainContext{ChainId: event.GetId(), Trigger: event.GetId()}
+	if v04, ok := event.(api.CDEventReaderV04); ok && v04.GetChainId() != "" {
+		chain.ChainId = v04.GetChainId()
+	}
+	return ContextWithChain(ctx, chain)
+}
+
+// ChainFromContext returns the chain context carried by ctx, if any
+func ChainFromContext(ctx context.Context) (ChainContext, bool) {
+	chain, ok := ctx.Value(chainContextKey{}).(ChainContext)
+	return chain, ok
+}
+
+// InjectChainContext returns a Middleware that sets the chainId of events
+// from the chain context of the context they are sent with, unless they
+// have one already, and links them to the trigger event of the chain.
+// Events of spec versions without chainId and links are not modified.
+func InjectChainContext() Middleware {
+	return Enrich(func(ctx context.Context, event api.CDEventReader) error {
+		chain, ok := ChainFromContext(ctx)
+		if !ok {
+			return nil
+		}
+		w, ok := event.(api.CDEventV04)
+		if !ok {
+			return nil
+		}
+		if w.GetChainId() == "" {
+			w.SetChainId(chain.ChainId)
+		}
+		if chain.Trigger == "" || hasTrigger(w.GetLinks(), chain.Trigger) {
+			return nil
+		}
+		link := api.NewEmbeddedLinkRelation()
+		link.SetLinkKind(LinkKindTrigger)
+		link.SetTarget(api.EventReference{ContextId: chain.Trigger})
+		tags := chain.Tags
+		if tags == nil {
+			// The schema requires tags to be an object
+			tags = api.Tags{}
+		}
+		link.SetTags(tags)
+		w.SetLinks(append(w.GetLinks(), link))
+		return nil
+	})
+}
+
+func hasTrigger(links api.EmbeddedLinksArray, trigger string) bool {
+	for _, link := range links {
+		relation, ok := link.(api.EmbeddedLinkWithTagsAndRelation)
+		if ok && relation.GetLinkKind() == LinkKindTrigger && relation.GetTarget().ContextId == trigger {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns a Middleware that only sends valid events, see
+// api.Validate
+func Validate() Middleware {
+	return Enrich(func(_ context.Context, event api.CDEventReader) error {
+		return api.Validate(event)
+	})
+}
+
+// RedactFunc removes sensitive data from an event
+type RedactFunc func(event api.CDEventReader) error
+
+// Redact returns a Middleware that redacts events with fn before sending
+// them
+func Redact(fn RedactFunc) Middleware {
+	return Enrich(func(_ context.Context, event api.CDEventReader) error {
+		return fn(event)
+	})
+}
+
+// MetricsRecorder records the outcome of sending events
+type MetricsRecorder interface {
+	// RecordSend records that an event was sent in duration, or failed
+	// to be sent with err
+	RecordSend(ctx context.Context, event api.CDEventReader, duration time.Duration, err error)
+}
+
+// Metrics returns a Middleware that records the outcome of sending events
+// with recorder
+func Metrics(recorder MetricsRecorder) Middleware {
+	return func(next Sender) Sender {
+		return SenderFunc(func(ctx context.Context, event api.CDEventReader) error {
+			start := time.Now()
+			err := next.Send(ctx, event)
+			recorder.RecordSend(ctx, event, time.Since(start), err)
+			return err
+		})
+	}
+}
+
+// Counters is a MetricsRecorder that counts sent and failed events by
+// unversioned event type
+type Counters struct {
+	mu     sync.Mutex
+	sent   map[string]int
+	failed map[string]int
+}
+
+// NewCounters creates Counters
+func NewCounters() *Counters {
+	return &Counters{sent: map[string]int{}, failed: map[string]int{}}
+}
+
+// RecordSend implements MetricsRecorder
+func (c *Counters) RecordSend(_ context.Context, event api.CDEventReader, _ time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.failed[event.GetType().UnversionedString()]++
+		return
+	}
+	c.sent[event.GetType().UnversionedString()]++
+}
+
+// Sent returns the number of events of a type sent successfully
+func (c *Counters) Sent(eventType api.CDEventType) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sent[eventType.UnversionedString()]
+}
+
+// Failed returns the number of events of a type that failed to be sent
+func (c *Counters) Failed(eventType api.CDEventType) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.failed[eventType.UnversionedString()]
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sender_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	"github.com/google/go-cmp/cmp"
+)
+
+// collect returns a Sender that collects the events it is sent
+func collect(events *[]api.CDEventReader) sender.Sender {
+	return sender.SenderFunc(func(_ context.Context, event api.CDEventReader) error {
+		*events = append(*events, event)
+		return nil
+	})
+}
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+	trace := func(name string) sender.Middleware {
+		return func(next sender.Sender) sender.Sender {
+			return sender.SenderFunc(func(ctx context.Context, event api.CDEventReader) error {
+				calls = append(calls, name)
+				return next.Send(ctx, event)
+			})
+		}
+	}
+	var sent []api.CDEventReader
+	s := sender.Chain(collect(&sent), trace("first"), trace("second"), trace("third"))
+	if err := s.Send(context.Background(), newServiceDeployed("myapp")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff([]string{"first", "second", "third"}, calls); d != "" {
+		t.Errorf("calls diff(-want,+got):\n%s", d)
+	}
+	if len(sent) != 1 {
+		t.Errorf("expected one event to be sent, got %d", len(sent))
+	}
+}
+
+func TestDefaultSource(t *testing.T) {
+	var sent []api.CDEventReader
+	s := sender.Chain(collect(&sent), sender.DefaultSource("/team/default"))
+
+	withSource := newServiceDeployed("myapp")
+	withoutSource, err := cdeventsv05.NewServiceDeployedEvent()
+	panicOnError(err)
+	for _, event := range []api.CDEventReader{withSource, withoutSource} {
+		if err := s.Send(context.Background(), event); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if d := cmp.Diff("/sender/test", sent[0].GetSource()); d != "" {
+		t.Errorf("source diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff("/team/default", sent[1].GetSource()); d != "" {
+		t.Errorf("source diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff("/team/default", sent[1].GetSubjectSource()); d != "" {
+		t.Errorf("subject source diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestSourcePrefix(t *testing.T) {
+	withSubjectSource := newServiceDeployed("myapp")
+	withSubjectSource.SetSubjectSource("/clusters/eu")
+	withoutSource, err := cdeventsv05.NewServiceDeployedEvent()
+	panicOnError(err)
+	prefixed := newServiceDeployed("myapp")
+	prefixed.SetSource("/team/payments/sender/test")
+
+	tests := []struct {
+		name              string
+		event             api.CDEventReader
+		wantSource        string
+		wantSubjectSource string
+	}{{
+		name:              "source",
+		event:             newServiceDeployed("myapp"),
+		wantSource:        "/team/payments/sender/test",
+		wantSubjectSource: "/team/payments/sender/test",
+	}, {
+		name:              "subject source",
+		event:             withSubjectSource,
+		wantSource:        "/team/payments/sender/test",
+		wantSubjectSource: "/clusters/eu",
+	}, {
+		name:              "no source",
+		event:             withoutSource,
+		wantSource:        "/team/payments",
+		wantSubjectSource: "/team/payments",
+	}, {
+		name:              "already prefixed",
+		event:             prefixed,
+		wantSource:        "/team/payments/sender/test",
+		wantSubjectSource: "/sender/test",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var sent []api.CDEventReader
+			s := sender.Chain(collect(&sent), sender.SourcePrefix("/team/payments/"))
+			if err := s.Send(context.Background(), tc.event); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantSource, sent[0].GetSource()); d != "" {
+				t.Errorf("source diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantSubjectSource, sent[0].GetSubjectSource()); d != "" {
+				t.Errorf("subject source diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestInjectChainContext(t *testing.T) {
+	trigger := newServiceDeployed("myapp")
+	trigger.SetChainId("0b0a1c9a-6b4e-4a39-9f5e-2d6c1a7c5e11")
+
+	var sent []api.CDEventReader
+	s := sender.Chain(collect(&sent), sender.InjectChainContext())
+
+	// Without chain context, events are not modified
+	unchained := newServiceDeployed("other")
+	if err := s.Send(context.Background(), unchained); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unchained.GetChainId() != "" || len(unchained.GetLinks()) != 0 {
+		t.Errorf("expected no chainId and links, got %q and %v", unchained.GetChainId(), unchained.GetLinks())
+	}
+
+	ctx := sender.ContextWithTrigger(context.Background(), trigger)
+	event := newServiceDeployed("myapp")
+	// Sending twice does not link twice
+	for i := 0; i < 2; i++ {
+		if err := s.Send(ctx, event); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if d := cmp.Diff(trigger.GetChainId(), event.GetChainId()); d != "" {
+		t.Errorf("chainId diff(-want,+got):\n%s", d)
+	}
+	links := event.GetLinks()
+	if len(links) != 1 {
+		t.Fatalf("expected one link, got %d", len(links))
+	}
+	relation, ok := links[0].(api.EmbeddedLinkWithTagsAndRelation)
+	if !ok {
+		t.Fatalf("expected a relation link, got %T", links[0])
+	}
+	if d := cmp.Diff(sender.LinkKindTrigger, relation.GetLinkKind()); d != "" {
+		t.Errorf("link kind diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(trigger.GetId(), relation.GetTarget().ContextId); d != "" {
+		t.Errorf("link target diff(-want,+got):\n%s", d)
+	}
+	if err := api.Validate(event); err != nil {
+		t.Errorf("expected a valid event, got %v", err)
+	}
+
+	// Events that have a chainId keep it
+	chained := newServiceDeployed("chained")
+	chained.SetChainId("a4d6e0b2-6f3b-4f9c-8f0e-7c1b2a3d4e5f")
+	if err := s.Send(ctx, chained); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff("a4d6e0b2-6f3b-4f9c-8f0e-7c1b2a3d4e5f", chained.GetChainId()); d != "" {
+		t.Errorf("chainId diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var sent []api.CDEventReader
+	s := sender.Chain(collect(&sent), sender.Validate())
+	if err := s.Send(context.Background(), newServiceDeployed("")); err == nil {
+		t.Error("expected an error for an invalid event, got none")
+	}
+	if err := s.Send(context.Background(), newServiceDeployed("myapp")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sent) != 1 {
+		t.Errorf("expected one event to be sent, got %d", len(sent))
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	errBroker := errors.New("broker unavailable")
+	var fail bool
+	next := sender.SenderFunc(func(context.Context, api.CDEventReader) error {
+		if fail {
+			return errBroker
+		}
+		return nil
+	})
+	counters := sender.NewCounters()
+	s := sender.Chain(next, sender.Metrics(counters))
+	for _, f := range []bool{false, false, true} {
+		fail = f
+		err := s.Send(context.Background(), newServiceDeployed("myapp"))
+		if f && !errors.Is(err, errBroker) {
+			t.Errorf("error %v, want %v", err, errBroker)
+		}
+	}
+	if d := cmp.Diff(2, counters.Sent(cdeventsv05.ServiceDeployedEventType)); d != "" {
+		t.Errorf("sent diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(1, counters.Failed(cdeventsv05.ServiceDeployedEventType)); d != "" {
+		t.Errorf("failed diff(-want,+got):\n%s", d)
+	}
+	if d := cmp.Diff(0, counters.Sent(cdeventsv05.PipelineRunStartedEventType)); d != "" {
+		t.Errorf("sent diff(-want,+got):\n%s", d)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package sender defines a common interface to deliver CDEvents, so that
+// producers like the adapters can stream events to a CloudEvents client,
+// a writer or any other destination.
+//
+// Middlewares wrap a Sender to apply the policies of producers in one place,
+// e.g.:
+//
+//	redactor, err := redact.New(redact.WithCustomDataKeys("token"))
+//	...
+//	s := sender.Chain(sender.NewCloudEventsSender(client),
+//		sender.SourcePrefix("/team/payments"),
+//		sender.InjectChainContext(),
+//		sender.Redact(redactor.RedactFunc(nil)),
+//		sender.Validate(),
+//	)
+package sender
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// Sender delivers CDEvents
+type Sender interface {
+	// Send delivers an event, returning an error if it could not be
+	// delivered
+	Send(ctx context.Context, event api.CDEventReader) error
+}
+
+// SenderFunc is an adapter to use an ordinary function as Sender
+type SenderFunc func(ctx context.Context, event api.CDEventReader) error
+
+// Send calls f(ctx, event)
+func (f SenderFunc) Send(ctx context.Context, event api.CDEventReader) error {
+	return f(ctx, event)
+}
+
+type cloudEventsSender struct {
+	client cloudevents.Client
+}
+
+// NewCloudEventsSender returns a Sender that renders events as CloudEvents
+// and sends them with client. The target of the events is set on the
+// context, e.g. with cloudevents.ContextWithTarget.
+func NewCloudEventsSender(client cloudevents.Client) Sender {
+	return &cloudEventsSender{client: client}
+}
+
+func (s *cloudEventsSender) Send(ctx context.Context, event api.CDEventReader) error {
+	ce, err := api.AsCloudEvent(event)
+	if err != nil {
+		return err
+	}
+	if result := s.client.Send(ctx, *ce); !cloudevents.IsACK(result) {
+		return fmt.Errorf("failed to send event %s: %w", event.GetId(), result)
+	}
+	return nil
+}
+
+type writerSender struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSender returns a Sender that validates events and writes them
+// to w as JSON, one event per line
+func NewWriterSender(w io.Writer) Sender {
+	return &writerSender{w: w}
+}
+
+func (s *writerSender) Send(_ context.Context, event api.CDEventReader) error {
+	if err := api.Validate(event); err != nil {
+		return err
+	}
+	data, err := api.AsJsonBytes(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
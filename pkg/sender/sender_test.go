@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The CDEvents Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sender_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cdeventsv05 "github.com/cdevents/sdk-go/pkg/api/v05"
+	"github.com/cdevents/sdk-go/pkg/cdeventstest"
+	"github.com/cdevents/sdk-go/pkg/sender"
+	"github.com/google/go-cmp/cmp"
+)
+
+func panicOnError(err error) {
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+func newServiceDeployed(subjectId string) *cdeventsv05.ServiceDeployedEvent {
+	event, err := cdeventsv05.NewServiceDeployedEvent()
+	panicOnError(err)
+	event.SetSource("/sender/test")
+	event.SetSubjectId(subjectId)
+	event.SetSubjectArtifactId("pkg:oci/myapp@sha256%3A0b31b1c02ff458ad9b7b81cbdf8f028bd54699fa151f221d1e8de6817db93427")
+	event.SetSubjectEnvironment(&api.Reference{Id: "production"})
+	return event
+}
+
+func TestCloudEventsSender(t *testing.T) {
+	recorder := cdeventstest.NewRecorder()
+	s := sender.NewCloudEventsSender(recorder)
+	if err := s.Send(context.Background(), newServiceDeployed("myapp")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	recorder.AssertEmitted(t, cdeventsv05.ServiceDeployedEventType, cdeventstest.WithSubjectId("myapp"))
+
+	// Invalid events are not sent
+	if err := s.Send(context.Background(), newServiceDeployed("")); err == nil {
+		t.Error("expected an error for an invalid event, got none")
+	}
+	recorder.AssertCount(t, 1)
+
+	// Delivery failures are reported
+	errNack := errors.New("broker unavailable")
+	recorder.SetResult(errNack)
+	if err := s.Send(context.Background(), newServiceDeployed("myapp")); !errors.Is(err, errNack) {
+		t.Errorf("error %v, want %v", err, errNack)
+	}
+}
+
+func TestWriterSender(t *testing.T) {
+	var out bytes.Buffer
+	s := sender.NewWriterSender(&out)
+	for _, subjectId := range []string{"myapp", "otherapp"} {
+		if err := s.Send(context.Background(), newServiceDeployed(subjectId)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d:\n%s", len(lines), out.String())
+	}
+	for i, want := range []string{"myapp", "otherapp"} {
+		event, err := cdeventsv05.NewFromJsonBytes([]byte(lines[i]))
+		if err != nil {
+			t.Fatalf("cannot parse line %d: %v", i, err)
+		}
+		if d := cmp.Diff(want, event.GetSubjectId()); d != "" {
+			t.Errorf("subject id diff(-want,+got):\n%s", d)
+		}
+	}
+	if err := s.Send(context.Background(), newServiceDeployed("")); err == nil {
+		t.Error("expected an error for an invalid event, got none")
+	}
+}
+
+func TestSenderFunc(t *testing.T) {
+	var got []string
+	s := sender.SenderFunc(func(_ context.Context, event api.CDEventReader) error {
+		got = append(got, event.GetSubjectId())
+		return nil
+	})
+	if err := s.Send(context.Background(), newServiceDeployed("myapp")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff([]string{"myapp"}, got); d != "" {
+		t.Errorf("subject ids diff(-want,+got):\n%s", d)
+	}
+}
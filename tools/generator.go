@@ -29,9 +29,11 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v6"
 	"golang.org/x/mod/semver"
@@ -115,6 +117,10 @@ type ContentField struct {
 	NameLower string
 	Type      string
 	Required  bool
+	Validate  string
+	// Copy is the kind of copy required by DeepCopyInto, see fieldType
+	Copy     string
+	ElemType string
 }
 
 // ContentType holds the data required to render any custom
@@ -124,6 +130,29 @@ type ContentType struct {
 	Fields []ContentField
 }
 
+// ContentEnum holds the data required to render a string type
+// with a constant for each value of an enum. When free text is allowed
+// too, only untyped constants are rendered.
+type ContentEnum struct {
+	Name     string
+	Values   []ContentEnumValue
+	FreeText bool
+}
+
+// Article returns the indefinite article to use before the name of the enum
+func (e ContentEnum) Article() string {
+	if strings.ContainsRune("AEIOU", rune(e.Name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// ContentEnumValue holds an enum value and the name of its constant
+type ContentEnumValue struct {
+	Name  string
+	Value string
+}
+
 type Data struct {
 	Subject         string
 	SubjectLower    string
@@ -134,6 +163,7 @@ type Data struct {
 	SubjectType     string
 	Contents        []ContentField
 	ContentTypes    []ContentType
+	ContentEnums    []ContentEnum
 	Prefix          string
 	Schema          string
 	IsTestData      bool
@@ -556,6 +586,7 @@ func DataFromSchema(schema *jsonschema.Schema, mappings map[string]string, specV
 	var subjectTypeString string
 	contentFields := []ContentField{}
 	contentTypes := []ContentType{}
+	contentEnums := []ContentEnum{}
 
 	// Special logic for custom schema
 	if strings.HasSuffix(schema.ID, "schema/custom") {
@@ -609,73 +640,38 @@ func DataFromSchema(schema *jsonschema.Schema, mappings map[string]string, specV
 		if !ok {
 			return nil, fmt.Errorf("no content property in schema %s", subjectSchema.Location)
 		}
+		// Content types are namespaced to the event
+		namer := func(name string) string {
+			return GoTypeName(eventType.Subject, mappings) +
+				GoTypeName(eventType.Predicate, mappings) + "SubjectContent" +
+				name + "V" + strings.ReplaceAll(eventType.Version, ".", "_")
+		}
 		for name, propertySchema := range contentSchema.Properties {
 			contentField := ContentField{}
 			contentField.NameLower = name
 			contentField.Name = capitalizer.String(name)
 			contentField.Required = false
-			var contentFieldType string
 			for _, value := range contentSchema.Required {
 				if name == value {
 					contentField.Required = true
 				}
 			}
-			// Handles the case of "anyOf" with string + enum of strings
-			var types = []string{}
-			if propertySchema.Types != nil {
-				types = propertySchema.Types.ToStrings()
-			}
-			if len(types) == 0 {
-				if propertySchema.AnyOf != nil {
-					err := validateStringEnumAnyOf(propertySchema)
-					if err != nil {
-						return nil, err
-					}
-					contentFieldType = "anyOfStringEnum"
-				} else {
-					return nil, fmt.Errorf("one type required or anyOf two string types in schema %s: %v", propertySchema.Location, types)
-				}
-			} else {
-				contentFieldType = types[0]
-			}
-			if len(types) > 1 {
-				return nil, fmt.Errorf("only one type allowed for content property in schema %s: %v", propertySchema.Location, types)
+			propertyType, err := goTypeForSchema(GoTypeName(name, mappings), propertySchema, namer, mappings)
+			if err != nil {
+				return nil, err
 			}
-			if len(types) > 1 {
-				return nil, fmt.Errorf("only one type allowed for content property in schema %s: %v", propertySchema.Location, types)
+			if !contentField.Required {
+				optionalScalar(propertyType)
 			}
-			switch contentFieldType {
-			case "object":
-				contentType, err := typesForSchema(name, propertySchema, mappings)
-				if err != nil {
-					return nil, err
-				}
-				namespacedType := GoTypeName(contentType.Name, mappings)
-				if contentType.Name != REFERENCE_TYPE {
-					// If this is not a "Reference" we need to define a new type
-					contentTypes = append(contentTypes, *contentType)
-					// If this is not a "Reference" we need to namespace the type name to the event
-					namespacedType = GoTypeName(eventType.Subject, mappings) +
-						GoTypeName(eventType.Predicate, mappings) + "SubjectContent" +
-						GoTypeName(contentType.Name, mappings) + "V" + strings.ReplaceAll(eventType.Version, ".", "_")
-				}
-				// We must use pointers here for "omitempty" to work when rendering to JSON
-				contentField.Type = "*" + namespacedType
-			case "string":
-				contentField.Type = "string"
-			case "array":
-				if propertySchema.Items2020 != nil &&
-					len(propertySchema.Items2020.Types.ToStrings()) == 1 &&
-					propertySchema.Items2020.Types.ToStrings()[0] == "string" {
-					contentField.Type = "[]string"
-				} else {
-					return nil, fmt.Errorf("content property type %s not allowed in schema %s", contentField.Type, propertySchema.Location)
-				}
-			case "anyOfStringEnum":
-				contentField.Type = "string"
-			default:
-				return nil, fmt.Errorf("content property type %s not allowed in schema %s", contentField.Type, propertySchema.Location)
+			if contentField.Name == "ArtifactId" {
+				propertyType.Validate = joinRules("purl", propertyType.Validate)
 			}
+			contentField.Type = propertyType.Name
+			contentField.Validate = propertyType.Validate
+			contentField.Copy = propertyType.Copy
+			contentField.ElemType = propertyType.ElemType
+			contentTypes = append(contentTypes, propertyType.Types...)
+			contentEnums = append(contentEnums, propertyType.Enums...)
 			contentFields = append(contentFields, contentField)
 		}
 		// Sort contents for deterministic code rendering
@@ -685,6 +681,9 @@ func DataFromSchema(schema *jsonschema.Schema, mappings map[string]string, specV
 		sort.Slice(contentTypes, func(i, j int) bool {
 			return contentTypes[i].Name < contentTypes[j].Name
 		})
+		sort.Slice(contentEnums, func(i, j int) bool {
+			return contentEnums[i].Name < contentEnums[j].Name
+		})
 	}
 	return &Data{
 		Subject:         GoTypeName(eventType.Subject, mappings),
@@ -696,57 +695,295 @@ func DataFromSchema(schema *jsonschema.Schema, mappings map[string]string, specV
 		SubjectType:     subjectTypeString,
 		Contents:        contentFields,
 		ContentTypes:    contentTypes,
+		ContentEnums:    contentEnums,
 		IsCustom:        isCustom,
 		UsesSpecVersion: usesSpecVersion,
 	}, nil
 }
 
-// typesForSchema takes a property from a jsonschema and produces
-// a ContentType object, as long as all fields are of type string
-func typesForSchema(name string, property *jsonschema.Schema, mappings map[string]string) (*ContentType, error) {
+// typeNamer returns the name of the Go type of a content type
+type typeNamer func(name string) string
+
+// Kinds of copy used by DeepCopyInto for content fields. Values are
+// copied by assignment, elements of other kinds are of type ElemType.
+const (
+	copyValue       = ""
+	copyPointer     = "pointer"
+	copyStruct      = "struct"
+	copySlice       = "slice"
+	copyStructSlice = "structSlice"
+	copyMap         = "map"
+	copyStructMap   = "structMap"
+	// Anything holding interface{} or nested slices and maps
+	copyValueDeep = "deep"
+)
+
+// fieldType holds the Go type of a content property, the validate rule
+// for it, how to copy it, and the content types and enums that must be
+// defined for it
+type fieldType struct {
+	Name     string
+	Validate string
+	Copy     string
+	ElemType string
+	Types    []ContentType
+	Enums    []ContentEnum
+}
+
+// resolveRef follows the $ref of a schema that only holds a reference
+func resolveRef(schema *jsonschema.Schema) *jsonschema.Schema {
+	for schema.Ref != nil && schema.Types == nil && schema.Properties == nil {
+		schema = schema.Ref
+	}
+	return schema
+}
+
+// enumForSchema returns the enum with the Go name name for the values
+// of an enum of strings
+func enumForSchema(name string, schema *jsonschema.Schema) (*ContentEnum, error) {
+	contentEnum := &ContentEnum{Name: name}
+	for _, value := range schema.Enum.Values {
+		if value == nil {
+			// Allowed in the enum of nullable strings
+			continue
+		}
+		stringValue, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("only string values allowed in enum in schema %s: %v", schema.Location, schema.Enum.Values)
+		}
+		contentEnum.Values = append(contentEnum.Values, ContentEnumValue{
+			Name:  enumValueName(stringValue),
+			Value: stringValue,
+		})
+	}
+	return contentEnum, nil
+}
+
+// enumValueName returns the Go name of an enum value, e.g. "e2e" becomes
+// "E2e" and "not-run" becomes "NotRun"
+func enumValueName(value string) string {
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	name := ""
+	for _, word := range words {
+		name += capitalizer.String(word)
+	}
+	return name
+}
+
+// oneOfRule returns the validate rule that only allows the enum values
+func oneOfRule(enum *ContentEnum) string {
+	values := []string{}
+	for _, value := range enum.Values {
+		if strings.ContainsAny(value.Value, " '") {
+			values = append(values, "'"+value.Value+"'")
+		} else {
+			values = append(values, value.Value)
+		}
+	}
+	return "oneof=" + strings.Join(values, " ")
+}
+
+// goTypeForSchema returns the Go type of the content property with the
+// Go name name, and the content types that must be defined for it:
+//   - string, integer, number and boolean map to Go basic types, which are
+//     pointers if the property is nullable, e.g. "type": ["string", "null"].
+//     Optional integer, number and boolean fields are pointers too, see
+//     optionalScalar
+//   - strings with an enum map to a string type with a constant for each
+//     value, strings with anyOf an enum or free text map to string
+//   - objects with properties map to *Reference, if they only have id and
+//     source, or to a pointer to a content type named after the property
+//   - objects without properties map to maps of additionalProperties
+//   - arrays map to slices of their items
+func goTypeForSchema(name string, schema *jsonschema.Schema, namer typeNamer, mappings map[string]string) (*fieldType, error) {
+	schema = resolveRef(schema)
+	var types = []string{}
+	if schema.Types != nil {
+		types = schema.Types.ToStrings()
+	}
+	nullable := false
+	if len(types) == 2 && slices.Contains(types, "null") {
+		nullable = true
+		types = slices.DeleteFunc(types, func(t string) bool { return t == "null" })
+	}
+	if len(types) == 0 {
+		// Handles the case of "anyOf" with string + enum of strings
+		if schema.AnyOf == nil {
+			return nil, fmt.Errorf("one type required or anyOf two string types in schema %s: %v", schema.Location, types)
+		}
+		if err := validateStringEnumAnyOf(schema); err != nil {
+			return nil, err
+		}
+		// Free text is allowed, so the enum only provides constants
+		for _, anyContainer := range schema.AnyOf {
+			if anyContainer.Enum != nil {
+				enum, err := enumForSchema(name, anyContainer)
+				if err != nil {
+					return nil, err
+				}
+				enum.FreeText = true
+				return &fieldType{Name: "string", Enums: []ContentEnum{*enum}}, nil
+			}
+		}
+	}
+	if len(types) > 1 {
+		return nil, fmt.Errorf("only one type allowed for content property in schema %s: %v", schema.Location, types)
+	}
+	field := &fieldType{}
+	switch types[0] {
+	case "string":
+		field.Name = "string"
+		if schema.Enum != nil {
+			enum, err := enumForSchema(name, schema)
+			if err != nil {
+				return nil, err
+			}
+			field.Name = namer(name)
+			field.Validate = oneOfRule(enum)
+			field.Enums = []ContentEnum{*enum}
+		}
+	case "integer":
+		field.Name = "int64"
+	case "number":
+		field.Name = "float64"
+	case "boolean":
+		field.Name = "bool"
+	case "object":
+		if len(schema.Properties) == 0 {
+			// Maps are nil-able already
+			field.Name = "map[string]interface{}"
+			field.Copy = copyValueDeep
+			if additional, ok := schema.AdditionalProperties.(*jsonschema.Schema); ok {
+				valueField, err := goTypeForSchema(name, additional, namer, mappings)
+				if err != nil {
+					return nil, err
+				}
+				field = valueField
+				field.Copy, field.ElemType = containerCopy(valueField, copyMap, copyStructMap)
+				field.Name = "map[string]" + field.ElemType
+				if valueField.Validate != "" {
+					field.Validate = "dive," + valueField.Validate
+				}
+			}
+			return field, nil
+		}
+		contentType, nested, err := typesForSchema(name, schema, namer, mappings)
+		if err != nil {
+			return nil, err
+		}
+		// We must use pointers here for "omitempty" to work when rendering to JSON
+		if contentType.Name == REFERENCE_TYPE {
+			return &fieldType{Name: "*" + REFERENCE_TYPE, Copy: copyStruct, ElemType: REFERENCE_TYPE}, nil
+		}
+		nested.Name = "*" + namer(contentType.Name)
+		nested.Copy = copyStruct
+		nested.ElemType = namer(contentType.Name)
+		nested.Types = append([]ContentType{*contentType}, nested.Types...)
+		return nested, nil
+	case "array":
+		// Slices are nil-able already
+		if schema.Items2020 == nil {
+			return &fieldType{Name: "[]interface{}", Copy: copyValueDeep}, nil
+		}
+		itemField, err := goTypeForSchema(name, schema.Items2020, namer, mappings)
+		if err != nil {
+			return nil, err
+		}
+		itemField.Copy, itemField.ElemType = containerCopy(itemField, copySlice, copyStructSlice)
+		itemField.Name = "[]" + itemField.ElemType
+		if itemField.Validate != "" {
+			itemField.Validate = "dive," + itemField.Validate
+		}
+		return itemField, nil
+	default:
+		return nil, fmt.Errorf("content property type %s not allowed in schema %s", types[0], schema.Location)
+	}
+	if nullable {
+		field.Copy = copyPointer
+		field.ElemType = field.Name
+		field.Name = "*" + field.Name
+	}
+	return field, nil
+}
+
+// optionalScalar turns optional integer, number and boolean fields into
+// pointers, so that zero values are not dropped by "omitempty"
+func optionalScalar(field *fieldType) {
+	switch field.Name {
+	case "int64", "float64", "bool":
+		field.Copy = copyPointer
+		field.ElemType = field.Name
+		field.Name = "*" + field.Name
+	}
+}
+
+// joinRules joins the non-empty validate rules
+func joinRules(rules ...string) string {
+	return strings.Join(slices.DeleteFunc(rules, func(rule string) bool { return rule == "" }), ",")
+}
+
+// containerCopy returns the kind of copy and the type of the elements of
+// a slice or map of element. Structs are stored as values, not pointers.
+func containerCopy(element *fieldType, valueCopy, structCopy string) (string, string) {
+	switch element.Copy {
+	case copyValue:
+		return valueCopy, element.Name
+	case copyStruct:
+		return structCopy, element.ElemType
+	default:
+		return copyValueDeep, element.Name
+	}
+}
+
+// typesForSchema takes an object property from a jsonschema and produces
+// a ContentType object, and the content types and enums of its nested
+// properties, which are named after the path of properties that leads to them
+func typesForSchema(name string, property *jsonschema.Schema, namer typeNamer, mappings map[string]string) (*ContentType, *fieldType, error) {
 	fields := []ContentField{}
+	nested := &fieldType{}
 	otherNames := []string{}
 	referenceFields := []string{}
-	for name, propertySchema := range property.Properties {
-		switch name {
+	for fieldName, propertySchema := range property.Properties {
+		switch fieldName {
 		case "id", "source":
-			referenceFields = append(referenceFields, name)
+			referenceFields = append(referenceFields, fieldName)
 		default:
-			otherNames = append(otherNames, name)
-		}
-		var types = []string{}
-		if propertySchema.Types != nil {
-			types = propertySchema.Types.ToStrings()
+			otherNames = append(otherNames, fieldName)
 		}
-		if len(types) != 1 {
-			return nil, fmt.Errorf("only one type allowed for content property in schema %s", propertySchema.Location)
+		propertyType, err := goTypeForSchema(name+GoTypeName(fieldName, mappings), propertySchema, namer, mappings)
+		if err != nil {
+			return nil, nil, err
 		}
-		if types[0] != "string" {
-			return nil, fmt.Errorf("only one string type allowed for content property in schema %s", propertySchema.Location)
+		required := slices.Contains(property.Required, fieldName)
+		if !required {
+			optionalScalar(propertyType)
 		}
 		field := ContentField{
-			NameLower: name,
-			Name:      GoTypeName(name, mappings),
-			Type:      "string",
-			Required:  false,
-		}
-		for _, value := range property.Required {
-			if name == value {
-				field.Required = true
-			}
+			NameLower: fieldName,
+			Name:      GoTypeName(fieldName, mappings),
+			Type:      propertyType.Name,
+			Required:  required,
+			Validate:  propertyType.Validate,
+			Copy:      propertyType.Copy,
+			ElemType:  propertyType.ElemType,
 		}
 		fields = append(fields, field)
+		nested.Types = append(nested.Types, propertyType.Types...)
+		nested.Enums = append(nested.Enums, propertyType.Enums...)
 	}
 	// Check if this is a reference
-	if len(referenceFields) == 2 && len(otherNames) == 0 {
+	if len(referenceFields) == 2 && len(otherNames) == 0 && fields[0].Type == "string" && fields[1].Type == "string" {
 		name = REFERENCE_TYPE
+		nested = &fieldType{}
 	}
 	// Sort fields for consistent generation
 	sort.Slice(fields, func(i, j int) bool {
 		return fields[i].Name < fields[j].Name
 	})
 	return &ContentType{
-		Name:   GoTypeName(name, mappings),
+		Name:   name,
 		Fields: fields,
-	}, nil
+	}, nested, nil
 }
@@ -19,7 +19,10 @@ SPDX-License-Identifier: Apache-2.0
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -32,10 +35,14 @@ import (
 )
 
 const testSchemaJson = "../pkg/api/tests-v99.1/schemas/foosubjectbarpredicate.json"
+const testTypesSchemaJson = "testdata/foosubject-typespredicate.json"
+const testEnumsSchemaJson = "testdata/foosubject-enumpredicate.json"
 const specVersion = "0.4.1"
 
 var (
 	testSchema      *jsonschema.Schema
+	testTypesSchema *jsonschema.Schema
+	testEnumsSchema *jsonschema.Schema
 	testSubject     = "FooSubject"
 	testSubjectType = "fooSubject"
 	testPredicate   = "BarPredicate"
@@ -69,6 +76,10 @@ func init() {
 	panicOnError(err)
 	testSchema, err = compiler.Compile(testSchemaJson)
 	panicOnError(err)
+	testTypesSchema, err = compiler.Compile(testTypesSchemaJson)
+	panicOnError(err)
+	testEnumsSchema, err = compiler.Compile(testEnumsSchemaJson)
+	panicOnError(err)
 }
 
 func TestDataFromSchema(t *testing.T) {
@@ -84,10 +95,18 @@ func TestDataFromSchema(t *testing.T) {
 			Name:      "ArtifactId",
 			NameLower: "artifactId",
 			Type:      "string",
+			Validate:  "purl",
+		}, {
+			Name:      "Mode",
+			NameLower: "mode",
+			Type:      "FooSubjectBarPredicateSubjectContentModeV2_2_3",
+			Validate:  "oneof='dry run' apply",
 		}, {
 			Name:      "ReferenceField",
 			NameLower: "referenceField",
 			Type:      "*Reference",
+			Copy:      "struct",
+			ElemType:  "Reference",
 			Required:  true,
 		}, {
 			Name:      "PlainField",
@@ -98,6 +117,8 @@ func TestDataFromSchema(t *testing.T) {
 			Name:      "ObjectField",
 			NameLower: "objectField",
 			Type:      "*FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3",
+			Copy:      "struct",
+			ElemType:  "FooSubjectBarPredicateSubjectContentObjectFieldV2_2_3",
 		}},
 		ContentTypes: []ContentType{{
 			Name: "ObjectField",
@@ -112,6 +133,10 @@ func TestDataFromSchema(t *testing.T) {
 				Type:      "string",
 			}},
 		}},
+		ContentEnums: []ContentEnum{{
+			Name:   "Mode",
+			Values: []ContentEnumValue{{Name: "DryRun", Value: "dry run"}, {Name: "Apply", Value: "apply"}},
+		}},
 	}
 
 	mappings := map[string]string{
@@ -128,6 +153,472 @@ func TestDataFromSchema(t *testing.T) {
 	}
 }
 
+func TestDataFromSchemaTypes(t *testing.T) {
+	typeName := func(name string) string {
+		return "FooSubjectTypesPredicateSubjectContent" + name + "V1_0_0"
+	}
+	want := &Data{
+		Subject:         testSubject,
+		Predicate:       "TypesPredicate",
+		SubjectLower:    strings.ToLower(testSubject),
+		PredicateLower:  "typespredicate",
+		Version:         "1.0.0",
+		VersionName:     "1_0_0",
+		UsesSpecVersion: true,
+		Contents: []ContentField{{
+			Name:      "BooleanField",
+			NameLower: "booleanField",
+			Type:      "*bool",
+			Copy:      "pointer",
+			ElemType:  "bool",
+		}, {
+			Name:      "FreeMap",
+			NameLower: "freeMap",
+			Type:      "map[string]interface{}",
+			Copy:      "deep",
+		}, {
+			Name:      "IntegerArray",
+			NameLower: "integerArray",
+			Type:      "[]int64",
+			Copy:      "slice",
+			ElemType:  "int64",
+		}, {
+			Name:      "IntegerField",
+			NameLower: "integerField",
+			Type:      "int64",
+			Required:  true,
+		}, {
+			Name:      "NestedObject",
+			NameLower: "nestedObject",
+			Type:      "*" + typeName("NestedObject"),
+			Copy:      "struct",
+			ElemType:  typeName("NestedObject"),
+		}, {
+			Name:      "NullableInteger",
+			NameLower: "nullableInteger",
+			Type:      "*int64",
+			Copy:      "pointer",
+			ElemType:  "int64",
+		}, {
+			Name:      "NullableObject",
+			NameLower: "nullableObject",
+			Type:      "*" + typeName("NullableObject"),
+			Copy:      "struct",
+			ElemType:  typeName("NullableObject"),
+		}, {
+			Name:      "NullableString",
+			NameLower: "nullableString",
+			Type:      "*string",
+			Copy:      "pointer",
+			ElemType:  "string",
+		}, {
+			Name:      "NumberField",
+			NameLower: "numberField",
+			Type:      "*float64",
+			Copy:      "pointer",
+			ElemType:  "float64",
+		}, {
+			Name:      "ObjectArray",
+			NameLower: "objectArray",
+			Type:      "[]" + typeName("ObjectArray"),
+			Copy:      "structSlice",
+			ElemType:  typeName("ObjectArray"),
+		}, {
+			Name:      "ObjectMap",
+			NameLower: "objectMap",
+			Type:      "map[string]" + typeName("ObjectMap"),
+			Copy:      "structMap",
+			ElemType:  typeName("ObjectMap"),
+		}, {
+			Name:      "Reference",
+			NameLower: "reference",
+			Type:      "*Reference",
+			Copy:      "struct",
+			ElemType:  "Reference",
+		}, {
+			Name:      "ReferenceArray",
+			NameLower: "referenceArray",
+			Type:      "[]Reference",
+			Copy:      "structSlice",
+			ElemType:  "Reference",
+		}, {
+			Name:      "StringMap",
+			NameLower: "stringMap",
+			Type:      "map[string]string",
+			Copy:      "map",
+			ElemType:  "string",
+		}},
+		ContentTypes: []ContentType{{
+			Name: "NestedObject",
+			Fields: []ContentField{{
+				Name:      "Inner",
+				NameLower: "inner",
+				Type:      "*" + typeName("NestedObjectInner"),
+				Copy:      "struct",
+				ElemType:  typeName("NestedObjectInner"),
+			}, {
+				Name:      "Name",
+				NameLower: "name",
+				Type:      "string",
+				Required:  true,
+			}},
+		}, {
+			Name: "NestedObjectInner",
+			Fields: []ContentField{{
+				Name:      "Count",
+				NameLower: "count",
+				Type:      "int64",
+				Required:  true,
+			}, {
+				Name:      "Leaf",
+				NameLower: "leaf",
+				Type:      "*" + typeName("NestedObjectInnerLeaf"),
+				Copy:      "struct",
+				ElemType:  typeName("NestedObjectInnerLeaf"),
+			}},
+		}, {
+			Name: "NestedObjectInnerLeaf",
+			Fields: []ContentField{{
+				Name:      "Value",
+				NameLower: "value",
+				Type:      "*float64",
+				Copy:      "pointer",
+				ElemType:  "float64",
+			}},
+		}, {
+			Name: "NullableObject",
+			Fields: []ContentField{{
+				Name:      "Name",
+				NameLower: "name",
+				Type:      "string",
+			}},
+		}, {
+			Name: "ObjectArray",
+			Fields: []ContentField{{
+				Name:      "Enabled",
+				NameLower: "enabled",
+				Type:      "*bool",
+				Copy:      "pointer",
+				ElemType:  "bool",
+			}, {
+				Name:      "Name",
+				NameLower: "name",
+				Type:      "string",
+				Required:  true,
+			}},
+		}, {
+			Name: "ObjectMap",
+			Fields: []ContentField{{
+				Name:      "Weight",
+				NameLower: "weight",
+				Type:      "*float64",
+				Copy:      "pointer",
+				ElemType:  "float64",
+			}},
+		}},
+		ContentEnums: []ContentEnum{},
+	}
+	mappings := map[string]string{
+		"foosubject":     "FooSubject",
+		"typespredicate": "TypesPredicate",
+	}
+	got, err := DataFromSchema(testTypesSchema, mappings, "0.5.1")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestDataFromSchemaEnums(t *testing.T) {
+	typeName := func(name string) string {
+		return "FooSubjectEnumPredicateSubjectContent" + name + "V1_0_0"
+	}
+	want := &Data{
+		Subject:         testSubject,
+		Predicate:       "EnumPredicate",
+		SubjectLower:    strings.ToLower(testSubject),
+		PredicateLower:  "enumpredicate",
+		Version:         "1.0.0",
+		VersionName:     "1_0_0",
+		UsesSpecVersion: true,
+		Contents: []ContentField{{
+			Name:      "Level",
+			NameLower: "level",
+			Type:      "*" + typeName("Level"),
+			Copy:      "pointer",
+			ElemType:  typeName("Level"),
+			Validate:  "oneof=low high",
+		}, {
+			Name:      "Outcome",
+			NameLower: "outcome",
+			Type:      typeName("Outcome"),
+			Required:  true,
+			Validate:  "oneof=success failure not-run",
+		}, {
+			Name:      "Priority",
+			NameLower: "priority",
+			Type:      "string",
+		}, {
+			Name:      "Tags",
+			NameLower: "tags",
+			Type:      "[]" + typeName("Tags"),
+			Copy:      "slice",
+			ElemType:  typeName("Tags"),
+			Validate:  "dive,oneof=fast slow",
+		}, {
+			Name:      "TestCase",
+			NameLower: "testCase",
+			Type:      "*" + typeName("TestCase"),
+			Copy:      "struct",
+			ElemType:  typeName("TestCase"),
+		}},
+		ContentTypes: []ContentType{{
+			Name: "TestCase",
+			Fields: []ContentField{{
+				Name:      "Id",
+				NameLower: "id",
+				Type:      "string",
+			}, {
+				Name:      "Type",
+				NameLower: "type",
+				Type:      typeName("TestCaseType"),
+				Validate:  "oneof=unit e2e",
+			}},
+		}},
+		ContentEnums: []ContentEnum{{
+			Name:   "Level",
+			Values: []ContentEnumValue{{Name: "Low", Value: "low"}, {Name: "High", Value: "high"}},
+		}, {
+			Name:   "Outcome",
+			Values: []ContentEnumValue{{Name: "Success", Value: "success"}, {Name: "Failure", Value: "failure"}, {Name: "NotRun", Value: "not-run"}},
+		}, {
+			Name:     "Priority",
+			Values:   []ContentEnumValue{{Name: "Low", Value: "low"}, {Name: "Medium", Value: "medium"}, {Name: "High", Value: "high"}},
+			FreeText: true,
+		}, {
+			Name:   "Tags",
+			Values: []ContentEnumValue{{Name: "Fast", Value: "fast"}, {Name: "Slow", Value: "slow"}},
+		}, {
+			Name:   "TestCaseType",
+			Values: []ContentEnumValue{{Name: "Unit", Value: "unit"}, {Name: "E2e", Value: "e2e"}},
+		}},
+	}
+	mappings := map[string]string{
+		"foosubject":    "FooSubject",
+		"enumpredicate": "EnumPredicate",
+	}
+	got, err := DataFromSchema(testEnumsSchema, mappings, "0.5.1")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("args: diff(-want,+got):\n%s", d)
+	}
+}
+
+func TestDataFromSchemaTypesInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		property  string
+		wantError string
+	}{{
+		name:      "multiple types",
+		property:  `{"type": ["string", "integer"]}`,
+		wantError: "only one type allowed for content property",
+	}, {
+		name:      "null only",
+		property:  `{"type": "null"}`,
+		wantError: "content property type null not allowed",
+	}, {
+		name:      "no type",
+		property:  `{"minLength": 1}`,
+		wantError: "one type required or anyOf two string types",
+	}, {
+		name:      "invalid array items",
+		property:  `{"type": "array", "items": {"type": ["string", "integer"]}}`,
+		wantError: "only one type allowed for content property",
+	}, {
+		name:      "invalid nested property",
+		property:  `{"type": "object", "properties": {"inner": {"type": ["string", "boolean"]}}}`,
+		wantError: "only one type allowed for content property",
+	}}
+	for i, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("https://cdevents.dev/99.2.0/schema/invalid-%d", i)
+			schema := `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"$id": "` + url + `",
+				"properties": {
+					"context": {"properties": {"type": {"type": "string", "enum": ["dev.cdevents.foosubject.invalidpredicate.1.0.0"]}}},
+					"subject": {"properties": {"content": {"properties": {"field": ` + tc.property + `}}}}
+				}
+			}`
+			doc, err := jsonschema.UnmarshalJSON(strings.NewReader(schema))
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			if err := compiler.AddResource(url, doc); err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			sch, err := compiler.Compile(url)
+			if err != nil {
+				t.Fatalf("didn't expected it to fail, but it did: %v", err)
+			}
+			_, err = DataFromSchema(sch, map[string]string{}, "0.5.1")
+			if err == nil {
+				t.Fatalf("expected an error, but go none")
+			}
+			if !strings.Contains(err.Error(), tc.wantError) {
+				t.Errorf("expected error to contain %q, got %q", tc.wantError, err.Error())
+			}
+		})
+	}
+}
+
+// TestGenerateTypes verifies that the code generated for all supported
+// types is valid and has the expected types, setters and tags
+func TestGenerateTypes(t *testing.T) {
+	templates, err := template.ParseGlob("templates/*.tmpl")
+	if err != nil {
+		t.Fatalf("error parsing templates: %s", err)
+	}
+	tests := []struct {
+		name     string
+		schema   *jsonschema.Schema
+		mappings map[string]string
+		want     []string
+		notWant  []string
+		// usage is code of the api package that uses the generated code
+		usage string
+	}{{
+		name:     "types",
+		schema:   testTypesSchema,
+		mappings: map[string]string{"foosubject": "FooSubject", "typespredicate": "TypesPredicate"},
+		want: []string{
+			"func (e *FooSubjectTypesPredicateEventV1_0_0) SetSubjectIntegerField(integerField int64) {",
+			"func (e *FooSubjectTypesPredicateEventV1_0_0) SetSubjectBooleanField(booleanField *bool) {",
+			"BooleanField *bool `json:\"booleanField,omitempty\"`",
+			"IntegerField int64 `json:\"integerField\"`",
+			"func (e *FooSubjectTypesPredicateEventV1_0_0) SetSubjectNullableString(nullableString *string) {",
+			"func (e *FooSubjectTypesPredicateEventV1_0_0) SetSubjectObjectArray(objectArray []FooSubjectTypesPredicateSubjectContentObjectArrayV1_0_0) {",
+			"func (e *FooSubjectTypesPredicateEventV1_0_0) SetSubjectObjectMap(objectMap map[string]FooSubjectTypesPredicateSubjectContentObjectMapV1_0_0) {",
+			"type FooSubjectTypesPredicateSubjectContentNestedObjectInnerLeafV1_0_0 struct {",
+			"Count int64 `json:\"count\"`",
+			"Leaf *FooSubjectTypesPredicateSubjectContentNestedObjectInnerLeafV1_0_0 `json:\"leaf,omitempty\"`",
+			"func (e FooSubjectTypesPredicateEventV1_0_0) GetSubjectIntegerField() int64 {",
+			"func (e FooSubjectTypesPredicateEventV1_0_0) GetSubjectObjectMap() map[string]FooSubjectTypesPredicateSubjectContentObjectMapV1_0_0 {",
+			"func (in *FooSubjectTypesPredicateEventV1_0_0) DeepCopy() *FooSubjectTypesPredicateEventV1_0_0 {",
+			"func (e *FooSubjectTypesPredicateEventV1_0_0) Equal(other *FooSubjectTypesPredicateEventV1_0_0, opts ...EqualOption) bool {",
+			"func (in *FooSubjectTypesPredicateSubjectContentNestedObjectInnerV1_0_0) DeepCopyInto(out *FooSubjectTypesPredicateSubjectContentNestedObjectInnerV1_0_0) {",
+			"in, out := &in.NullableString, &out.NullableString *out = new(string) **out = **in",
+			"*out = new(FooSubjectTypesPredicateSubjectContentNestedObjectInnerLeafV1_0_0) (*in).DeepCopyInto(*out)",
+			"for i := range *in { (*in)[i].DeepCopyInto(&(*out)[i]) }",
+			"for key, val := range *in { (*out)[key] = *val.DeepCopy() }",
+		},
+		usage: `func _() {
+	e, _ := NewFooSubjectTypesPredicateEventV1_0_0("0.5.1")
+	disabled := false
+	e.SetSubjectBooleanField(&disabled)
+	_ = e.DeepCopy()
+}`,
+	}, {
+		name:     "enums",
+		schema:   testEnumsSchema,
+		mappings: map[string]string{"foosubject": "FooSubject", "enumpredicate": "EnumPredicate"},
+		want: []string{
+			"Outcome FooSubjectEnumPredicateSubjectContentOutcomeV1_0_0 `json:\"outcome\" validate:\"oneof=success failure not-run\"`",
+			"Level *FooSubjectEnumPredicateSubjectContentLevelV1_0_0 `json:\"level,omitempty\" validate:\"omitempty,oneof=low high\"`",
+			"Tags []FooSubjectEnumPredicateSubjectContentTagsV1_0_0 `json:\"tags,omitempty\" validate:\"omitempty,dive,oneof=fast slow\"`",
+			"Type FooSubjectEnumPredicateSubjectContentTestCaseTypeV1_0_0 `json:\"type,omitempty\" validate:\"omitempty,oneof=unit e2e\"`",
+			"func (e *FooSubjectEnumPredicateEventV1_0_0) SetSubjectOutcome(outcome FooSubjectEnumPredicateSubjectContentOutcomeV1_0_0) {",
+			"func (e *FooSubjectEnumPredicateEventV1_0_0) SetSubjectPriority(priority string) {",
+			"type FooSubjectEnumPredicateSubjectContentOutcomeV1_0_0 string",
+			"FooSubjectEnumPredicateSubjectContentOutcomeNotRunV1_0_0 FooSubjectEnumPredicateSubjectContentOutcomeV1_0_0 = \"not-run\"",
+			"FooSubjectEnumPredicateSubjectContentPriorityMediumV1_0_0 = \"medium\"",
+			"registerEnumValues( FooSubjectEnumPredicateSubjectContentOutcomeSuccessV1_0_0, FooSubjectEnumPredicateSubjectContentOutcomeFailureV1_0_0, FooSubjectEnumPredicateSubjectContentOutcomeNotRunV1_0_0, )",
+		},
+		notWant: []string{
+			"type FooSubjectEnumPredicateSubjectContentPriorityV1_0_0 string",
+		},
+		usage: `func _() {
+	e, _ := NewFooSubjectEnumPredicateEventV1_0_0("0.5.1")
+	e.SetSubjectOutcome(FooSubjectEnumPredicateSubjectContentOutcomeNotRunV1_0_0)
+	e.SetSubjectPriority(FooSubjectEnumPredicateSubjectContentPriorityMediumV1_0_0)
+	e.SetSubjectPriority("urgent")
+}`,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := DataFromSchema(tc.schema, tc.mappings, "0.5.1")
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			data.SpecVersion = "0.5.1"
+			outputFileName := filepath.Join(t.TempDir(), data.OutputFile())
+			err = executeTemplate(templates, eventTemplateFileName, outputFileName, data)
+			if err != nil {
+				t.Fatalf("error executing template: %s", err)
+			}
+			output, err := os.ReadFile(outputFileName)
+			if err != nil {
+				t.Fatalf("error reading output file: %s", err)
+			}
+			// gofmt aligns the fields and constants in each block
+			normalized := strings.Join(strings.Fields(string(output)), " ")
+			for _, want := range tc.want {
+				if !strings.Contains(normalized, want) {
+					t.Errorf("expected generated code to contain %q", want)
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(normalized, notWant) {
+					t.Errorf("expected generated code not to contain %q", notWant)
+				}
+			}
+			if tc.usage != "" {
+				buildWithApi(t, data.OutputFile(), output, tc.usage)
+			}
+		})
+	}
+}
+
+// buildWithApi builds the api package along with the generated code and
+// code that uses it, which are overlaid on the package
+func buildWithApi(t *testing.T, fileName string, generated []byte, usage string) {
+	t.Helper()
+	apiDir, err := filepath.Abs("../pkg/api")
+	if err != nil {
+		t.Fatalf("error finding the api package: %s", err)
+	}
+	dir := t.TempDir()
+	files := map[string][]byte{
+		fileName:                generated,
+		"zz_generator_usage.go": []byte("package api\n\n" + usage + "\n"),
+	}
+	overlay := map[string]map[string]string{"Replace": {}}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, content, 0o600); err != nil {
+			t.Fatalf("error writing %s: %s", path, err)
+		}
+		overlay["Replace"][filepath.Join(apiDir, name)] = path
+	}
+	overlayJson, err := json.Marshal(overlay)
+	if err != nil {
+		t.Fatalf("error rendering the overlay: %s", err)
+	}
+	overlayFile := filepath.Join(dir, "overlay.json")
+	if err := os.WriteFile(overlayFile, overlayJson, 0o600); err != nil {
+		t.Fatalf("error writing %s: %s", overlayFile, err)
+	}
+	output, err := exec.Command("go", "build", "-overlay", overlayFile, apiDir).CombinedOutput()
+	if err != nil {
+		t.Fatalf("generated code does not build: %s\n%s", err, output)
+	}
+}
+
 // TestExecuteTemplate_Success verifies that templating and code formatting
 // are both applied
 func TestExecuteTemplate_Success(t *testing.T) {